@@ -15,6 +15,10 @@ var _ Client = (*client)(nil)
 // Client interface for Avalanche Health API Endpoint
 // For helpers to wait for Readiness, Health, or Liveness, see AwaitReady,
 // AwaitHealthy, and AwaitAlive.
+//
+// A node that is still bootstrapping is alive but not ready, so orchestrators
+// should use Liveness for restart decisions and Readiness to route traffic.
+// The names of the checks that failed are given by [APIReply.FailedChecks].
 type Client interface {
 	// Readiness returns if the node has finished initialization
 	Readiness(ctx context.Context, tags []string, options ...rpc.Option) (*APIReply, error)
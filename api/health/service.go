@@ -5,6 +5,7 @@ package health
 
 import (
 	"net/http"
+	"slices"
 
 	"go.uber.org/zap"
 
@@ -22,6 +23,18 @@ type APIReply struct {
 	Healthy bool              `json:"healthy"`
 }
 
+// FailedChecks returns the sorted names of the checks that reported an error.
+func (r *APIReply) FailedChecks() []string {
+	var failed []string
+	for name, result := range r.Checks {
+		if result.Error != nil {
+			failed = append(failed, name)
+		}
+	}
+	slices.Sort(failed)
+	return failed
+}
+
 // APIArgs is the arguments for Readiness, Health, and Liveness.
 type APIArgs struct {
 	Tags []string `json:"tags"`
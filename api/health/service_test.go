@@ -105,6 +105,55 @@ func TestServiceResponses(t *testing.T) {
 	}
 }
 
+func TestServiceFailedChecks(t *testing.T) {
+	require := require.New(t)
+
+	passingCheck := CheckerFunc(func(context.Context) (interface{}, error) {
+		return "", nil
+	})
+	failingCheck := CheckerFunc(func(context.Context) (interface{}, error) {
+		return "", errUnhealthy
+	})
+
+	h, err := New(logging.NoLog{}, prometheus.NewRegistry())
+	require.NoError(err)
+
+	s := &Service{
+		log:    logging.NoLog{},
+		health: h,
+	}
+
+	// A bootstrapping node is alive but not ready.
+	require.NoError(h.RegisterReadinessCheck("bootstrapped", failingCheck))
+	require.NoError(h.RegisterReadinessCheck("database", passingCheck))
+	require.NoError(h.RegisterLivenessCheck("network", passingCheck))
+
+	h.Start(context.Background(), checkFreq)
+	defer h.Stop()
+
+	awaitLiveness(t, h, true)
+
+	{
+		reply := APIReply{}
+		require.NoError(s.Liveness(nil, &APIArgs{}, &reply))
+		require.True(reply.Healthy)
+		require.Empty(reply.FailedChecks())
+	}
+
+	// Wait for every readiness check to have run at least once.
+	require.Eventually(func() bool {
+		checks, _ := h.Readiness()
+		return checks["database"].Error == nil
+	}, awaitTimeout, awaitFreq)
+
+	{
+		reply := APIReply{}
+		require.NoError(s.Readiness(nil, &APIArgs{}, &reply))
+		require.False(reply.Healthy)
+		require.Equal([]string{"bootstrapped"}, reply.FailedChecks())
+	}
+}
+
 func TestServiceTagResponse(t *testing.T) {
 	check := CheckerFunc(func(context.Context) (interface{}, error) {
 		return "", nil
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/formatting"
 	"github.com/Juneo-io/juneogo/utils/rpc"
 	"github.com/Juneo-io/juneogo/vms/platformvm/signer"
 )
@@ -22,12 +23,14 @@ type Client interface {
 	GetNodeIP(context.Context, ...rpc.Option) (string, error)
 	GetNetworkID(context.Context, ...rpc.Option) (uint32, error)
 	GetNetworkName(context.Context, ...rpc.Option) (string, error)
+	GetGenesisBytes(context.Context, ...rpc.Option) ([]byte, error)
 	GetBlockchainID(context.Context, string, ...rpc.Option) (ids.ID, error)
 	Peers(context.Context, ...rpc.Option) ([]Peer, error)
 	IsBootstrapped(context.Context, string, ...rpc.Option) (bool, error)
 	GetTxFee(context.Context, ...rpc.Option) (*GetTxFeeResponse, error)
 	Uptime(context.Context, ids.ID, ...rpc.Option) (*UptimeResponse, error)
 	GetVMs(context.Context, ...rpc.Option) (map[ids.ID][]string, error)
+	GetUpgrades(context.Context, ...rpc.Option) ([]Upgrade, error)
 }
 
 // Client implementation for an Info API Client
@@ -72,6 +75,17 @@ func (c *client) GetNetworkName(ctx context.Context, options ...rpc.Option) (str
 	return res.NetworkName, err
 }
 
+func (c *client) GetGenesisBytes(ctx context.Context, options ...rpc.Option) ([]byte, error) {
+	res := &GetGenesisBytesReply{}
+	err := c.requester.SendRequest(ctx, "info.getGenesisBytes", &GetGenesisBytesArgs{
+		Encoding: formatting.Hex,
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
+	return formatting.Decode(res.Encoding, res.GenesisBytes)
+}
+
 func (c *client) GetBlockchainID(ctx context.Context, alias string, options ...rpc.Option) (ids.ID, error) {
 	res := &GetBlockchainIDReply{}
 	err := c.requester.SendRequest(ctx, "info.getBlockchainID", &GetBlockchainIDArgs{
@@ -114,6 +128,12 @@ func (c *client) GetVMs(ctx context.Context, options ...rpc.Option) (map[ids.ID]
 	return res.VMs, err
 }
 
+func (c *client) GetUpgrades(ctx context.Context, options ...rpc.Option) ([]Upgrade, error) {
+	res := &GetUpgradesReply{}
+	err := c.requester.SendRequest(ctx, "info.getUpgrades", struct{}{}, res, options...)
+	return res.Upgrades, err
+}
+
 // AwaitBootstrapped polls the node every [freq] to check if [chainID] has
 // finished bootstrapping. Returns true once [chainID] reports that it has
 // finished bootstrapping.
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 	"go.uber.org/zap"
@@ -18,10 +19,12 @@ import (
 	"github.com/Juneo-io/juneogo/snow/networking/benchlist"
 	"github.com/Juneo-io/juneogo/snow/validators"
 	"github.com/Juneo-io/juneogo/utils/constants"
+	"github.com/Juneo-io/juneogo/utils/formatting"
 	"github.com/Juneo-io/juneogo/utils/ips"
 	"github.com/Juneo-io/juneogo/utils/json"
 	"github.com/Juneo-io/juneogo/utils/logging"
 	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/utils/timer/mockable"
 	"github.com/Juneo-io/juneogo/version"
 	"github.com/Juneo-io/juneogo/vms"
 	"github.com/Juneo-io/juneogo/vms/nftfx"
@@ -59,6 +62,8 @@ type Parameters struct {
 	AddSupernetValidatorFee         uint64
 	AddSupernetDelegatorFee         uint64
 	VMManager                     vms.Manager
+	Upgrades                      []NetworkUpgrade
+	GenesisBytes                  []byte
 }
 
 func NewService(
@@ -185,6 +190,33 @@ func (i *Info) GetNetworkName(_ *http.Request, _ *struct{}, reply *GetNetworkNam
 	return nil
 }
 
+// GetGenesisBytesArgs are the arguments for calling GetGenesisBytes
+type GetGenesisBytesArgs struct {
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetGenesisBytesReply is the result from calling GetGenesisBytes
+type GetGenesisBytesReply struct {
+	GenesisBytes string              `json:"genesisBytes"`
+	Encoding     formatting.Encoding `json:"encoding"`
+}
+
+// GetGenesisBytes returns the genesis this node was started with
+func (i *Info) GetGenesisBytes(_ *http.Request, args *GetGenesisBytesArgs, reply *GetGenesisBytesReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getGenesisBytes"),
+	)
+
+	genesisBytes, err := formatting.Encode(args.Encoding, i.GenesisBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't encode genesis as %s: %w", args.Encoding, err)
+	}
+	reply.GenesisBytes = genesisBytes
+	reply.Encoding = args.Encoding
+	return nil
+}
+
 // GetBlockchainIDArgs are the arguments for calling GetBlockchainID
 type GetBlockchainIDArgs struct {
 	Alias string `json:"alias"`
@@ -384,6 +416,72 @@ func (i *Info) Acps(_ *http.Request, _ *struct{}, reply *ACPsReply) error {
 	return nil
 }
 
+// UpgradeNotScheduled is reported as the activation time of the network
+// upgrades that aren't scheduled.
+const UpgradeNotScheduled = "not scheduled"
+
+// NetworkUpgrade is a network upgrade along with the time it activates at
+type NetworkUpgrade struct {
+	Name string
+	Time time.Time
+}
+
+// NetworkUpgrades returns the network upgrades configured for [networkID],
+// sorted by activation time.
+func NetworkUpgrades(networkID uint32) []NetworkUpgrade {
+	return []NetworkUpgrade{
+		{Name: "apricotPhase1", Time: version.GetApricotPhase1Time(networkID)},
+		{Name: "apricotPhase2", Time: version.GetApricotPhase2Time(networkID)},
+		{Name: "apricotPhase3", Time: version.GetApricotPhase3Time(networkID)},
+		{Name: "apricotPhase4", Time: version.GetApricotPhase4Time(networkID)},
+		{Name: "apricotPhase5", Time: version.GetApricotPhase5Time(networkID)},
+		{Name: "apricotPhasePre6", Time: version.GetApricotPhasePre6Time(networkID)},
+		{Name: "apricotPhase6", Time: version.GetApricotPhase6Time(networkID)},
+		{Name: "apricotPhasePost6", Time: version.GetApricotPhasePost6Time(networkID)},
+		{Name: "banff", Time: version.GetBanffTime(networkID)},
+		{Name: "cortina", Time: version.GetCortinaTime(networkID)},
+		{Name: "durango", Time: version.GetDurangoTime(networkID)},
+		{Name: "eUpgrade", Time: version.GetEUpgradeTime(networkID)},
+	}
+}
+
+// Upgrade describes a network upgrade and whether it is active
+type Upgrade struct {
+	Name string `json:"name"`
+	// ActivationTime is formatted as RFC 3339, or is [UpgradeNotScheduled]
+	ActivationTime string `json:"activationTime"`
+	Active         bool   `json:"active"`
+}
+
+// GetUpgradesReply are the results from calling GetUpgrades
+type GetUpgradesReply struct {
+	Upgrades []Upgrade `json:"upgrades"`
+}
+
+// GetUpgrades returns the network upgrades configured on this node along with
+// whether they are active
+func (i *Info) GetUpgrades(_ *http.Request, _ *struct{}, reply *GetUpgradesReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getUpgrades"),
+	)
+
+	now := time.Now()
+	reply.Upgrades = make([]Upgrade, len(i.Upgrades))
+	for j, upgrade := range i.Upgrades {
+		activationTime := UpgradeNotScheduled
+		if !upgrade.Time.Equal(mockable.MaxTime) {
+			activationTime = upgrade.Time.UTC().Format(time.RFC3339)
+		}
+		reply.Upgrades[j] = Upgrade{
+			Name:           upgrade.Name,
+			ActivationTime: activationTime,
+			Active:         !now.Before(upgrade.Time),
+		}
+	}
+	return nil
+}
+
 type GetTxFeeResponse struct {
 	TxFee                         json.Uint64 `json:"txFee"`
 	CreateAssetTxFee              json.Uint64 `json:"createAssetTxFee"`
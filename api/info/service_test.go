@@ -6,12 +6,15 @@ package info
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/formatting"
 	"github.com/Juneo-io/juneogo/utils/logging"
+	"github.com/Juneo-io/juneogo/utils/timer/mockable"
 	"github.com/Juneo-io/juneogo/vms"
 )
 
@@ -94,3 +97,55 @@ func TestGetVMsGetAliasesFails(t *testing.T) {
 	err := resources.info.GetVMs(nil, nil, &reply)
 	require.ErrorIs(t, err, errTest)
 }
+
+func TestGetUpgrades(t *testing.T) {
+	require := require.New(t)
+
+	activationTime := time.Date(2024, time.March, 6, 16, 0, 0, 0, time.UTC)
+	info := &Info{
+		Parameters: Parameters{
+			Upgrades: []NetworkUpgrade{
+				{Name: "durango", Time: activationTime},
+				{Name: "eUpgrade", Time: mockable.MaxTime},
+			},
+		},
+		log: logging.NoLog{},
+	}
+
+	reply := GetUpgradesReply{}
+	require.NoError(info.GetUpgrades(nil, nil, &reply))
+	require.Equal([]Upgrade{
+		{
+			Name:           "durango",
+			ActivationTime: "2024-03-06T16:00:00Z",
+			Active:         true,
+		},
+		{
+			Name:           "eUpgrade",
+			ActivationTime: UpgradeNotScheduled,
+			Active:         false,
+		},
+	}, reply.Upgrades)
+}
+
+func TestGetGenesisBytes(t *testing.T) {
+	require := require.New(t)
+
+	genesisBytes := []byte{0, 1, 2, 3}
+	info := &Info{
+		Parameters: Parameters{
+			GenesisBytes: genesisBytes,
+		},
+		log: logging.NoLog{},
+	}
+
+	reply := GetGenesisBytesReply{}
+	require.NoError(info.GetGenesisBytes(nil, &GetGenesisBytesArgs{
+		Encoding: formatting.Hex,
+	}, &reply))
+	require.Equal(formatting.Hex, reply.Encoding)
+
+	decoded, err := formatting.Decode(reply.Encoding, reply.GenesisBytes)
+	require.NoError(err)
+	require.Equal(genesisBytes, decoded)
+}
@@ -1241,6 +1241,9 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	}
 
 	nodeConfig.UseCurrentHeight = v.GetBool(ProposerVMUseCurrentHeightKey)
+	nodeConfig.MaxValidatorsInResponse = int(v.GetUint(PlatformAPIMaxValidatorsInResponseKey))
+	nodeConfig.PlatformReadOnlyAPI = v.GetBool(PlatformAPIReadOnlyKey)
+	nodeConfig.PlatformMempoolMaxSize = int(v.GetUint(PlatformMempoolMaxSizeKey))
 
 	// Logging
 	nodeConfig.LoggingConfig, err = getLoggingConfig(v)
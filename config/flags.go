@@ -24,6 +24,7 @@ import (
 	"github.com/Juneo-io/juneogo/utils/dynamicip"
 	"github.com/Juneo-io/juneogo/utils/ulimit"
 	"github.com/Juneo-io/juneogo/utils/units"
+	"github.com/Juneo-io/juneogo/vms/platformvm/txs/mempool"
 )
 
 const (
@@ -222,6 +223,9 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Bool(KeystoreAPIEnabledKey, false, "If true, this node exposes the Keystore API")
 	fs.Bool(MetricsAPIEnabledKey, true, "If true, this node exposes the Metrics API")
 	fs.Bool(HealthAPIEnabledKey, true, "If true, this node exposes the Health API")
+	fs.Uint(PlatformAPIMaxValidatorsInResponseKey, 0, "Maximum number of validators platform.getCurrentValidators returns when no node IDs are provided. If 0, there is no limit")
+	fs.Bool(PlatformAPIReadOnlyKey, false, "If true, the Platform API rejects requests that issue transactions and only serves queries")
+	fs.Uint(PlatformMempoolMaxSizeKey, mempool.DefaultMaxSize, "Maximum number of bytes of transactions held by the P-chain mempool. When full, transactions paying lower fees per byte are evicted")
 
 	// Health Checks
 	fs.Duration(HealthCheckFreqKey, 30*time.Second, "Time between health checks")
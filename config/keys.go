@@ -137,6 +137,9 @@ const (
 	KeystoreAPIEnabledKey                              = "api-keystore-enabled"
 	MetricsAPIEnabledKey                               = "api-metrics-enabled"
 	HealthAPIEnabledKey                                = "api-health-enabled"
+	PlatformAPIMaxValidatorsInResponseKey              = "api-platform-max-validators-in-response"
+	PlatformAPIReadOnlyKey                             = "api-platform-read-only"
+	PlatformMempoolMaxSizeKey                          = "platform-mempool-max-size"
 	MeterVMsEnabledKey                                 = "meter-vms-enabled"
 	ConsensusAppConcurrencyKey                         = "consensus-app-concurrency"
 	ConsensusShutdownTimeoutKey                        = "consensus-shutdown-timeout"
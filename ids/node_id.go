@@ -90,3 +90,19 @@ func NodeIDFromString(nodeIDStr string) (NodeID, error) {
 	}
 	return NodeID(asShort), nil
 }
+
+// NodeIDToShortID returns the ShortID with the same bytes as [nodeID].
+//
+// A NodeID is the 20-byte hash of a staking certificate, so it shares its
+// representation with a ShortID. The conversion never fails and is the inverse
+// of ShortIDToNodeID.
+func NodeIDToShortID(nodeID NodeID) ShortID {
+	return ShortID(nodeID)
+}
+
+// ShortIDToNodeID returns the NodeID with the same bytes as [shortID].
+//
+// This is the inverse of NodeIDToShortID.
+func ShortIDToNodeID(shortID ShortID) NodeID {
+	return NodeID(shortID)
+}
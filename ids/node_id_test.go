@@ -216,3 +216,22 @@ func TestNodeIDCompare(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeIDShortIDRoundTrip(t *testing.T) {
+	tests := []NodeID{
+		EmptyNodeID,
+		{1},
+		{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14},
+		GenerateTestNodeID(),
+	}
+	for _, nodeID := range tests {
+		t.Run(nodeID.String(), func(t *testing.T) {
+			require := require.New(t)
+
+			shortID := NodeIDToShortID(nodeID)
+			require.Equal(nodeID.Bytes(), shortID.Bytes())
+			require.Equal(nodeID, ShortIDToNodeID(shortID))
+			require.Equal(shortID, NodeIDToShortID(ShortIDToNodeID(shortID)))
+		})
+	}
+}
@@ -205,6 +205,15 @@ type Config struct {
 	// See comment on [UseCurrentHeight] in platformvm.Config
 	UseCurrentHeight bool `json:"useCurrentHeight"`
 
+	// See comment on [MaxValidatorsInResponse] in platformvm.Config
+	MaxValidatorsInResponse int `json:"maxValidatorsInResponse"`
+
+	// See comment on [ReadOnlyAPI] in platformvm.Config
+	PlatformReadOnlyAPI bool `json:"platformReadOnlyAPI"`
+
+	// See comment on [MempoolMaxSize] in platformvm.Config
+	PlatformMempoolMaxSize int `json:"platformMempoolMaxSize"`
+
 	// ProvidedFlags contains all the flags set by the user
 	ProvidedFlags map[string]interface{} `json:"-"`
 
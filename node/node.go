@@ -1175,6 +1175,10 @@ func (n *Node) initVMs() error {
 				DurangoTime:                   version.GetDurangoTime(n.Config.NetworkID),
 				EUpgradeTime:                  eUpgradeTime,
 				UseCurrentHeight:              n.Config.UseCurrentHeight,
+				MaxValidatorsInResponse:       n.Config.MaxValidatorsInResponse,
+				ReadOnlyAPI:                   n.Config.PlatformReadOnlyAPI,
+				AdminAPIEnabled:               n.Config.AdminAPIEnabled,
+				MempoolMaxSize:                n.Config.PlatformMempoolMaxSize,
 			},
 		}),
 		n.VMManager.RegisterFactory(context.TODO(), constants.AVMID, &avm.Factory{
@@ -1354,6 +1358,8 @@ func (n *Node) initInfoAPI() error {
 			AddSupernetValidatorFee:         n.Config.AddSupernetValidatorFee,
 			AddSupernetDelegatorFee:         n.Config.AddSupernetDelegatorFee,
 			VMManager:                     n.VMManager,
+			Upgrades:                      info.NetworkUpgrades(n.Config.NetworkID),
+			GenesisBytes:                  n.Config.GenesisBytes,
 		},
 		n.Log,
 		n.vdrs,
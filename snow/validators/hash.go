@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package validators
+
+import (
+	"golang.org/x/exp/maps"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils"
+	"github.com/Juneo-io/juneogo/utils/crypto/bls"
+	"github.com/Juneo-io/juneogo/utils/hashing"
+	"github.com/Juneo-io/juneogo/utils/wrappers"
+)
+
+// hashedValidatorLen is the maximum number of bytes a validator contributes to
+// the preimage of a validator set hash: its nodeID, its weight, whether it
+// registered a public key and the public key itself.
+const hashedValidatorLen = ids.NodeIDLen + wrappers.LongLen + wrappers.BoolLen + bls.PublicKeyLen
+
+// HashValidatorSet returns a deterministic hash of [vdrs].
+//
+// The hash commits to the nodeID, weight and BLS public key of every
+// validator, ordered by nodeID, so that two parties can cheaply check whether
+// they agree on a validator set.
+func HashValidatorSet(vdrs map[ids.NodeID]*GetValidatorOutput) ids.ID {
+	nodeIDs := maps.Keys(vdrs)
+	utils.Sort(nodeIDs)
+
+	p := wrappers.Packer{
+		Bytes:   make([]byte, 0, len(nodeIDs)*hashedValidatorLen),
+		MaxSize: len(nodeIDs) * hashedValidatorLen,
+	}
+	for _, nodeID := range nodeIDs {
+		vdr := vdrs[nodeID]
+		p.PackFixedBytes(nodeID.Bytes())
+		p.PackLong(vdr.Weight)
+		p.PackBool(vdr.PublicKey != nil)
+		if vdr.PublicKey != nil {
+			p.PackFixedBytes(bls.PublicKeyToCompressedBytes(vdr.PublicKey))
+		}
+	}
+	return hashing.ComputeHash256Array(p.Bytes)
+}
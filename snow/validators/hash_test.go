@@ -0,0 +1,50 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/crypto/bls"
+)
+
+func TestHashValidatorSet(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+
+	var (
+		nodeID0 = ids.GenerateTestNodeID()
+		nodeID1 = ids.GenerateTestNodeID()
+	)
+	newSet := func(weight uint64) map[ids.NodeID]*GetValidatorOutput {
+		return map[ids.NodeID]*GetValidatorOutput{
+			nodeID0: {
+				NodeID:    nodeID0,
+				PublicKey: bls.PublicFromSecretKey(sk),
+				Weight:    1,
+			},
+			nodeID1: {
+				NodeID: nodeID1,
+				Weight: weight,
+			},
+		}
+	}
+
+	hash := HashValidatorSet(newSet(2))
+	require.Equal(hash, HashValidatorSet(newSet(2)))
+	require.NotEqual(hash, HashValidatorSet(newSet(3)))
+
+	// Dropping the public key of a validator changes the hash
+	noPKSet := newSet(2)
+	noPKSet[nodeID0] = &GetValidatorOutput{
+		NodeID: nodeID0,
+		Weight: 1,
+	}
+	require.NotEqual(hash, HashValidatorSet(noPKSet))
+}
@@ -10,7 +10,10 @@ import (
 	"github.com/Juneo-io/juneogo/ids"
 )
 
-var _ State = (*lockedState)(nil)
+var (
+	_ State     = (*lockedState)(nil)
+	_ SetsState = (*lockedSetsState)(nil)
+)
 
 // State allows the lookup of validator sets on specified supernets at the
 // requested P-chain height.
@@ -78,6 +81,42 @@ func (s *lockedState) GetValidatorSet(
 	return s.s.GetValidatorSet(ctx, height, supernetID)
 }
 
+// SetsState allows the lookup of the validator sets of several supernets at
+// once.
+type SetsState interface {
+	// GetValidatorSets returns the validators of the provided supernets at the
+	// requested P-chain height, keyed by supernet ID.
+	// The returned maps should not be modified.
+	GetValidatorSets(
+		ctx context.Context,
+		height uint64,
+		supernetIDs []ids.ID,
+	) (map[ids.ID]map[ids.NodeID]*GetValidatorOutput, error)
+}
+
+type lockedSetsState struct {
+	lock sync.Locker
+	s    SetsState
+}
+
+func NewLockedSetsState(lock sync.Locker, s SetsState) SetsState {
+	return &lockedSetsState{
+		lock: lock,
+		s:    s,
+	}
+}
+
+func (s *lockedSetsState) GetValidatorSets(
+	ctx context.Context,
+	height uint64,
+	supernetIDs []ids.ID,
+) (map[ids.ID]map[ids.NodeID]*GetValidatorOutput, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.s.GetValidatorSets(ctx, height, supernetIDs)
+}
+
 type noValidators struct {
 	State
 }
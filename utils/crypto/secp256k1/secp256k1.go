@@ -51,6 +51,7 @@ var (
 	errInvalidPublicKeyLength  = fmt.Errorf("public key has unexpected length, expected %d", PublicKeyLen)
 	errInvalidSigLen           = errors.New("invalid signature length")
 	errMutatedSig              = errors.New("signature was mutated from its original format")
+	errEmptySeed               = errors.New("seed must not be empty")
 )
 
 func NewPrivateKey() (*PrivateKey, error) {
@@ -58,6 +59,27 @@ func NewPrivateKey() (*PrivateKey, error) {
 	return &PrivateKey{sk: k}, err
 }
 
+// NewPrivateKeyFromSeed deterministically derives a private key from [seed].
+// The same seed always yields the same key, which makes it possible to
+// reproduce runs that depend on generated keys. It must not be used to
+// generate keys that hold real funds unless [seed] has enough entropy.
+func NewPrivateKeyFromSeed(seed []byte) (*PrivateKey, error) {
+	if len(seed) == 0 {
+		return nil, errEmptySeed
+	}
+
+	// Rehash until the digest is a valid scalar. The probability of needing
+	// more than one iteration is negligible.
+	skBytes := hashing.ComputeHash256(seed)
+	for {
+		var scalar secp256k1.ModNScalar
+		if overflow := scalar.SetByteSlice(skBytes); !overflow && !scalar.IsZero() {
+			return ToPrivateKey(skBytes)
+		}
+		skBytes = hashing.ComputeHash256(skBytes)
+	}
+}
+
 func ToPublicKey(b []byte) (*PublicKey, error) {
 	if len(b) != PublicKeyLen {
 		return nil, errInvalidPublicKeyLength
@@ -83,6 +83,23 @@ func TestGenRecreate(t *testing.T) {
 	}
 }
 
+func TestNewPrivateKeyFromSeed(t *testing.T) {
+	require := require.New(t)
+
+	_, err := NewPrivateKeyFromSeed(nil)
+	require.ErrorIs(err, errEmptySeed)
+
+	sk0, err := NewPrivateKeyFromSeed([]byte("seed"))
+	require.NoError(err)
+	sk1, err := NewPrivateKeyFromSeed([]byte("seed"))
+	require.NoError(err)
+	require.Equal(sk0.Bytes(), sk1.Bytes())
+
+	sk2, err := NewPrivateKeyFromSeed([]byte("other seed"))
+	require.NoError(err)
+	require.NotEqual(sk0.Bytes(), sk2.Bytes())
+}
+
 func TestVerifyMutatedSignature(t *testing.T) {
 	require := require.New(t)
 
@@ -0,0 +1,18 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpc
+
+import "time"
+
+// MinPollFrequency is the shortest interval at which clients poll a node while
+// waiting for a tx to be decided. Shorter intervals requested by callers are
+// raised to this value so that a node isn't flooded with requests.
+//
+// Tests may override this value.
+var MinPollFrequency = 10 * time.Millisecond
+
+// PollFrequency returns [freq] clamped to be at least [MinPollFrequency].
+func PollFrequency(freq time.Duration) time.Duration {
+	return max(freq, MinPollFrequency)
+}
@@ -0,0 +1,27 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPollFrequency(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal(MinPollFrequency, PollFrequency(time.Nanosecond))
+	require.Equal(MinPollFrequency, PollFrequency(0))
+	require.Equal(time.Second, PollFrequency(time.Second))
+
+	defaultMinPollFrequency := MinPollFrequency
+	t.Cleanup(func() {
+		MinPollFrequency = defaultMinPollFrequency
+	})
+
+	MinPollFrequency = time.Minute
+	require.Equal(time.Minute, PollFrequency(time.Second))
+}
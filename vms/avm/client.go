@@ -38,9 +38,17 @@ type Client interface {
 	// ConfirmTx attempts to confirm [txID] by repeatedly checking its status.
 	// Note: ConfirmTx will block until either the context is done or the client
 	//       returns a decided status.
+	// [freq] is raised to [rpc.MinPollFrequency] if it is shorter.
 	// TODO: Move this function off of the Client interface into a utility
 	// function.
 	ConfirmTx(ctx context.Context, txID ids.ID, freq time.Duration, options ...rpc.Option) (choices.Status, error)
+	// ConfirmTxs attempts to confirm all of [txIDs] by repeatedly checking
+	// the status of the ones that aren't decided yet. Returns the last known
+	// status of each tx.
+	// Note: ConfirmTxs will block until either the context is done, one of
+	//       the txs is rejected, or all of the txs are accepted.
+	// [freq] is raised to [rpc.MinPollFrequency] if it is shorter.
+	ConfirmTxs(ctx context.Context, txIDs []ids.ID, freq time.Duration, options ...rpc.Option) (map[ids.ID]choices.Status, error)
 	// GetTx returns the byte representation of [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetUTXOs returns the byte representation of the UTXOs controlled by [addrs]
@@ -287,7 +295,7 @@ func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Op
 }
 
 func (c *client) ConfirmTx(ctx context.Context, txID ids.ID, freq time.Duration, options ...rpc.Option) (choices.Status, error) {
-	ticker := time.NewTicker(freq)
+	ticker := time.NewTicker(rpc.PollFrequency(freq))
 	defer ticker.Stop()
 
 	for {
@@ -306,6 +314,49 @@ func (c *client) ConfirmTx(ctx context.Context, txID ids.ID, freq time.Duration,
 	}
 }
 
+func (c *client) ConfirmTxs(ctx context.Context, txIDs []ids.ID, freq time.Duration, options ...rpc.Option) (map[ids.ID]choices.Status, error) {
+	ticker := time.NewTicker(rpc.PollFrequency(freq))
+	defer ticker.Stop()
+
+	statuses := make(map[ids.ID]choices.Status, len(txIDs))
+	for _, txID := range txIDs {
+		statuses[txID] = choices.Unknown
+	}
+
+	for {
+		numAccepted := 0
+		for txID, status := range statuses {
+			// Accepted txs don't need to be polled again.
+			if status == choices.Accepted {
+				numAccepted++
+				continue
+			}
+
+			status, err := c.GetTxStatus(ctx, txID, options...)
+			if err != nil {
+				continue
+			}
+			statuses[txID] = status
+
+			switch status {
+			case choices.Accepted:
+				numAccepted++
+			case choices.Rejected:
+				return statuses, nil
+			}
+		}
+		if numAccepted == len(statuses) {
+			return statuses, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return statuses, ctx.Err()
+		}
+	}
+}
+
 func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedTx{}
 	err := c.requester.SendRequest(ctx, "jvm.getTx", &api.GetTxArgs{
@@ -6,11 +6,13 @@ package avm
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/Juneo-io/juneogo/api"
 	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/snow/choices"
 	"github.com/Juneo-io/juneogo/utils/json"
 	"github.com/Juneo-io/juneogo/utils/rpc"
 )
@@ -202,3 +204,87 @@ func TestClientCreateFixedCapAsset(t *testing.T) {
 		require.NoError(err)
 	}
 }
+
+type txStatusClient struct {
+	// statuses are returned in order, the last one being repeated
+	statuses map[ids.ID][]choices.Status
+	numCalls map[ids.ID]int
+}
+
+func (c *txStatusClient) SendRequest(
+	_ context.Context,
+	_ string,
+	inData interface{},
+	outData interface{},
+	_ ...rpc.Option,
+) error {
+	txID := inData.(*api.JSONTxID).TxID
+	statuses := c.statuses[txID]
+	index := min(c.numCalls[txID], len(statuses)-1)
+	c.numCalls[txID]++
+	outData.(*GetTxStatusReply).Status = statuses[index]
+	return nil
+}
+
+func TestClientConfirmTxs(t *testing.T) {
+	var (
+		txID0 = ids.GenerateTestID()
+		txID1 = ids.GenerateTestID()
+	)
+
+	tests := []struct {
+		name             string
+		statuses         map[ids.ID][]choices.Status
+		expectedStatuses map[ids.ID]choices.Status
+		expectedNumCalls map[ids.ID]int
+	}{
+		{
+			name: "all accepted",
+			statuses: map[ids.ID][]choices.Status{
+				txID0: {choices.Accepted},
+				txID1: {choices.Processing, choices.Processing, choices.Accepted},
+			},
+			expectedStatuses: map[ids.ID]choices.Status{
+				txID0: choices.Accepted,
+				txID1: choices.Accepted,
+			},
+			expectedNumCalls: map[ids.ID]int{
+				txID0: 1,
+				txID1: 3,
+			},
+		},
+		{
+			name: "rejected",
+			statuses: map[ids.ID][]choices.Status{
+				txID0: {choices.Processing, choices.Rejected},
+				txID1: {choices.Processing},
+			},
+			expectedStatuses: map[ids.ID]choices.Status{
+				txID0: choices.Rejected,
+				txID1: choices.Processing,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			requester := &txStatusClient{
+				statuses: test.statuses,
+				numCalls: make(map[ids.ID]int),
+			}
+			client := client{requester: requester}
+
+			statuses, err := client.ConfirmTxs(
+				context.Background(),
+				[]ids.ID{txID0, txID1},
+				time.Millisecond,
+			)
+			require.NoError(err)
+			require.Equal(test.expectedStatuses, statuses)
+			if test.expectedNumCalls != nil {
+				require.Equal(test.expectedNumCalls, requester.numCalls)
+			}
+		})
+	}
+}
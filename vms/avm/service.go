@@ -33,9 +33,13 @@ import (
 )
 
 const (
-	// Max number of addresses that can be passed in as argument to GetUTXOs
+	// Max number of addresses GetUTXOs searches at once. More addresses are
+	// searched in chunks of at most this size.
 	maxGetUTXOsAddrs = 1024
 
+	// Max number of addresses that can be passed in as argument to GetUTXOs
+	maxGetUTXOsTotalAddrs = 8 * maxGetUTXOsAddrs
+
 	// Max number of items allowed in a page
 	maxPageSize uint64 = 1024
 )
@@ -378,8 +382,8 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, reply *api.G
 	if len(args.Addresses) == 0 {
 		return errNoAddresses
 	}
-	if len(args.Addresses) > maxGetUTXOsAddrs {
-		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	if len(args.Addresses) > maxGetUTXOsTotalAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsTotalAddrs)
 	}
 
 	var sourceChain ids.ID
@@ -424,25 +428,37 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, reply *api.G
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	if sourceChain == s.vm.ctx.ChainID {
-		utxos, endAddr, endUTXOID, err = avax.GetPaginatedUTXOs(
-			s.vm.state,
-			addrSet,
-			startAddr,
-			startUTXO,
-			limit,
-		)
-	} else {
-		utxos, endAddr, endUTXOID, err = avax.GetAtomicUTXOs(
+	fetch := func(addrs set.Set[ids.ShortID], startAddr ids.ShortID, startUTXO ids.ID, limit int) ([]*avax.UTXO, ids.ShortID, ids.ID, error) {
+		if sourceChain == s.vm.ctx.ChainID {
+			return avax.GetPaginatedUTXOs(
+				s.vm.state,
+				addrs,
+				startAddr,
+				startUTXO,
+				limit,
+			)
+		}
+		return avax.GetAtomicUTXOs(
 			s.vm.ctx.SharedMemory,
 			s.vm.parser.Codec(),
 			sourceChain,
-			addrSet,
+			addrs,
 			startAddr,
 			startUTXO,
 			limit,
 		)
 	}
+
+	// More than [maxGetUTXOsAddrs] addresses are searched in sorted chunks, so
+	// the end index also identifies the chunk to resume from.
+	utxos, endAddr, endUTXOID, err = avax.GetChunkedUTXOs(
+		addrSet,
+		startAddr,
+		startUTXO,
+		limit,
+		maxGetUTXOsAddrs,
+		fetch,
+	)
 	if err != nil {
 		return fmt.Errorf("problem retrieving UTXOs: %w", err)
 	}
@@ -108,3 +108,81 @@ func GetPaginatedUTXOs(
 	}
 	return utxos, lastAddr, lastUTXOID, nil // Didn't reach the [limit] utxos; no more were found
 }
+
+// UTXOPageFetcher fetches a single page of UTXOs referenced by [addrs] with the
+// same semantics as [GetPaginatedUTXOs].
+type UTXOPageFetcher func(
+	addrs set.Set[ids.ShortID],
+	startAddr ids.ShortID,
+	startUTXOID ids.ID,
+	limit int,
+) ([]*UTXO, ids.ShortID, ids.ID, error)
+
+// GetChunkedUTXOs returns UTXOs such that at least one of the addresses in
+// [addrs] is referenced, without ever passing more than [chunkSize] addresses
+// to [fetch].
+//
+// [addrs] are sorted and split into chunks of at most [chunkSize] addresses.
+// Chunks are searched in order, starting with the chunk that contains
+// [startAddr], until [limit] UTXOs are found. Because every chunk only
+// contains addresses greater than the ones of the previous chunks, the
+// returned address and UTXO ID identify the chunk to resume from and pages are
+// ordered exactly as if [addrs] had been passed to [GetPaginatedUTXOs].
+//
+// A UTXO is only returned once per call, even if it is referenced by
+// addresses of different chunks. It may however be returned again by a later
+// call resuming from the returned index, as UTXOs returned by previous calls
+// aren't tracked.
+func GetChunkedUTXOs(
+	addrs set.Set[ids.ShortID],
+	startAddr ids.ShortID,
+	startUTXOID ids.ID,
+	limit int,
+	chunkSize int,
+	fetch UTXOPageFetcher,
+) ([]*UTXO, ids.ShortID, ids.ID, error) {
+	var (
+		utxos     []*UTXO
+		seen      set.Set[ids.ID] // IDs of UTXOs already in the list
+		addrsList = addrs.List()
+		lastAddr  = startAddr
+		lastUTXO  = startUTXOID
+	)
+	utils.Sort(addrsList)
+	for len(addrsList) > 0 {
+		chunk := addrsList[:min(chunkSize, len(addrsList))]
+		addrsList = addrsList[len(chunk):]
+
+		// Skip chunks that only contain addresses before [lastAddr]
+		if bytes.Compare(chunk[len(chunk)-1].Bytes(), lastAddr.Bytes()) == -1 {
+			continue
+		}
+
+		chunkAddrs := set.Of(chunk...)
+		for {
+			searchSize := limit - len(utxos)
+			fetched, endAddr, endUTXO, err := fetch(chunkAddrs, lastAddr, lastUTXO, searchSize)
+			if err != nil {
+				return nil, ids.ShortID{}, ids.ID{}, err
+			}
+			for _, utxo := range fetched {
+				utxoID := utxo.InputID()
+				if seen.Contains(utxoID) {
+					continue
+				}
+				utxos = append(utxos, utxo)
+				seen.Add(utxoID)
+			}
+
+			progressed := endAddr != lastAddr || endUTXO != lastUTXO
+			lastAddr, lastUTXO = endAddr, endUTXO
+			if len(utxos) >= limit {
+				return utxos, lastAddr, lastUTXO, nil // Found [limit] utxos; stop.
+			}
+			if len(fetched) < searchSize || !progressed {
+				break // This chunk has no more utxos
+			}
+		}
+	}
+	return utxos, lastAddr, lastUTXO, nil // Didn't reach the [limit] utxos; no more were found
+}
@@ -158,3 +158,74 @@ func TestGetPaginatedUTXOs(t *testing.T) {
 	require.NoError(err)
 	require.Len(notPaginatedUTXOs, len(totalUTXOs))
 }
+
+func TestGetChunkedUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	c := linearcodec.NewDefault()
+	manager := codec.NewDefaultManager()
+
+	require.NoError(c.RegisterType(&secp256k1fx.TransferOutput{}))
+	require.NoError(manager.RegisterCodec(codecVersion, c))
+
+	db := memdb.New()
+	s, err := NewUTXOState(db, manager, trackChecksum)
+	require.NoError(err)
+
+	// Create 3 UTXOs on each of 10 addresses, leaving some addresses empty.
+	addrs := set.Set[ids.ShortID]{}
+	for i := 0; i < 10; i++ {
+		addr := ids.GenerateTestShortID()
+		addrs.Add(addr)
+		if i%4 == 0 {
+			continue
+		}
+		for j := 0; j < 3; j++ {
+			utxo := &UTXO{
+				UTXOID: UTXOID{
+					TxID:        ids.GenerateTestID(),
+					OutputIndex: uint32(j),
+				},
+				Asset: Asset{ID: ids.GenerateTestID()},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 12345,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{addr},
+					},
+				},
+			}
+			require.NoError(s.PutUTXO(utxo))
+		}
+	}
+
+	const (
+		limit     = 4
+		chunkSize = 3
+	)
+	fetch := func(addrs set.Set[ids.ShortID], startAddr ids.ShortID, startUTXOID ids.ID, limit int) ([]*UTXO, ids.ShortID, ids.ID, error) {
+		require.LessOrEqual(addrs.Len(), chunkSize)
+		return GetPaginatedUTXOs(s, addrs, startAddr, startUTXOID, limit)
+	}
+
+	var (
+		fetched   []*UTXO
+		startAddr = ids.ShortEmpty
+		startUTXO = ids.Empty
+	)
+	for {
+		utxos, endAddr, endUTXO, err := GetChunkedUTXOs(addrs, startAddr, startUTXO, limit, chunkSize, fetch)
+		require.NoError(err)
+		require.LessOrEqual(len(utxos), limit)
+
+		fetched = append(fetched, utxos...)
+		if len(utxos) < limit {
+			break
+		}
+		startAddr, startUTXO = endAddr, endUTXO
+	}
+
+	expected, err := GetAllUTXOs(s, addrs)
+	require.NoError(err)
+	require.Equal(expected, fetched)
+}
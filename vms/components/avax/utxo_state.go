@@ -13,6 +13,7 @@ import (
 	"github.com/Juneo-io/juneogo/database/linkeddb"
 	"github.com/Juneo-io/juneogo/database/prefixdb"
 	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/set"
 )
 
 const (
@@ -21,8 +22,11 @@ const (
 )
 
 var (
-	utxoPrefix  = []byte("utxo")
-	indexPrefix = []byte("index")
+	utxoPrefix       = []byte("utxo")
+	indexPrefix      = []byte("index")
+	assetCountPrefix = []byte("assetCount")
+
+	assetCountsInitializedKey = []byte("assetCountsInitialized")
 )
 
 // UTXOState is a thin wrapper around a database to provide, caching,
@@ -33,6 +37,11 @@ type UTXOState interface {
 
 	// Checksum returns the current UTXOChecksum.
 	Checksum() ids.ID
+
+	// AssetIDs returns the IDs of every asset that has at least one UTXO
+	// stored in this state. The result is served from a per-asset UTXO count
+	// that is persisted alongside the UTXOs by PutUTXO and DeleteUTXO.
+	AssetIDs() (set.Set[ids.ID], error)
 }
 
 // UTXOReader is a thin wrapper around a database to provide fetching of UTXOs.
@@ -82,6 +91,11 @@ type utxoState struct {
 
 	trackChecksum bool
 	checksum      ids.ID
+
+	// Asset ID -> number of UTXOs of the asset. Assets without UTXOs aren't
+	// present.
+	assetCounts  map[ids.ID]uint64
+	assetCountDB database.Database
 }
 
 func NewUTXOState(
@@ -99,8 +113,13 @@ func NewUTXOState(
 		indexCache: &cache.LRU[string, linkeddb.LinkedDB]{Size: indexCacheSize},
 
 		trackChecksum: trackChecksum,
+
+		assetCountDB: prefixdb.New(assetCountPrefix, db),
 	}
-	return s, s.initChecksum()
+	if err := s.initChecksum(); err != nil {
+		return nil, err
+	}
+	return s, s.initAssetCounts(db)
 }
 
 func NewMeteredUTXOState(
@@ -139,8 +158,13 @@ func NewMeteredUTXOState(
 		indexCache: indexCache,
 
 		trackChecksum: trackChecksum,
+
+		assetCountDB: prefixdb.New(assetCountPrefix, db),
 	}
-	return s, s.initChecksum()
+	if err := s.initChecksum(); err != nil {
+		return nil, err
+	}
+	return s, s.initAssetCounts(db)
 }
 
 func (s *utxoState) GetUTXO(utxoID ids.ID) (*UTXO, error) {
@@ -177,6 +201,11 @@ func (s *utxoState) PutUTXO(utxo *UTXO) error {
 	}
 
 	utxoID := utxo.InputID()
+	prevUTXO, err := s.GetUTXO(utxoID)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+
 	s.updateChecksum(utxoID)
 
 	s.utxoCache.Put(utxoID, utxo)
@@ -184,6 +213,19 @@ func (s *utxoState) PutUTXO(utxo *UTXO) error {
 		return err
 	}
 
+	// Overwriting a UTXO only changes the counts if the asset changed.
+	assetID := utxo.AssetID()
+	if prevUTXO == nil || prevUTXO.AssetID() != assetID {
+		if prevUTXO != nil {
+			if err := s.decrementAssetCount(prevUTXO.AssetID()); err != nil {
+				return err
+			}
+		}
+		if err := s.incrementAssetCount(assetID); err != nil {
+			return err
+		}
+	}
+
 	addressable, ok := utxo.Out.(Addressable)
 	if !ok {
 		return nil
@@ -214,6 +256,9 @@ func (s *utxoState) DeleteUTXO(utxoID ids.ID) error {
 	if err := s.utxoDB.Delete(utxoID[:]); err != nil {
 		return err
 	}
+	if err := s.decrementAssetCount(utxo.AssetID()); err != nil {
+		return err
+	}
 
 	addressable, ok := utxo.Out.(Addressable)
 	if !ok {
@@ -255,6 +300,32 @@ func (s *utxoState) Checksum() ids.ID {
 	return s.checksum
 }
 
+func (s *utxoState) AssetIDs() (set.Set[ids.ID], error) {
+	assetIDs := set.NewSet[ids.ID](len(s.assetCounts))
+	for assetID := range s.assetCounts {
+		assetIDs.Add(assetID)
+	}
+	return assetIDs, nil
+}
+
+func (s *utxoState) incrementAssetCount(assetID ids.ID) error {
+	count := s.assetCounts[assetID] + 1
+	s.assetCounts[assetID] = count
+	return database.PutUInt64(s.assetCountDB, assetID[:], count)
+}
+
+func (s *utxoState) decrementAssetCount(assetID ids.ID) error {
+	count := s.assetCounts[assetID]
+	if count <= 1 {
+		delete(s.assetCounts, assetID)
+		return s.assetCountDB.Delete(assetID[:])
+	}
+
+	count--
+	s.assetCounts[assetID] = count
+	return database.PutUInt64(s.assetCountDB, assetID[:], count)
+}
+
 func (s *utxoState) getIndexDB(addr []byte) linkeddb.LinkedDB {
 	addrStr := string(addr)
 	if indexList, exists := s.indexCache.Get(addrStr); exists {
@@ -292,3 +363,58 @@ func (s *utxoState) updateChecksum(modifiedID ids.ID) {
 
 	s.checksum = s.checksum.XOR(modifiedID)
 }
+
+// initAssetCounts loads the persisted per-asset UTXO counts. If they were never
+// written, which happens the first time a database created before they existed
+// is opened, they are computed from the stored UTXOs and persisted once.
+func (s *utxoState) initAssetCounts(db database.Database) error {
+	s.assetCounts = make(map[ids.ID]uint64)
+
+	initialized, err := db.Has(assetCountsInitializedKey)
+	if err != nil {
+		return err
+	}
+	if initialized {
+		it := s.assetCountDB.NewIterator()
+		defer it.Release()
+
+		for it.Next() {
+			assetID, err := ids.ToID(it.Key())
+			if err != nil {
+				return err
+			}
+			count, err := database.ParseUInt64(it.Value())
+			if err != nil {
+				return err
+			}
+			s.assetCounts[assetID] = count
+		}
+		return it.Error()
+	}
+
+	// Remove any count left by a partially written initialization.
+	if err := database.AtomicClear(s.assetCountDB, s.assetCountDB); err != nil {
+		return err
+	}
+
+	it := s.utxoDB.NewIterator()
+	defer it.Release()
+
+	for it.Next() {
+		utxo := &UTXO{}
+		if _, err := s.codec.Unmarshal(it.Value(), utxo); err != nil {
+			return err
+		}
+		s.assetCounts[utxo.AssetID()]++
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	for assetID, count := range s.assetCounts {
+		if err := database.PutUInt64(s.assetCountDB, assetID[:], count); err != nil {
+			return err
+		}
+	}
+	return db.Put(assetCountsInitializedKey, nil)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/Juneo-io/juneogo/database"
 	"github.com/Juneo-io/juneogo/database/memdb"
 	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/set"
 	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
 )
 
@@ -92,3 +93,88 @@ func TestUTXOState(t *testing.T) {
 	require.NoError(err)
 	require.Equal([]ids.ID{utxoID}, utxoIDs)
 }
+
+func TestUTXOStateAssetIDs(t *testing.T) {
+	require := require.New(t)
+
+	c := linearcodec.NewDefault()
+	manager := codec.NewDefaultManager()
+
+	require.NoError(c.RegisterType(&secp256k1fx.TransferOutput{}))
+	require.NoError(manager.RegisterCodec(codecVersion, c))
+
+	txID := ids.GenerateTestID()
+	assetID := ids.GenerateTestID()
+	otherAssetID := ids.GenerateTestID()
+	out := &secp256k1fx.TransferOutput{
+		Amt: 12345,
+		OutputOwners: secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		},
+	}
+	utxo := &UTXO{
+		UTXOID: UTXOID{TxID: txID},
+		Asset:  Asset{ID: assetID},
+		Out:    out,
+	}
+	otherUTXO := &UTXO{
+		UTXOID: UTXOID{
+			TxID:        txID,
+			OutputIndex: 1,
+		},
+		Asset: Asset{ID: otherAssetID},
+		Out:   out,
+	}
+
+	db := memdb.New()
+	s, err := NewUTXOState(db, manager, trackChecksum)
+	require.NoError(err)
+
+	assetIDs, err := s.AssetIDs()
+	require.NoError(err)
+	require.Empty(assetIDs)
+
+	require.NoError(s.PutUTXO(utxo))
+	require.NoError(s.PutUTXO(otherUTXO))
+
+	assetIDs, err = s.AssetIDs()
+	require.NoError(err)
+	require.Equal(set.Of(assetID, otherAssetID), assetIDs)
+
+	// Overwriting a UTXO must not count it twice, so deleting it afterwards
+	// removes its asset.
+	require.NoError(s.PutUTXO(utxo))
+	require.NoError(s.DeleteUTXO(utxo.InputID()))
+
+	assetIDs, err = s.AssetIDs()
+	require.NoError(err)
+	require.Equal(set.Of(otherAssetID), assetIDs)
+
+	// The counts are persisted.
+	s, err = NewUTXOState(db, manager, trackChecksum)
+	require.NoError(err)
+
+	assetIDs, err = s.AssetIDs()
+	require.NoError(err)
+	require.Equal(set.Of(otherAssetID), assetIDs)
+
+	// A database written before the counts existed has them computed from
+	// the stored UTXOs.
+	require.NoError(db.Delete(assetCountsInitializedKey))
+	require.NoError(s.PutUTXO(utxo))
+
+	s, err = NewUTXOState(db, manager, trackChecksum)
+	require.NoError(err)
+
+	assetIDs, err = s.AssetIDs()
+	require.NoError(err)
+	require.Equal(set.Of(assetID, otherAssetID), assetIDs)
+
+	require.NoError(s.DeleteUTXO(utxo.InputID()))
+	require.NoError(s.DeleteUTXO(otherUTXO.InputID()))
+
+	assetIDs, err = s.AssetIDs()
+	require.NoError(err)
+	require.Empty(assetIDs)
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+
+	"github.com/Juneo-io/juneogo/api"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/rpc"
+)
+
+var _ AdminClient = (*adminClient)(nil)
+
+// AdminClient interface for interacting with the admin endpoints of the P
+// Chain
+type AdminClient interface {
+	// WarmBalanceCache keeps the UTXOs referencing [addrs] in memory so that
+	// GetBalance calls for exactly [addrs] don't scan them. Returns the number
+	// of UTXOs referencing [addrs].
+	WarmBalanceCache(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (uint64, error)
+}
+
+// adminClient implementation for interacting with the admin endpoints of the
+// P Chain
+type adminClient struct {
+	requester rpc.EndpointRequester
+}
+
+// NewAdminClient returns a client to interact with the admin endpoints of the
+// P Chain, which are only served by nodes with the admin API enabled
+func NewAdminClient(uri string) AdminClient {
+	return &adminClient{requester: rpc.NewEndpointRequester(
+		uri + "/ext/P/admin",
+	)}
+}
+
+func (c *adminClient) WarmBalanceCache(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (uint64, error) {
+	res := &WarmBalanceCacheReply{}
+	err := c.requester.SendRequest(ctx, "admin.warmBalanceCache", &api.JSONAddresses{
+		Addresses: ids.ShortIDsToStrings(addrs),
+	}, res, options...)
+	return uint64(res.NumUTXOs), err
+}
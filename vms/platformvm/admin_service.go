@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/Juneo-io/juneogo/api"
+	"github.com/Juneo-io/juneogo/utils/logging"
+	"github.com/Juneo-io/juneogo/vms/components/avax"
+
+	avajson "github.com/Juneo-io/juneogo/utils/json"
+)
+
+// Max number of addresses that can be passed in as argument to
+// WarmBalanceCache
+const maxWarmBalanceCacheAddrs = 256
+
+// AdminService defines the API calls of the platform chain that change the
+// state of the node. It is only served when the admin API is enabled.
+type AdminService struct {
+	vm           *VM
+	addrManager  avax.AddressManager
+	balanceCache *balanceCache
+}
+
+// WarmBalanceCacheReply is the response from calling WarmBalanceCache
+type WarmBalanceCacheReply struct {
+	// Number of UTXOs referencing the addresses
+	NumUTXOs avajson.Uint64 `json:"numUTXOs"`
+}
+
+// WarmBalanceCache keeps the UTXOs referencing [args.Addresses] in memory so
+// that GetBalance doesn't scan them on every call. Only GetBalance calls
+// providing exactly the same set of addresses are served from the cache.
+//
+// The cached UTXOs are fetched again once a block touching the addresses is
+// accepted. At most [balanceCacheSize] address sets are cached, the least
+// recently used ones being evicted first.
+func (s *AdminService) WarmBalanceCache(_ *http.Request, args *api.JSONAddresses, reply *WarmBalanceCacheReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "warmBalanceCache"),
+		logging.UserStrings("addresses", args.Addresses),
+	)
+
+	if s.vm.ReadOnlyAPI {
+		return ErrReadOnlyNode
+	}
+
+	if len(args.Addresses) > maxWarmBalanceCacheAddrs {
+		return fmt.Errorf("%d addresses provided but this method can take at most %d", len(args.Addresses), maxWarmBalanceCacheAddrs)
+	}
+
+	addrs, err := avax.ParseServiceAddresses(s.addrManager, args.Addresses)
+	if err != nil {
+		return err
+	}
+	if addrs.Len() == 0 {
+		return errNoAddresses
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	utxos, err := s.balanceCache.warm(addrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get UTXO set of %v: %w", args.Addresses, err)
+	}
+
+	reply.NumUTXOs = avajson.Uint64(len(utxos))
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/api"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/crypto/secp256k1"
+	"github.com/Juneo-io/juneogo/vms/components/avax"
+	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
+
+	avajson "github.com/Juneo-io/juneogo/utils/json"
+	blockexecutor "github.com/Juneo-io/juneogo/vms/platformvm/block/executor"
+)
+
+func TestAdminHandler(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	handlers, err := service.vm.CreateHandlers(context.Background())
+	require.NoError(err)
+	require.NotContains(handlers, "/admin")
+
+	service.vm.AdminAPIEnabled = true
+	handlers, err = service.vm.CreateHandlers(context.Background())
+	require.NoError(err)
+	require.Contains(handlers, "/admin")
+}
+
+func TestWarmBalanceCache(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	adminService := &AdminService{
+		vm:           service.vm,
+		addrManager:  service.addrManager,
+		balanceCache: service.balanceCache,
+	}
+
+	// [uncached] shares the VM of [service] but never warmed its cache.
+	uncached := &Service{
+		vm:                    service.vm,
+		addrManager:           service.addrManager,
+		stakerAttributesCache: service.stakerAttributesCache,
+		balanceCache:          newBalanceCache(service.vm.state),
+	}
+	addr, err := service.addrManager.FormatLocalAddress(keys[0].Address())
+	require.NoError(err)
+
+	requireBalance := func() GetBalanceResponse {
+		args := &GetBalanceRequest{
+			Addresses: []string{addr},
+		}
+		var expected, balance GetBalanceResponse
+		require.NoError(uncached.GetBalance(nil, args, &expected))
+		require.NoError(service.GetBalance(nil, args, &balance))
+		require.Equal(expected, balance)
+		return balance
+	}
+
+	reply := WarmBalanceCacheReply{}
+	require.NoError(adminService.WarmBalanceCache(nil, &api.JSONAddresses{
+		Addresses: []string{addr},
+	}, &reply))
+	initialBalance := requireBalance()
+	require.Equal(avajson.Uint64(len(initialBalance.UTXOIDs)), reply.NumUTXOs)
+
+	// Issues a tx sending funds of [key] to a new address.
+	issueSend := func(key *secp256k1.PrivateKey) {
+		service.vm.ctx.Lock.Lock()
+		tx, err := txBuilder.NewBaseTx(
+			[]*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: service.vm.ctx.JUNEAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 1,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+					},
+				},
+			}},
+			[]*secp256k1.PrivateKey{key},
+		)
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(err)
+		require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+	}
+	// Builds and verifies a block on top of the last accepted block.
+	buildBlock := func() *blockexecutor.Block {
+		service.vm.ctx.Lock.Lock()
+		defer service.vm.ctx.Lock.Unlock()
+
+		blk, err := service.vm.BuildBlock(context.Background())
+		require.NoError(err)
+		require.NoError(blk.Verify(context.Background()))
+		return blk.(*blockexecutor.Block)
+	}
+	decide := func(blk *blockexecutor.Block, accept bool) {
+		service.vm.ctx.Lock.Lock()
+		defer service.vm.ctx.Lock.Unlock()
+
+		if accept {
+			require.NoError(blk.Accept(context.Background()))
+		} else {
+			require.NoError(blk.Reject(context.Background()))
+		}
+		require.NoError(service.vm.SetPreference(context.Background(), service.vm.manager.LastAccepted()))
+	}
+
+	// Blocks that don't touch the address don't change its balance.
+	issueSend(keys[1])
+	decide(buildBlock(), true)
+	require.Equal(initialBalance, requireBalance())
+
+	// Processing and rejected blocks don't change its balance.
+	issueSend(keys[0])
+	blk := buildBlock()
+	require.Equal(initialBalance, requireBalance())
+	decide(blk, false)
+	require.Equal(initialBalance, requireBalance())
+
+	// Accepted blocks touching the address are reflected in its balance. The
+	// tx of the rejected block is back in the mempool, so it's built again.
+	decide(buildBlock(), true)
+	require.Less(requireBalance().Balance, initialBalance.Balance)
+
+	err = adminService.WarmBalanceCache(nil, &api.JSONAddresses{}, &reply)
+	require.ErrorIs(err, errNoAddresses)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
 	"github.com/Juneo-io/juneogo/vms/platformvm/txs/txheap"
 	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
+	"github.com/Juneo-io/juneogo/vms/types"
 )
 
 // Note that since an Avalanche network has exactly one Platform Chain,
@@ -115,15 +116,22 @@ type PermissionlessValidator struct {
 	ValidationRewardOwner *Owner `json:"validationRewardOwner,omitempty"`
 	// The owner of the rewards from delegations during the validation period,
 	// if applicable.
-	DelegationRewardOwner  *Owner                    `json:"delegationRewardOwner,omitempty"`
-	PotentialReward        *json.Uint64              `json:"potentialReward,omitempty"`
-	AccruedDelegateeReward *json.Uint64              `json:"accruedDelegateeReward,omitempty"`
-	DelegationFee          json.Float32              `json:"delegationFee"`
-	ExactDelegationFee     *json.Uint32              `json:"exactDelegationFee,omitempty"`
-	Uptime                 *json.Float32             `json:"uptime,omitempty"`
-	Connected              bool                      `json:"connected"`
-	Staked                 []UTXO                    `json:"staked,omitempty"`
-	Signer                 *signer.ProofOfPossession `json:"signer,omitempty"`
+	DelegationRewardOwner *Owner       `json:"delegationRewardOwner,omitempty"`
+	PotentialReward       *json.Uint64 `json:"potentialReward,omitempty"`
+	// An estimate of the potential reward of a validator that has not started
+	// validating yet. The actual potential reward is computed once the
+	// validator starts.
+	EstimatedPotentialReward *json.Uint64              `json:"estimatedPotentialReward,omitempty"`
+	AccruedDelegateeReward   *json.Uint64              `json:"accruedDelegateeReward,omitempty"`
+	DelegationFee            json.Float32              `json:"delegationFee"`
+	ExactDelegationFee       *json.Uint32              `json:"exactDelegationFee,omitempty"`
+	Uptime                   *json.Float32             `json:"uptime,omitempty"`
+	Connected                bool                      `json:"connected"`
+	Staked                   []UTXO                    `json:"staked,omitempty"`
+	Signer                   *signer.ProofOfPossession `json:"signer,omitempty"`
+	// Arbitrary metadata the validator was tagged with when staking, taken
+	// from the memo of its AddPermissionlessValidatorTx.
+	Metadata types.JSONByteSlice `json:"metadata,omitempty"`
 
 	// The delegators delegating to this validator
 	DelegatorCount  *json.Uint64        `json:"delegatorCount,omitempty"`
@@ -0,0 +1,200 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"fmt"
+
+	"github.com/Juneo-io/juneogo/cache"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils"
+	"github.com/Juneo-io/juneogo/utils/hashing"
+	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/vms/components/avax"
+	"github.com/Juneo-io/juneogo/vms/platformvm/state"
+	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
+)
+
+const (
+	// Max number of address sets whose UTXOs are kept in the balance cache
+	balanceCacheSize = 64
+
+	// Max number of accepted blocks inspected to refresh a balance cache
+	// entry. Entries that are further behind are fetched again, so refreshing
+	// an entry never costs much more than fetching its UTXOs.
+	maxBalanceCacheRefreshBlocks = 64
+)
+
+// balanceCache keeps the UTXOs referencing warmed address sets in memory, as
+// of the accepted state.
+type balanceCache struct {
+	state   state.State
+	entries cache.LRU[ids.ID, *balanceCacheEntry] // address set key -> entry
+}
+
+func newBalanceCache(state state.State) *balanceCache {
+	return &balanceCache{
+		state: state,
+		entries: cache.LRU[ids.ID, *balanceCacheEntry]{
+			Size: balanceCacheSize,
+		},
+	}
+}
+
+// balanceCacheEntry holds the UTXOs referencing a warmed address set, as of the
+// accepted block at [height].
+type balanceCacheEntry struct {
+	addrs   set.Set[ids.ShortID]
+	utxos   []*avax.UTXO
+	utxoIDs set.Set[ids.ID]
+	height  uint64
+}
+
+// touchedBy returns true if [tx] may have consumed or produced a UTXO
+// referencing the addresses of the entry.
+func (e *balanceCacheEntry) touchedBy(tx *txs.Tx) bool {
+	if _, ok := tx.Unsigned.(*txs.RewardValidatorTx); ok {
+		// The UTXOs returned to a staker aren't part of the tx, so they are
+		// conservatively assumed to reference the addresses.
+		return true
+	}
+	if e.utxoIDs.Overlaps(tx.Unsigned.InputIDs()) {
+		return true
+	}
+	for _, utxo := range tx.UTXOs() {
+		out, ok := utxo.Out.(avax.Addressable)
+		if !ok {
+			continue
+		}
+		for _, addr := range out.Addresses() {
+			addrID, err := ids.ToShortID(addr)
+			if err == nil && e.addrs.Contains(addrID) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// balanceCacheKey returns the key of [addrs] in the balance cache.
+func balanceCacheKey(addrs set.Set[ids.ShortID]) ids.ID {
+	addrList := addrs.List()
+	utils.Sort(addrList)
+
+	addrBytes := make([]byte, 0, len(addrList)*ids.ShortIDLen)
+	for _, addr := range addrList {
+		addrBytes = append(addrBytes, addr[:]...)
+	}
+	return hashing.ComputeHash256Array(addrBytes)
+}
+
+// getAllUTXOs returns all the UTXOs referencing [addrs]. If [addrs] was warmed,
+// the UTXOs are served from the cache.
+//
+// Invariant: The context lock is held.
+func (c *balanceCache) getAllUTXOs(addrs set.Set[ids.ShortID]) ([]*avax.UTXO, error) {
+	entry, ok := c.entries.Get(balanceCacheKey(addrs))
+	if !ok {
+		return avax.GetAllUTXOs(c.state, addrs)
+	}
+	if err := c.refresh(entry); err != nil {
+		return nil, err
+	}
+	return entry.utxos, nil
+}
+
+// warm caches the UTXOs referencing [addrs] and returns them.
+//
+// Invariant: The context lock is held.
+func (c *balanceCache) warm(addrs set.Set[ids.ShortID]) ([]*avax.UTXO, error) {
+	key := balanceCacheKey(addrs)
+	if entry, ok := c.entries.Get(key); ok {
+		if err := c.refresh(entry); err != nil {
+			return nil, err
+		}
+		return entry.utxos, nil
+	}
+
+	height, err := c.acceptedHeight()
+	if err != nil {
+		return nil, err
+	}
+	entry := &balanceCacheEntry{
+		addrs: addrs,
+	}
+	if err := c.load(entry, height); err != nil {
+		return nil, err
+	}
+	c.entries.Put(key, entry)
+	return entry.utxos, nil
+}
+
+// refresh brings [entry] up to date with the last accepted block. The UTXOs of
+// [entry] are only fetched again if one of the blocks accepted since it was
+// last refreshed touched its addresses, or if more than
+// [maxBalanceCacheRefreshBlocks] blocks were accepted since.
+//
+// Only the accepted state is read, so blocks that are processing, and may
+// later be rejected, never affect the cached UTXOs.
+//
+// Invariant: The context lock is held.
+func (c *balanceCache) refresh(entry *balanceCacheEntry) error {
+	lastAcceptedHeight, err := c.acceptedHeight()
+	if err != nil {
+		return err
+	}
+	if lastAcceptedHeight-entry.height > maxBalanceCacheRefreshBlocks {
+		return c.load(entry, lastAcceptedHeight)
+	}
+
+	for height := entry.height + 1; height <= lastAcceptedHeight; height++ {
+		blkID, err := c.state.GetBlockIDAtHeight(height)
+		if err != nil {
+			return fmt.Errorf("couldn't get block ID at height %d: %w", height, err)
+		}
+		blk, err := c.state.GetStatelessBlock(blkID)
+		if err != nil {
+			return fmt.Errorf("couldn't get block %s: %w", blkID, err)
+		}
+		for _, tx := range blk.Txs() {
+			if entry.touchedBy(tx) {
+				return c.load(entry, lastAcceptedHeight)
+			}
+		}
+	}
+	entry.height = lastAcceptedHeight
+	return nil
+}
+
+// load fetches the UTXOs referencing the addresses of [entry] from the
+// accepted state at [height].
+//
+// Invariant: The context lock is held.
+func (c *balanceCache) load(entry *balanceCacheEntry, height uint64) error {
+	utxos, err := avax.GetAllUTXOs(c.state, entry.addrs)
+	if err != nil {
+		return err
+	}
+	entry.utxos = utxos
+	entry.utxoIDs = set.NewSet[ids.ID](len(utxos))
+	for _, utxo := range utxos {
+		entry.utxoIDs.Add(utxo.InputID())
+	}
+	entry.height = height
+	return nil
+}
+
+// acceptedHeight returns the height of the last block written to the accepted
+// state. Unlike the last accepted block of the block manager, this excludes
+// proposal blocks whose option hasn't been accepted yet.
+//
+// Invariant: The context lock is held.
+func (c *balanceCache) acceptedHeight() (uint64, error) {
+	lastAcceptedID := c.state.GetLastAccepted()
+	lastAccepted, err := c.state.GetStatelessBlock(lastAcceptedID)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't get last accepted block %s: %w", lastAcceptedID, err)
+	}
+	return lastAccepted.Height(), nil
+}
@@ -178,7 +178,7 @@ func newEnvironment(t *testing.T, f fork) *environment { //nolint:unparam
 	metrics, err := metrics.New("", registerer)
 	require.NoError(err)
 
-	res.mempool, err = mempool.New("mempool", registerer, nil)
+	res.mempool, err = mempool.New("mempool", registerer, nil, mempool.DefaultMaxSize, res.ctx.JUNEAssetID)
 	require.NoError(err)
 
 	res.blkManager = blockexecutor.NewManager(
@@ -4,7 +4,9 @@
 package executor
 
 import (
+	"cmp"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/Juneo-io/juneogo/ids"
@@ -77,6 +79,32 @@ func (b *backend) GetBlock(blkID ids.ID) (block.Block, error) {
 	return b.state.GetStatelessBlock(blkID)
 }
 
+func (b *backend) ProcessingBlocks() ([]block.Block, error) {
+	lastAccepted, err := b.GetBlock(b.lastAccepted)
+	if err != nil {
+		return nil, err
+	}
+	lastAcceptedHeight := lastAccepted.Height()
+
+	blks := make([]block.Block, 0, len(b.blkIDToState))
+	for _, blkState := range b.blkIDToState {
+		// Accepted proposal blocks are kept in memory until one of their
+		// options is accepted.
+		blk := blkState.statelessBlock
+		if blk.Height() <= lastAcceptedHeight {
+			continue
+		}
+		blks = append(blks, blk)
+	}
+	slices.SortFunc(blks, func(a, b block.Block) int {
+		if heightCmp := cmp.Compare(a.Height(), b.Height()); heightCmp != 0 {
+			return heightCmp
+		}
+		return a.ID().Compare(b.ID())
+	})
+	return blks, nil
+}
+
 func (b *backend) LastAccepted() ids.ID {
 	return b.lastAccepted
 }
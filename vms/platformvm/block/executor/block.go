@@ -37,7 +37,12 @@ func (b *Block) Verify(context.Context) error {
 }
 
 func (b *Block) Accept(context.Context) error {
-	return b.Visit(b.manager.acceptor)
+	if err := b.Visit(b.manager.acceptor); err != nil {
+		return err
+	}
+	b.manager.observeBlockBuildLatency(b.Block)
+	b.manager.observeReorg(b.Block)
+	return nil
 }
 
 func (b *Block) Reject(context.Context) error {
@@ -194,7 +194,7 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller, f fork) *environment
 	metrics := metrics.Noop
 
 	var err error
-	res.mempool, err = mempool.New("mempool", registerer, nil)
+	res.mempool, err = mempool.New("mempool", registerer, nil, mempool.DefaultMaxSize, res.ctx.JUNEAssetID)
 	if err != nil {
 		panic(fmt.Errorf("failed to create mempool: %w", err))
 	}
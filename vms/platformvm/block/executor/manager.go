@@ -5,9 +5,13 @@ package executor
 
 import (
 	"errors"
+	"fmt"
+	"maps"
+	"time"
 
 	"github.com/Juneo-io/juneogo/ids"
 	"github.com/Juneo-io/juneogo/snow/consensus/snowman"
+	"github.com/Juneo-io/juneogo/utils/math"
 	"github.com/Juneo-io/juneogo/utils/set"
 	"github.com/Juneo-io/juneogo/vms/platformvm/block"
 	"github.com/Juneo-io/juneogo/vms/platformvm/metrics"
@@ -18,10 +22,19 @@ import (
 	"github.com/Juneo-io/juneogo/vms/platformvm/validators"
 )
 
+// blockBuildLatencyHalflife is the halflife of the rolling average reported by
+// [Manager.GetBlockBuildLatency].
+const blockBuildLatencyHalflife = time.Minute
+
+// maxReorgEvents is the number of most recent reorg events kept in memory.
+const maxReorgEvents = 1024
+
 var (
-	_ Manager = (*manager)(nil)
+	_ Manager         = (*manager)(nil)
+	_ mempool.Mempool = (*unmodifiedMempool)(nil)
 
-	ErrChainNotSynced = errors.New("chain not synced")
+	ErrChainNotSynced     = errors.New("chain not synced")
+	ErrBlockNotProcessing = errors.New("block isn't processing")
 )
 
 type Manager interface {
@@ -37,6 +50,10 @@ type Manager interface {
 	GetStatelessBlock(blkID ids.ID) (block.Block, error)
 	NewBlock(block.Block) snowman.Block
 
+	// ProcessingBlocks returns the verified blocks that have not been decided
+	// yet, sorted by increasing height.
+	ProcessingBlocks() ([]block.Block, error)
+
 	// VerifyTx verifies that the transaction can be issued based on the currently
 	// preferred state. This should *not* be used to verify transactions in a block.
 	VerifyTx(tx *txs.Tx) error
@@ -44,6 +61,48 @@ type Manager interface {
 	// VerifyUniqueInputs verifies that the inputs are not duplicated in the
 	// provided blk or any of its ancestors pinned in memory.
 	VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error
+
+	// VerifyChain verifies again the processing block [blkID] and its
+	// processing ancestors, oldest first, each against the state resulting
+	// from its parent. The blocks aren't added to the manager and the mempool
+	// isn't modified.
+	//
+	// Returns the IDs of the blocks that passed verification, oldest first.
+	// If a block fails verification, a [*BlockVerificationError] is also
+	// returned.
+	VerifyChain(blkID ids.ID) ([]ids.ID, error)
+
+	// GetBlockBuildLatency returns the rolling average of the time between a
+	// tx being added to the local mempool and the block including it being
+	// accepted.
+	GetBlockBuildLatency() time.Duration
+
+	// GetReorgEvents returns the recorded reorg events whose height is in
+	// [fromHeight, toHeight], sorted by increasing height.
+	GetReorgEvents(fromHeight, toHeight uint64) []ReorgEvent
+}
+
+// BlockVerificationError reports the block of a chain that failed
+// verification. It wraps the verification error.
+type BlockVerificationError struct {
+	BlockID ids.ID
+	Err     error
+}
+
+func (e *BlockVerificationError) Error() string {
+	return fmt.Sprintf("block %s failed verification: %s", e.BlockID, e.Err)
+}
+
+func (e *BlockVerificationError) Unwrap() error {
+	return e.Err
+}
+
+// ReorgEvent records that an accepted block replaced a block that was
+// previously preferred at the same height but was never accepted.
+type ReorgEvent struct {
+	Height          uint64
+	AcceptedBlockID ids.ID
+	ReplacedBlockID ids.ID
 }
 
 func NewManager(
@@ -80,6 +139,9 @@ func NewManager(
 		},
 		preferred:         lastAccepted,
 		txExecutorBackend: txExecutorBackend,
+		metrics:           metrics,
+		blockBuildLatency: math.NewUninitializedAverager(blockBuildLatencyHalflife),
+		abandonedBlocks:   map[uint64]ids.ID{},
 	}
 }
 
@@ -91,6 +153,17 @@ type manager struct {
 
 	preferred         ids.ID
 	txExecutorBackend *executor.Backend
+	metrics           metrics.Metrics
+
+	// blockBuildLatency is only updated for txs that were added to the local
+	// mempool.
+	blockBuildLatency math.Averager
+
+	// abandonedBlocks maps a height to the processing block at that height
+	// that was part of a previously preferred chain.
+	abandonedBlocks map[uint64]ids.ID
+	// reorgEvents is bounded by [maxReorgEvents] and sorted by height.
+	reorgEvents []ReorgEvent
 }
 
 func (m *manager) GetBlock(blkID ids.ID) (snowman.Block, error) {
@@ -114,10 +187,44 @@ func (m *manager) NewBlock(blk block.Block) snowman.Block {
 
 func (m *manager) SetPreference(blkID ids.ID) bool {
 	updated := m.preferred != blkID
+	if updated {
+		m.trackAbandonedBlocks(blkID)
+	}
 	m.preferred = blkID
 	return updated
 }
 
+// trackAbandonedBlocks records the processing blocks of the currently
+// preferred chain that are not ancestors of [newPreferredID].
+func (m *manager) trackAbandonedBlocks(newPreferredID ids.ID) {
+	newChain := set.Set[ids.ID]{}
+	for blkID := newPreferredID; ; {
+		blkState, ok := m.blkIDToState[blkID]
+		if !ok {
+			break
+		}
+		newChain.Add(blkID)
+
+		// A block that is preferred again is no longer abandoned.
+		blk := blkState.statelessBlock
+		height := blk.Height()
+		if abandonedID, ok := m.abandonedBlocks[height]; ok && abandonedID == blkID {
+			delete(m.abandonedBlocks, height)
+		}
+		blkID = blk.Parent()
+	}
+
+	for blkID := m.preferred; !newChain.Contains(blkID); {
+		blkState, ok := m.blkIDToState[blkID]
+		if !ok {
+			break
+		}
+		blk := blkState.statelessBlock
+		m.abandonedBlocks[blk.Height()] = blkID
+		blkID = blk.Parent()
+	}
+}
+
 func (m *manager) Preferred() ids.ID {
 	return m.preferred
 }
@@ -152,3 +259,117 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 func (m *manager) VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error {
 	return m.backend.verifyUniqueInputs(blkID, inputs)
 }
+
+func (m *manager) VerifyChain(blkID ids.ID) ([]ids.ID, error) {
+	var chain []block.Block
+	for blkID != m.lastAccepted {
+		blkState, ok := m.blkIDToState[blkID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrBlockNotProcessing, blkID)
+		}
+		// Banff blocks are stored as the Apricot block they embed, so the
+		// block is parsed again to be verified as the block that was issued.
+		blk, err := block.Parse(block.Codec, blkState.statelessBlock.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse block %s: %w", blkID, err)
+		}
+		chain = append(chain, blk)
+		blkID = blk.Parent()
+	}
+
+	// The blocks are verified against a copy of the processing block states,
+	// which is updated with the state produced by each block so that its
+	// children are verified against it.
+	verifier := &verifier{
+		backend: &backend{
+			Mempool:      unmodifiedMempool{Mempool: m.Mempool},
+			lastAccepted: m.lastAccepted,
+			blkIDToState: maps.Clone(m.blkIDToState),
+			state:        m.state,
+			ctx:          m.ctx,
+		},
+		txExecutorBackend: m.txExecutorBackend,
+	}
+	verified := make([]ids.ID, 0, len(chain))
+	for i := len(chain) - 1; i >= 0; i-- {
+		blk := chain[i]
+		if err := blk.Visit(verifier); err != nil {
+			return verified, &BlockVerificationError{
+				BlockID: blk.ID(),
+				Err:     err,
+			}
+		}
+		verified = append(verified, blk.ID())
+	}
+	return verified, nil
+}
+
+// unmodifiedMempool ignores the changes made to the mempool while blocks are
+// verified by [manager.VerifyChain].
+type unmodifiedMempool struct {
+	mempool.Mempool
+}
+
+func (unmodifiedMempool) Remove(...*txs.Tx) {}
+
+func (unmodifiedMempool) MarkDropped(ids.ID, error) {}
+
+func (m *manager) GetBlockBuildLatency() time.Duration {
+	return time.Duration(m.blockBuildLatency.Read())
+}
+
+// observeBlockBuildLatency records the time it took for each tx of the
+// accepted block [blk] to be accepted after entering the local mempool.
+func (m *manager) observeBlockBuildLatency(blk block.Block) {
+	now := time.Now()
+	observed := false
+	for _, tx := range blk.Txs() {
+		addedTime, ok := m.Mempool.GetAddedTime(tx.ID())
+		if !ok {
+			continue
+		}
+		m.blockBuildLatency.Observe(float64(now.Sub(addedTime)), now)
+		observed = true
+	}
+	if observed {
+		m.metrics.SetBlockBuildLatency(m.GetBlockBuildLatency())
+	}
+}
+
+func (m *manager) GetReorgEvents(fromHeight, toHeight uint64) []ReorgEvent {
+	var events []ReorgEvent
+	for _, event := range m.reorgEvents {
+		if event.Height < fromHeight {
+			continue
+		}
+		if event.Height > toHeight {
+			break
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// observeReorg records a reorg event if a different block at the height of the
+// accepted block [blk] was previously preferred.
+func (m *manager) observeReorg(blk block.Block) {
+	height := blk.Height()
+	replacedID, ok := m.abandonedBlocks[height]
+	if !ok {
+		return
+	}
+	delete(m.abandonedBlocks, height)
+
+	blkID := blk.ID()
+	if replacedID == blkID {
+		return
+	}
+	m.reorgEvents = append(m.reorgEvents, ReorgEvent{
+		Height:          height,
+		AcceptedBlockID: blkID,
+		ReplacedBlockID: replacedID,
+	})
+	if len(m.reorgEvents) > maxReorgEvents {
+		m.reorgEvents = m.reorgEvents[1:]
+	}
+}
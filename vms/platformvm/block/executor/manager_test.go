@@ -77,6 +77,7 @@ func TestManagerSetPreference(t *testing.T) {
 
 	initialPreference := ids.GenerateTestID()
 	manager := &manager{
+		backend:   &backend{},
 		preferred: initialPreference,
 	}
 	require.False(manager.SetPreference(initialPreference))
@@ -11,6 +11,7 @@ package executor
 
 import (
 	reflect "reflect"
+	time "time"
 
 	ids "github.com/Juneo-io/juneogo/ids"
 	snowman "github.com/Juneo-io/juneogo/snow/consensus/snowman"
@@ -59,6 +60,34 @@ func (mr *MockManagerMockRecorder) GetBlock(blkID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockManager)(nil).GetBlock), blkID)
 }
 
+// GetBlockBuildLatency mocks base method.
+func (m *MockManager) GetBlockBuildLatency() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockBuildLatency")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetBlockBuildLatency indicates an expected call of GetBlockBuildLatency.
+func (mr *MockManagerMockRecorder) GetBlockBuildLatency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockBuildLatency", reflect.TypeOf((*MockManager)(nil).GetBlockBuildLatency))
+}
+
+// GetReorgEvents mocks base method.
+func (m *MockManager) GetReorgEvents(fromHeight, toHeight uint64) []ReorgEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReorgEvents", fromHeight, toHeight)
+	ret0, _ := ret[0].([]ReorgEvent)
+	return ret0
+}
+
+// GetReorgEvents indicates an expected call of GetReorgEvents.
+func (mr *MockManagerMockRecorder) GetReorgEvents(fromHeight, toHeight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReorgEvents", reflect.TypeOf((*MockManager)(nil).GetReorgEvents), fromHeight, toHeight)
+}
+
 // GetState mocks base method.
 func (m *MockManager) GetState(blkID ids.ID) (state.Chain, bool) {
 	m.ctrl.T.Helper()
@@ -131,6 +160,21 @@ func (mr *MockManagerMockRecorder) Preferred() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preferred", reflect.TypeOf((*MockManager)(nil).Preferred))
 }
 
+// ProcessingBlocks mocks base method.
+func (m *MockManager) ProcessingBlocks() ([]block.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessingBlocks")
+	ret0, _ := ret[0].([]block.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessingBlocks indicates an expected call of ProcessingBlocks.
+func (mr *MockManagerMockRecorder) ProcessingBlocks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessingBlocks", reflect.TypeOf((*MockManager)(nil).ProcessingBlocks))
+}
+
 // SetPreference mocks base method.
 func (m *MockManager) SetPreference(blkID ids.ID) bool {
 	m.ctrl.T.Helper()
@@ -145,6 +189,21 @@ func (mr *MockManagerMockRecorder) SetPreference(blkID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreference", reflect.TypeOf((*MockManager)(nil).SetPreference), blkID)
 }
 
+// VerifyChain mocks base method.
+func (m *MockManager) VerifyChain(blkID ids.ID) ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyChain", blkID)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyChain indicates an expected call of VerifyChain.
+func (mr *MockManagerMockRecorder) VerifyChain(blkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyChain", reflect.TypeOf((*MockManager)(nil).VerifyChain), blkID)
+}
+
 // VerifyTx mocks base method.
 func (m *MockManager) VerifyTx(tx *txs.Tx) error {
 	m.ctrl.T.Helper()
@@ -25,6 +25,22 @@ var _ Client = (*client)(nil)
 type Client interface {
 	// GetHeight returns the current block height of the P Chain
 	GetHeight(ctx context.Context, options ...rpc.Option) (uint64, error)
+	// GetProcessingBlocks returns the blocks that have been verified but not
+	// yet decided by the node, sorted by increasing height
+	GetProcessingBlocks(ctx context.Context, options ...rpc.Option) ([]ProcessingBlock, error)
+	// VerifyBlockChain verifies again the processing block [blkID] and its
+	// ancestors back to the last accepted block, and reports the first block
+	// that fails verification
+	VerifyBlockChain(ctx context.Context, blkID ids.ID, options ...rpc.Option) (*VerifyBlockChainReply, error)
+	// GetBlockBuildLatency returns the rolling average of the time between a tx
+	// entering the node's mempool and the block including it being accepted
+	GetBlockBuildLatency(ctx context.Context, options ...rpc.Option) (time.Duration, error)
+	// GetAPISchema returns the methods exposed by the P Chain API along with
+	// the fields of their arguments and replies
+	GetAPISchema(ctx context.Context, options ...rpc.Option) ([]APIMethod, error)
+	// GetReorgEvents returns the blocks accepted between [fromHeight] and
+	// [toHeight] that replaced a block previously preferred by the node
+	GetReorgEvents(ctx context.Context, fromHeight, toHeight uint64, options ...rpc.Option) ([]ReorgEvent, error)
 	// ExportKey returns the private key corresponding to [address] from [user]'s account
 	//
 	// Deprecated: Keys should no longer be stored on the node.
@@ -33,6 +49,14 @@ type Client interface {
 	//
 	// Deprecated: GetUTXOs should be used instead.
 	GetBalance(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (*GetBalanceResponse, error)
+	// GetPendingBalance returns the balance of [addrs] on the P Chain along
+	// with the amounts the txs in the mempool send to and spend from them
+	//
+	// Deprecated: GetUTXOs should be used instead.
+	GetPendingBalance(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (*GetBalanceResponse, error)
+	// GetLockedUTXOs returns the stakeable locked UTXOs of [addrs] that are
+	// still locked, sorted by the time at which they unlock
+	GetLockedUTXOs(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) ([]LockedUTXO, error)
 	// ListAddresses returns an array of platform addresses controlled by [user]
 	//
 	// Deprecated: Keys should no longer be stored on the node.
@@ -59,6 +83,10 @@ type Client interface {
 	) ([][]byte, ids.ShortID, ids.ID, error)
 	// GetSupernet returns information about the specified supernet
 	GetSupernet(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (GetSupernetClientResponse, error)
+	// GetSupernetOwnerHistory returns the owners the specified supernet had,
+	// sorted by the height at which they became effective. The height is nil
+	// if the node can't tell it.
+	GetSupernetOwnerHistory(ctx context.Context, supernetID ids.ID, options ...rpc.Option) ([]APISupernetOwnerChange, error)
 	// GetSupernets returns information about the specified supernets
 	//
 	// Deprecated: Supernets should be fetched from a dedicated indexer.
@@ -66,16 +94,51 @@ type Client interface {
 	// GetStakingAssetID returns the assetID of the asset used for staking on
 	// supernet corresponding to [supernetID]
 	GetStakingAssetID(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (ids.ID, error)
+	// GetRecognizedAssets returns the IDs of the assets currently recognized by
+	// the P-chain
+	GetRecognizedAssets(ctx context.Context, options ...rpc.Option) ([]ids.ID, error)
 	// GetCurrentValidators returns the list of current validators for supernet with ID [supernetID]
 	GetCurrentValidators(ctx context.Context, supernetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]ClientPermissionlessValidator, error)
+	// GetPendingValidators returns the list of pending validators and
+	// delegators for supernet with ID [supernetID]
+	GetPendingValidators(ctx context.Context, supernetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]interface{}, []interface{}, error)
+	// GetNodeSupernets returns the supernets, including the primary network,
+	// that [nodeID] currently validates along with its weight on each of them
+	GetNodeSupernets(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) ([]NodeSupernet, error)
 	// GetCurrentSupply returns an upper bound on the supply of AVAX in the system along with the P-chain height
 	GetCurrentSupply(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (uint64, uint64, error)
+	// GetProjectedSupply returns an upper bound on the supply of the staking
+	// asset of [supernetID], JUNE for the primary network, at [atTime],
+	// assuming all pending stakers ending by then are rewarded, along with the
+	// P-chain height
+	GetProjectedSupply(ctx context.Context, supernetID ids.ID, atTime time.Time, options ...rpc.Option) (uint64, uint64, error)
 	// GetRewardPoolSupply returns the current supply in the reward pool
 	GetRewardPoolSupply(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (uint64, error)
 	// GetFeePoolValue returns the current value in the fee pool
 	GetFeePoolValue(ctx context.Context, options ...rpc.Option) (uint64, error)
+	// GetFeeConfig returns the fees effective at the timestamp of the last
+	// accepted block
+	GetFeeConfig(ctx context.Context, options ...rpc.Option) (*GetFeeConfigReply, error)
+	// GetSupernetOperationFee returns the fee burned when performing
+	// [operation] on the supernet [supernetID]
+	GetSupernetOperationFee(ctx context.Context, supernetID ids.ID, operation string, options ...rpc.Option) (uint64, error)
+	// GetAccruedReward returns the potential reward of the validator [nodeID]
+	// of [supernetID] along with the share of it earned so far
+	GetAccruedReward(ctx context.Context, supernetID ids.ID, nodeID ids.NodeID, options ...rpc.Option) (*GetAccruedRewardReply, error)
+	// GetUpcomingRewards returns the next [limit] stakers of [supernetID] to be
+	// rewarded, in the order in which their staking periods end
+	GetUpcomingRewards(ctx context.Context, supernetID ids.ID, limit uint32, options ...rpc.Option) ([]UpcomingReward, error)
 	// SampleValidators returns the nodeIDs of a sample of [sampleSize] validators from the current validator set for supernet with ID [supernetID]
 	SampleValidators(ctx context.Context, supernetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error)
+	// SampleValidatorsWithDetails is the same as SampleValidators but also
+	// returns the weight and BLS public key of each sampled validator
+	SampleValidatorsWithDetails(ctx context.Context, supernetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]SampledValidator, error)
+	// GetConnectedValidators returns the nodeIDs of the current validators of
+	// supernet [supernetID] the node is connected to
+	GetConnectedValidators(ctx context.Context, supernetID ids.ID, options ...rpc.Option) ([]ids.NodeID, error)
+	// GetConnectedPeers returns whether the node is connected to each of the
+	// current validators of supernet [supernetID] and their observed uptime
+	GetConnectedPeers(ctx context.Context, supernetID ids.ID, options ...rpc.Option) ([]ConnectedPeer, error)
 	// GetBlockchainStatus returns the current status of blockchain with ID: [blockchainID]
 	GetBlockchainStatus(ctx context.Context, blockchainID string, options ...rpc.Option) (status.BlockchainStatus, error)
 	// ValidatedBy returns the ID of the Supernet that validates [blockchainID]
@@ -86,14 +149,41 @@ type Client interface {
 	//
 	// Deprecated: Blockchains should be fetched from a dedicated indexer.
 	GetBlockchains(ctx context.Context, options ...rpc.Option) ([]APIBlockchain, error)
+	// GetChainConfig returns the name, supernet, VM and feature extensions
+	// the blockchain [chainID] was created with
+	GetChainConfig(ctx context.Context, chainID ids.ID, options ...rpc.Option) (*GetChainConfigResponse, error)
+	// GetChainsCreatedBy returns the blockchains whose creation tx was funded
+	// by any of [addrs]
+	GetChainsCreatedBy(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) ([]APIBlockchain, error)
 	// IssueTx issues the transaction and returns its txID
 	IssueTx(ctx context.Context, tx []byte, options ...rpc.Option) (ids.ID, error)
 	// GetTx returns the byte representation of the transaction corresponding to [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
+	// GetTxJSON returns the type and the decoded fields of the transaction
+	// corresponding to [txID]
+	GetTxJSON(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetTxJSONReply, error)
+	// DecodeTx returns the type and the decoded fields of [tx] without
+	// issuing it
+	DecodeTx(ctx context.Context, tx []byte, options ...rpc.Option) (*GetTxJSONReply, error)
+	// GetTxByPrefix returns the ID of the only committed transaction whose ID
+	// starts with [prefix], which is hex encoded with a 0x prefix and at least
+	// 4 bytes long
+	GetTxByPrefix(ctx context.Context, prefix string, options ...rpc.Option) (ids.ID, error)
 	// GetTxStatus returns the status of the transaction corresponding to [txID]
 	GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetTxStatusResponse, error)
+	// GetTxStatuses returns the status of each of the transactions
+	// corresponding to [txIDs], keyed by tx ID
+	GetTxStatuses(ctx context.Context, txIDs []ids.ID, options ...rpc.Option) (map[ids.ID]GetTxStatusResponse, error)
+	// GetTxConfirmations returns the number of accepted blocks built on top of
+	// the block that included [txID], plus one. Zero is returned if the tx is
+	// processing.
+	GetTxConfirmations(ctx context.Context, txID ids.ID, options ...rpc.Option) (uint64, error)
 	// AwaitTxDecided polls [GetTxStatus] until a status is returned that
-	// implies the tx may be decided.
+	// implies the tx may be decided. Once decided, the height of the chain and
+	// the number of confirmations of the tx are reported when the node can
+	// provide them. If [ctx] is done first, the last response observed, if
+	// any, is returned along with the context's error.
+	// [freq] is raised to [rpc.MinPollFrequency] if it is shorter.
 	// TODO: Move this function off of the Client interface into a utility
 	// function.
 	AwaitTxDecided(
@@ -101,7 +191,7 @@ type Client interface {
 		txID ids.ID,
 		freq time.Duration,
 		options ...rpc.Option,
-	) (*GetTxStatusResponse, error)
+	) (*AwaitTxDecidedResponse, error)
 	// GetStake returns the amount of nAVAX that [addrs] have cumulatively
 	// staked on the Primary Network.
 	//
@@ -112,9 +202,29 @@ type Client interface {
 		validatorsOnly bool,
 		options ...rpc.Option,
 	) (map[ids.ID]uint64, [][]byte, error)
+	// GetAccountState returns the balances, stake, pending rewards and UTXO
+	// count of [addrs] in a single round trip
+	GetAccountState(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (*GetAccountStateReply, error)
+	// GetFeesPaid returns the sum of the fees of the txs paid by [addrs] and
+	// the number of such txs, in the blocks from [fromHeight] to [toHeight]
+	// inclusive
+	GetFeesPaid(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		fromHeight uint64,
+		toHeight uint64,
+		options ...rpc.Option,
+	) (uint64, uint64, error)
 	// GetMinStake returns the minimum staking amount in nAVAX for validators
 	// and delegators respectively
 	GetMinStake(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (uint64, uint64, error)
+	// GetStakeLimits returns the stake amounts, durations and delegation fees
+	// allowed for the validators and delegators of [supernetID]
+	GetStakeLimits(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (*GetMinStakeReply, error)
+	// GetValidatorDelegationTerms returns the delegation fee of the validator
+	// [nodeID] of supernet [supernetID] and how much weight can still be
+	// delegated to it
+	GetValidatorDelegationTerms(ctx context.Context, supernetID ids.ID, nodeID ids.NodeID, options ...rpc.Option) (*GetValidatorDelegationTermsReply, error)
 	// GetTotalStake returns the total amount (in nAVAX) staked on the network
 	GetTotalStake(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (uint64, error)
 	// GetRewardUTXOs returns the reward UTXOs for a transaction
@@ -123,6 +233,13 @@ type Client interface {
 	GetRewardUTXOs(context.Context, *api.GetTxArgs, ...rpc.Option) ([][]byte, error)
 	// GetTimestamp returns the current chain timestamp
 	GetTimestamp(ctx context.Context, options ...rpc.Option) (time.Time, error)
+	// GetNextRewardTime returns the end time of the current staker that will
+	// be rewarded first
+	GetNextRewardTime(ctx context.Context, options ...rpc.Option) (*GetNextRewardTimeReply, error)
+	// EstimateReward returns the reward a staker of [stakeAmount] on supernet
+	// [supernetID] from [startTime] to [endTime] would be entitled to. A zero
+	// [startTime] defaults to the current chain time.
+	EstimateReward(ctx context.Context, supernetID ids.ID, stakeAmount uint64, startTime time.Time, endTime time.Time, options ...rpc.Option) (uint64, error)
 	// GetValidatorsAt returns the weights of the validator set of a provided
 	// supernet at the specified height.
 	GetValidatorsAt(
@@ -131,6 +248,14 @@ type Client interface {
 		height uint64,
 		options ...rpc.Option,
 	) (map[ids.NodeID]*validators.GetValidatorOutput, error)
+	// GetValidatorSetHash returns a deterministic hash of the validator set of
+	// a provided supernet at the specified height.
+	GetValidatorSetHash(
+		ctx context.Context,
+		supernetID ids.ID,
+		height uint64,
+		options ...rpc.Option,
+	) (ids.ID, error)
 	// GetBlock returns the block with the given id.
 	GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetBlockByHeight returns the block at the given [height].
@@ -155,6 +280,41 @@ func (c *client) GetHeight(ctx context.Context, options ...rpc.Option) (uint64,
 	return uint64(res.Height), err
 }
 
+func (c *client) GetProcessingBlocks(ctx context.Context, options ...rpc.Option) ([]ProcessingBlock, error) {
+	res := &GetProcessingBlocksReply{}
+	err := c.requester.SendRequest(ctx, "platform.getProcessingBlocks", struct{}{}, res, options...)
+	return res.Blocks, err
+}
+
+func (c *client) VerifyBlockChain(ctx context.Context, blkID ids.ID, options ...rpc.Option) (*VerifyBlockChainReply, error) {
+	res := &VerifyBlockChainReply{}
+	err := c.requester.SendRequest(ctx, "platform.verifyBlockChain", &VerifyBlockChainArgs{
+		BlockID: blkID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetBlockBuildLatency(ctx context.Context, options ...rpc.Option) (time.Duration, error) {
+	res := &GetBlockBuildLatencyReply{}
+	err := c.requester.SendRequest(ctx, "platform.getBlockBuildLatency", struct{}{}, res, options...)
+	return time.Duration(res.Latency), err
+}
+
+func (c *client) GetAPISchema(ctx context.Context, options ...rpc.Option) ([]APIMethod, error) {
+	res := &GetAPISchemaReply{}
+	err := c.requester.SendRequest(ctx, "platform.getAPISchema", struct{}{}, res, options...)
+	return res.Methods, err
+}
+
+func (c *client) GetReorgEvents(ctx context.Context, fromHeight, toHeight uint64, options ...rpc.Option) ([]ReorgEvent, error) {
+	res := &GetReorgEventsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getReorgEvents", &GetReorgEventsArgs{
+		FromHeight: json.Uint64(fromHeight),
+		ToHeight:   json.Uint64(toHeight),
+	}, res, options...)
+	return res.Events, err
+}
+
 func (c *client) ExportKey(ctx context.Context, user api.UserPass, address ids.ShortID, options ...rpc.Option) (*secp256k1.PrivateKey, error) {
 	res := &ExportKeyReply{}
 	err := c.requester.SendRequest(ctx, "platform.exportKey", &ExportKeyArgs{
@@ -172,6 +332,25 @@ func (c *client) GetBalance(ctx context.Context, addrs []ids.ShortID, options ..
 	return res, err
 }
 
+func (c *client) GetPendingBalance(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (*GetBalanceResponse, error) {
+	res := &GetBalanceResponse{}
+	err := c.requester.SendRequest(ctx, "platform.getBalance", &GetBalanceRequest{
+		Addresses:      ids.ShortIDsToStrings(addrs),
+		IncludeMempool: true,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetLockedUTXOs(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) ([]LockedUTXO, error) {
+	res := &GetLockedUTXOsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getLockedUTXOs", &GetLockedUTXOsArgs{
+		JSONAddresses: api.JSONAddresses{
+			Addresses: ids.ShortIDsToStrings(addrs),
+		},
+	}, res, options...)
+	return res.UTXOs, err
+}
+
 func (c *client) ListAddresses(ctx context.Context, user api.UserPass, options ...rpc.Option) ([]ids.ShortID, error) {
 	res := &api.JSONAddresses{}
 	err := c.requester.SendRequest(ctx, "platform.listAddresses", &user, res, options...)
@@ -242,6 +421,15 @@ type GetSupernetClientResponse struct {
 	Locktime    uint64
 	// supernet transformation tx ID for a permissionless supernet
 	SupernetTransformationTxID ids.ID
+	// staking parameters of a permissionless supernet
+	StakingAssetID    ids.ID
+	MinValidatorStake uint64
+	MaxValidatorStake uint64
+	MinDelegatorStake uint64
+	MinStakeDuration  time.Duration
+	MaxStakeDuration  time.Duration
+	MinDelegationFee  uint32
+	MaxDelegationFee  uint32
 }
 
 func (c *client) GetSupernet(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (GetSupernetClientResponse, error) {
@@ -257,13 +445,32 @@ func (c *client) GetSupernet(ctx context.Context, supernetID ids.ID, options ...
 		return GetSupernetClientResponse{}, err
 	}
 
-	return GetSupernetClientResponse{
+	response := GetSupernetClientResponse{
 		IsPermissioned:           res.IsPermissioned,
 		ControlKeys:              controlKeys,
 		Threshold:                uint32(res.Threshold),
 		Locktime:                 uint64(res.Locktime),
 		SupernetTransformationTxID: res.SupernetTransformationTxID,
-	}, nil
+	}
+	if staking := res.Staking; staking != nil {
+		response.StakingAssetID = staking.AssetID
+		response.MinValidatorStake = uint64(staking.MinValidatorStake)
+		response.MaxValidatorStake = uint64(staking.MaxValidatorStake)
+		response.MinDelegatorStake = uint64(staking.MinDelegatorStake)
+		response.MinStakeDuration = time.Duration(staking.MinStakeDuration) * time.Second
+		response.MaxStakeDuration = time.Duration(staking.MaxStakeDuration) * time.Second
+		response.MinDelegationFee = uint32(staking.MinDelegationFee)
+		response.MaxDelegationFee = uint32(staking.MaxDelegationFee)
+	}
+	return response, nil
+}
+
+func (c *client) GetSupernetOwnerHistory(ctx context.Context, supernetID ids.ID, options ...rpc.Option) ([]APISupernetOwnerChange, error) {
+	res := &GetSupernetOwnerHistoryResponse{}
+	err := c.requester.SendRequest(ctx, "platform.getSupernetOwnerHistory", &GetSupernetOwnerHistoryArgs{
+		SupernetID: supernetID,
+	}, res, options...)
+	return res.Owners, err
 }
 
 // ClientSupernet is a representation of a supernet used in client methods
@@ -309,6 +516,12 @@ func (c *client) GetStakingAssetID(ctx context.Context, supernetID ids.ID, optio
 	return res.AssetID, err
 }
 
+func (c *client) GetRecognizedAssets(ctx context.Context, options ...rpc.Option) ([]ids.ID, error) {
+	res := &GetRecognizedAssetsResponse{}
+	err := c.requester.SendRequest(ctx, "platform.getRecognizedAssets", struct{}{}, res, options...)
+	return res.AssetIDs, err
+}
+
 func (c *client) GetCurrentValidators(
 	ctx context.Context,
 	supernetID ids.ID,
@@ -326,6 +539,28 @@ func (c *client) GetCurrentValidators(
 	return getClientPermissionlessValidators(res.Validators)
 }
 
+func (c *client) GetPendingValidators(
+	ctx context.Context,
+	supernetID ids.ID,
+	nodeIDs []ids.NodeID,
+	options ...rpc.Option,
+) ([]interface{}, []interface{}, error) {
+	res := &GetPendingValidatorsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getPendingValidators", &GetPendingValidatorsArgs{
+		SupernetID: supernetID,
+		NodeIDs:    nodeIDs,
+	}, res, options...)
+	return res.Validators, res.Delegators, err
+}
+
+func (c *client) GetNodeSupernets(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) ([]NodeSupernet, error) {
+	res := &GetNodeSupernetsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getNodeSupernets", &GetNodeSupernetsArgs{
+		NodeID: nodeID,
+	}, res, options...)
+	return res.Supernets, err
+}
+
 func (c *client) GetCurrentSupply(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (uint64, uint64, error) {
 	res := &GetCurrentSupplyReply{}
 	err := c.requester.SendRequest(ctx, "platform.getCurrentSupply", &GetCurrentSupplyArgs{
@@ -334,6 +569,15 @@ func (c *client) GetCurrentSupply(ctx context.Context, supernetID ids.ID, option
 	return uint64(res.Supply), uint64(res.Height), err
 }
 
+func (c *client) GetProjectedSupply(ctx context.Context, supernetID ids.ID, atTime time.Time, options ...rpc.Option) (uint64, uint64, error) {
+	res := &GetProjectedSupplyReply{}
+	err := c.requester.SendRequest(ctx, "platform.getProjectedSupply", &GetProjectedSupplyArgs{
+		SupernetID: supernetID,
+		Time:       json.Uint64(atTime.Unix()),
+	}, res, options...)
+	return uint64(res.Supply), uint64(res.Height), err
+}
+
 func (c *client) GetRewardPoolSupply(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (uint64, error) {
 	res := &GetRewardPoolSupplyReply{}
 	err := c.requester.SendRequest(ctx, "platform.getRewardPoolSupply", &GetRewardPoolSupplyArgs{
@@ -348,6 +592,39 @@ func (c *client) GetFeePoolValue(ctx context.Context, options ...rpc.Option) (ui
 	return uint64(res.FeePoolValue), err
 }
 
+func (c *client) GetFeeConfig(ctx context.Context, options ...rpc.Option) (*GetFeeConfigReply, error) {
+	res := &GetFeeConfigReply{}
+	err := c.requester.SendRequest(ctx, "platform.getFeeConfig", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) GetSupernetOperationFee(ctx context.Context, supernetID ids.ID, operation string, options ...rpc.Option) (uint64, error) {
+	res := &GetSupernetOperationFeeReply{}
+	err := c.requester.SendRequest(ctx, "platform.getSupernetOperationFee", &GetSupernetOperationFeeArgs{
+		SupernetID: supernetID,
+		Operation:  operation,
+	}, res, options...)
+	return uint64(res.Fee), err
+}
+
+func (c *client) GetAccruedReward(ctx context.Context, supernetID ids.ID, nodeID ids.NodeID, options ...rpc.Option) (*GetAccruedRewardReply, error) {
+	res := &GetAccruedRewardReply{}
+	err := c.requester.SendRequest(ctx, "platform.getAccruedReward", &GetAccruedRewardArgs{
+		SupernetID: supernetID,
+		NodeID:     nodeID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetUpcomingRewards(ctx context.Context, supernetID ids.ID, limit uint32, options ...rpc.Option) ([]UpcomingReward, error) {
+	res := &GetUpcomingRewardsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getUpcomingRewards", &GetUpcomingRewardsArgs{
+		SupernetID: supernetID,
+		Limit:      json.Uint32(limit),
+	}, res, options...)
+	return res.Rewards, err
+}
+
 func (c *client) SampleValidators(ctx context.Context, supernetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error) {
 	res := &SampleValidatorsReply{}
 	err := c.requester.SendRequest(ctx, "platform.sampleValidators", &SampleValidatorsArgs{
@@ -357,6 +634,32 @@ func (c *client) SampleValidators(ctx context.Context, supernetID ids.ID, sample
 	return res.Validators, err
 }
 
+func (c *client) SampleValidatorsWithDetails(ctx context.Context, supernetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]SampledValidator, error) {
+	res := &SampleValidatorsReply{}
+	err := c.requester.SendRequest(ctx, "platform.sampleValidators", &SampleValidatorsArgs{
+		SupernetID:     supernetID,
+		Size:           json.Uint16(sampleSize),
+		IncludeDetails: true,
+	}, res, options...)
+	return res.ValidatorDetails, err
+}
+
+func (c *client) GetConnectedValidators(ctx context.Context, supernetID ids.ID, options ...rpc.Option) ([]ids.NodeID, error) {
+	res := &GetConnectedValidatorsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getConnectedValidators", &GetConnectedValidatorsArgs{
+		SupernetID: supernetID,
+	}, res, options...)
+	return res.Validators, err
+}
+
+func (c *client) GetConnectedPeers(ctx context.Context, supernetID ids.ID, options ...rpc.Option) ([]ConnectedPeer, error) {
+	res := &GetConnectedPeersReply{}
+	err := c.requester.SendRequest(ctx, "platform.getConnectedPeers", &GetConnectedPeersArgs{
+		SupernetID: supernetID,
+	}, res, options...)
+	return res.Peers, err
+}
+
 func (c *client) GetBlockchainStatus(ctx context.Context, blockchainID string, options ...rpc.Option) (status.BlockchainStatus, error) {
 	res := &GetBlockchainStatusReply{}
 	err := c.requester.SendRequest(ctx, "platform.getBlockchainStatus", &GetBlockchainStatusArgs{
@@ -387,6 +690,24 @@ func (c *client) GetBlockchains(ctx context.Context, options ...rpc.Option) ([]A
 	return res.Blockchains, err
 }
 
+func (c *client) GetChainConfig(ctx context.Context, chainID ids.ID, options ...rpc.Option) (*GetChainConfigResponse, error) {
+	res := &GetChainConfigResponse{}
+	err := c.requester.SendRequest(ctx, "platform.getChainConfig", &GetChainConfigArgs{
+		ChainID: chainID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetChainsCreatedBy(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) ([]APIBlockchain, error) {
+	res := &GetChainsCreatedByResponse{}
+	err := c.requester.SendRequest(ctx, "platform.getChainsCreatedBy", &GetChainsCreatedByArgs{
+		JSONAddresses: api.JSONAddresses{
+			Addresses: ids.ShortIDsToStrings(addrs),
+		},
+	}, res, options...)
+	return res.Blockchains, err
+}
+
 func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (ids.ID, error) {
 	txStr, err := formatting.Encode(formatting.Hex, txBytes)
 	if err != nil {
@@ -413,6 +734,36 @@ func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option)
 	return formatting.Decode(res.Encoding, res.Tx)
 }
 
+func (c *client) GetTxJSON(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetTxJSONReply, error) {
+	res := &GetTxJSONReply{}
+	err := c.requester.SendRequest(ctx, "platform.getTxJSON", &GetTxJSONArgs{
+		TxID: txID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) DecodeTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (*GetTxJSONReply, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &GetTxJSONReply{}
+	err = c.requester.SendRequest(ctx, "platform.decodeTx", &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetTxByPrefix(ctx context.Context, prefix string, options ...rpc.Option) (ids.ID, error) {
+	res := &GetTxByPrefixReply{}
+	err := c.requester.SendRequest(ctx, "platform.getTxByPrefix", &GetTxByPrefixArgs{
+		Prefix: prefix,
+	}, res, options...)
+	return res.TxID, err
+}
+
 func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetTxStatusResponse, error) {
 	res := &GetTxStatusResponse{}
 	err := c.requester.SendRequest(
@@ -427,27 +778,97 @@ func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Op
 	return res, err
 }
 
-func (c *client) AwaitTxDecided(ctx context.Context, txID ids.ID, freq time.Duration, options ...rpc.Option) (*GetTxStatusResponse, error) {
-	ticker := time.NewTicker(freq)
+func (c *client) GetTxStatuses(ctx context.Context, txIDs []ids.ID, options ...rpc.Option) (map[ids.ID]GetTxStatusResponse, error) {
+	res := &GetTxStatusesReply{}
+	err := c.requester.SendRequest(ctx, "platform.getTxStatuses", &GetTxStatusesArgs{
+		TxIDs: txIDs,
+	}, res, options...)
+	return res.Statuses, err
+}
+
+func (c *client) GetTxConfirmations(ctx context.Context, txID ids.ID, options ...rpc.Option) (uint64, error) {
+	res := &GetTxConfirmationsReply{}
+	err := c.requester.SendRequest(
+		ctx,
+		"platform.getTxConfirmations",
+		&GetTxConfirmationsArgs{
+			TxID: txID,
+		},
+		res,
+		options...,
+	)
+	return uint64(res.Confirmations), err
+}
+
+// AwaitTxDecidedResponse is the last status of a tx observed by
+// [Client.AwaitTxDecided]
+type AwaitTxDecidedResponse struct {
+	GetTxStatusResponse
+
+	// Height is the last accepted height of the chain observed once the tx was
+	// decided. Zero if the tx isn't decided or if the height couldn't be
+	// fetched.
+	Height uint64
+	// Confirmations is the number of accepted blocks built on top of the block
+	// that included the tx, plus one, as of [Height]. Zero if the tx isn't
+	// committed or if the node couldn't report them.
+	Confirmations uint64
+}
+
+func (c *client) AwaitTxDecided(ctx context.Context, txID ids.ID, freq time.Duration, options ...rpc.Option) (*AwaitTxDecidedResponse, error) {
+	ticker := time.NewTicker(rpc.PollFrequency(freq))
 	defer ticker.Stop()
 
+	var lastRes *AwaitTxDecidedResponse
 	for {
-		res, err := c.GetTxStatus(ctx, txID, options...)
+		// Don't issue another request once [ctx] is done, even if the ticker
+		// fired concurrently.
+		if err := ctx.Err(); err != nil {
+			return lastRes, err
+		}
+
+		txStatus, err := c.GetTxStatus(ctx, txID, options...)
 		if err == nil {
-			switch res.Status {
+			res := &AwaitTxDecidedResponse{
+				GetTxStatusResponse: *txStatus,
+			}
+			switch txStatus.Status {
 			case status.Committed, status.Aborted, status.Dropped:
+				c.addTxDecisionDetails(ctx, txID, res, options...)
 				return res, nil
 			}
+			lastRes = res
 		}
 
 		select {
 		case <-ticker.C:
 		case <-ctx.Done():
-			return nil, ctx.Err()
+			return lastRes, ctx.Err()
 		}
 	}
 }
 
+// addTxDecisionDetails reports in [res] the current height of the chain and,
+// if the tx is committed, its number of confirmations. These details are best
+// effort: nodes may not index the confirmations of txs accepted before they
+// were upgraded, so failures to fetch them are ignored.
+func (c *client) addTxDecisionDetails(ctx context.Context, txID ids.ID, res *AwaitTxDecidedResponse, options ...rpc.Option) {
+	height, err := c.GetHeight(ctx, options...)
+	if err != nil {
+		return
+	}
+	res.Height = height
+
+	if res.Status != status.Committed {
+		return
+	}
+	confirmations, err := c.GetTxConfirmations(ctx, txID, options...)
+	if err != nil {
+		return
+	}
+	res.Confirmations = confirmations
+}
+
 func (c *client) GetStake(
 	ctx context.Context,
 	addrs []ids.ShortID,
@@ -482,6 +903,34 @@ func (c *client) GetStake(
 	return staked, outputs, err
 }
 
+func (c *client) GetAccountState(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (*GetAccountStateReply, error) {
+	res := &GetAccountStateReply{}
+	err := c.requester.SendRequest(ctx, "platform.getAccountState", &GetAccountStateArgs{
+		JSONAddresses: api.JSONAddresses{
+			Addresses: ids.ShortIDsToStrings(addrs),
+		},
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetFeesPaid(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	fromHeight uint64,
+	toHeight uint64,
+	options ...rpc.Option,
+) (uint64, uint64, error) {
+	res := &GetFeesPaidReply{}
+	err := c.requester.SendRequest(ctx, "platform.getFeesPaid", &GetFeesPaidArgs{
+		JSONAddresses: api.JSONAddresses{
+			Addresses: ids.ShortIDsToStrings(addrs),
+		},
+		FromHeight: json.Uint64(fromHeight),
+		ToHeight:   json.Uint64(toHeight),
+	}, res, options...)
+	return uint64(res.Fees), uint64(res.NumTxs), err
+}
+
 func (c *client) GetMinStake(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (uint64, uint64, error) {
 	res := &GetMinStakeReply{}
 	err := c.requester.SendRequest(ctx, "platform.getMinStake", &GetMinStakeArgs{
@@ -490,6 +939,23 @@ func (c *client) GetMinStake(ctx context.Context, supernetID ids.ID, options ...
 	return uint64(res.MinValidatorStake), uint64(res.MinDelegatorStake), err
 }
 
+func (c *client) GetStakeLimits(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (*GetMinStakeReply, error) {
+	res := &GetMinStakeReply{}
+	err := c.requester.SendRequest(ctx, "platform.getMinStake", &GetMinStakeArgs{
+		SupernetID: supernetID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetValidatorDelegationTerms(ctx context.Context, supernetID ids.ID, nodeID ids.NodeID, options ...rpc.Option) (*GetValidatorDelegationTermsReply, error) {
+	res := &GetValidatorDelegationTermsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getValidatorDelegationTerms", &GetValidatorDelegationTermsArgs{
+		SupernetID: supernetID,
+		NodeID:     nodeID,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetTotalStake(ctx context.Context, supernetID ids.ID, options ...rpc.Option) (uint64, error) {
 	res := &GetTotalStakeReply{}
 	err := c.requester.SendRequest(ctx, "platform.getTotalStake", &GetTotalStakeArgs{
@@ -527,6 +993,33 @@ func (c *client) GetTimestamp(ctx context.Context, options ...rpc.Option) (time.
 	return res.Timestamp, err
 }
 
+func (c *client) GetNextRewardTime(ctx context.Context, options ...rpc.Option) (*GetNextRewardTimeReply, error) {
+	res := &GetNextRewardTimeReply{}
+	err := c.requester.SendRequest(ctx, "platform.getNextRewardTime", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) EstimateReward(
+	ctx context.Context,
+	supernetID ids.ID,
+	stakeAmount uint64,
+	startTime time.Time,
+	endTime time.Time,
+	options ...rpc.Option,
+) (uint64, error) {
+	args := &EstimateRewardArgs{
+		SupernetID:  supernetID,
+		StakeAmount: json.Uint64(stakeAmount),
+		EndTime:     json.Uint64(endTime.Unix()),
+	}
+	if !startTime.IsZero() {
+		args.StartTime = json.Uint64(startTime.Unix())
+	}
+	res := &EstimateRewardReply{}
+	err := c.requester.SendRequest(ctx, "platform.estimateReward", args, res, options...)
+	return uint64(res.PotentialReward), err
+}
+
 func (c *client) GetValidatorsAt(
 	ctx context.Context,
 	supernetID ids.ID,
@@ -541,6 +1034,20 @@ func (c *client) GetValidatorsAt(
 	return res.Validators, err
 }
 
+func (c *client) GetValidatorSetHash(
+	ctx context.Context,
+	supernetID ids.ID,
+	height uint64,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	res := &GetValidatorSetHashReply{}
+	err := c.requester.SendRequest(ctx, "platform.getValidatorSetHash", &GetValidatorSetHashArgs{
+		SupernetID: supernetID,
+		Height:     json.Uint64(height),
+	}, res, options...)
+	return res.Hash, err
+}
+
 func (c *client) GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedBlock{}
 	if err := c.requester.SendRequest(ctx, "platform.getBlock", &api.GetBlockArgs{
@@ -47,6 +47,7 @@ type ClientPermissionlessValidator struct {
 	Uptime                 *float32
 	Connected              *bool
 	Signer                 *signer.ProofOfPossession
+	Metadata               []byte
 	// The delegators delegating to this validator
 	DelegatorCount  *uint64
 	DelegatorWeight *uint64
@@ -135,6 +136,7 @@ func getClientPermissionlessValidators(validatorsSliceIntf []interface{}) ([]Cli
 			Uptime:                 (*float32)(apiValidator.Uptime),
 			Connected:              &apiValidator.Connected,
 			Signer:                 apiValidator.Signer,
+			Metadata:               apiValidator.Metadata,
 			DelegatorCount:         (*uint64)(apiValidator.DelegatorCount),
 			DelegatorWeight:        (*uint64)(apiValidator.DelegatorWeight),
 			Delegators:             clientDelegators,
@@ -0,0 +1,163 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/api"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/json"
+	"github.com/Juneo-io/juneogo/utils/rpc"
+	"github.com/Juneo-io/juneogo/vms/platformvm/status"
+)
+
+// txDecisionRequester reports the statuses in [statuses] in order, repeating
+// the last one, while the height increases by one on every request.
+type txDecisionRequester struct {
+	statuses         []status.Status
+	confirmations    uint64
+	confirmationsErr error
+
+	height            uint64
+	numStatusRequests int
+	numRequests       int
+}
+
+func (r *txDecisionRequester) SendRequest(
+	ctx context.Context,
+	method string,
+	_ interface{},
+	reply interface{},
+	_ ...rpc.Option,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.numRequests++
+	switch method {
+	case "platform.getTxStatus":
+		i := min(r.numStatusRequests, len(r.statuses)-1)
+		reply.(*GetTxStatusResponse).Status = r.statuses[i]
+		r.numStatusRequests++
+	case "platform.getHeight":
+		r.height++
+		reply.(*api.GetHeightResponse).Height = json.Uint64(r.height)
+	case "platform.getTxConfirmations":
+		if r.confirmationsErr != nil {
+			return r.confirmationsErr
+		}
+		reply.(*GetTxConfirmationsReply).Confirmations = json.Uint64(r.confirmations)
+	}
+	return nil
+}
+
+var errNotFound = errors.New("not found")
+
+func TestClientAwaitTxDecided(t *testing.T) {
+	tests := []struct {
+		name                string
+		statuses            []status.Status
+		confirmationsErr    error
+		ctx                 func() (context.Context, context.CancelFunc)
+		expectedRes         *AwaitTxDecidedResponse
+		expectedNumRequests int
+		expectedErr         error
+	}{
+		{
+			name:     "committed",
+			statuses: []status.Status{status.Processing, status.Processing, status.Committed},
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			expectedRes: &AwaitTxDecidedResponse{
+				GetTxStatusResponse: GetTxStatusResponse{Status: status.Committed},
+				Height:              1,
+				Confirmations:       2,
+			},
+			expectedNumRequests: 5,
+		},
+		{
+			name:             "committed without confirmations",
+			statuses:         []status.Status{status.Committed},
+			confirmationsErr: errNotFound,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			expectedRes: &AwaitTxDecidedResponse{
+				GetTxStatusResponse: GetTxStatusResponse{Status: status.Committed},
+				Height:              1,
+			},
+			expectedNumRequests: 3,
+		},
+		{
+			name:     "dropped",
+			statuses: []status.Status{status.Dropped},
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			expectedRes: &AwaitTxDecidedResponse{
+				GetTxStatusResponse: GetTxStatusResponse{Status: status.Dropped},
+				Height:              1,
+			},
+			expectedNumRequests: 2,
+		},
+		{
+			name:     "deadline exceeded",
+			statuses: []status.Status{status.Processing},
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 50*time.Millisecond)
+			},
+			expectedErr: context.DeadlineExceeded,
+		},
+		{
+			name:     "already cancelled",
+			statuses: []status.Status{status.Committed},
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			expectedErr: context.Canceled,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			requester := &txDecisionRequester{
+				statuses:         test.statuses,
+				confirmations:    2,
+				confirmationsErr: test.confirmationsErr,
+			}
+			c := &client{requester: requester}
+
+			ctx, cancel := test.ctx()
+			defer cancel()
+
+			res, err := c.AwaitTxDecided(ctx, ids.GenerateTestID(), time.Nanosecond)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr == nil {
+				require.Equal(test.expectedRes, res)
+				require.Equal(test.expectedNumRequests, requester.numRequests)
+				return
+			}
+
+			// The last observed status is reported along with the error.
+			if requester.numStatusRequests == 0 {
+				require.Nil(res)
+				return
+			}
+			require.Equal(status.Processing, res.Status)
+			require.Zero(res.Height)
+			require.Zero(res.Confirmations)
+		})
+	}
+}
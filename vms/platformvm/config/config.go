@@ -121,6 +121,27 @@ type Config struct {
 	// on recently created supernets (without this, users need to wait for
 	// [recentlyAcceptedWindowTTL] to pass for activation to occur).
 	UseCurrentHeight bool
+
+	// MaxValidatorsInResponse is the maximum number of validators
+	// platform.getCurrentValidators returns when no node IDs are requested.
+	// Requests for larger validator sets must filter by node ID. If 0, there
+	// is no limit.
+	MaxValidatorsInResponse int
+
+	// ReadOnlyAPI makes the platform API reject every request that would
+	// mutate the chain, such as issuing transactions, or the state of the VM,
+	// such as warming the balance cache, while queries are served normally.
+	// This allows operators to run dedicated query nodes.
+	ReadOnlyAPI bool
+
+	// AdminAPIEnabled exposes the admin endpoints of the platform API, such
+	// as warming the balance cache, under the /admin extension of the chain.
+	AdminAPIEnabled bool
+
+	// MempoolMaxSize is the maximum number of bytes of txs held by the
+	// mempool. When it is full, txs paying lower fees per byte are evicted to
+	// make room for new ones. If 0, [mempool.DefaultMaxSize] is used.
+	MempoolMaxSize int
 }
 
 func (c *Config) IsApricotPhase3Activated(timestamp time.Time) bool {
@@ -21,6 +21,7 @@ var DefaultExecutionConfig = ExecutionConfig{
 	ChainDBCacheSize:             2048,
 	BlockIDCacheSize:             8192,
 	FxOwnerCacheSize:             4 * units.MiB,
+	ValidatorSetsCacheSize:       64,
 	ChecksumsEnabled:             false,
 	MempoolPruneFrequency:        30 * time.Minute,
 }
@@ -36,6 +37,8 @@ type ExecutionConfig struct {
 	ChainDBCacheSize             int            `json:"chain-db-cache-size"`
 	BlockIDCacheSize             int            `json:"block-id-cache-size"`
 	FxOwnerCacheSize             int            `json:"fx-owner-cache-size"`
+	// Number of validator sets cached per supernet, keyed by height
+	ValidatorSetsCacheSize       int            `json:"validator-sets-cache-size"`
 	ChecksumsEnabled             bool           `json:"checksums-enabled"`
 	MempoolPruneFrequency        time.Duration  `json:"mempool-prune-frequency"`
 }
@@ -51,5 +54,13 @@ func GetExecutionConfig(b []byte) (*ExecutionConfig, error) {
 		return &ec, nil
 	}
 
-	return &ec, json.Unmarshal(b, &ec)
+	if err := json.Unmarshal(b, &ec); err != nil {
+		return nil, err
+	}
+
+	// a non-positive validator sets cache size would disable caching
+	if ec.ValidatorSetsCacheSize <= 0 {
+		ec.ValidatorSetsCacheSize = DefaultExecutionConfig.ValidatorSetsCacheSize
+	}
+	return &ec, nil
 }
@@ -4,6 +4,7 @@
 package config
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -39,6 +40,16 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 		require.Equal(&expected, ec)
 	})
 
+	t.Run("non-positive validator sets cache size falls back to default", func(t *testing.T) {
+		for _, size := range []int{0, -1} {
+			require := require.New(t)
+			b := []byte(fmt.Sprintf(`{"validator-sets-cache-size":%d}`, size))
+			ec, err := GetExecutionConfig(b)
+			require.NoError(err)
+			require.Equal(&DefaultExecutionConfig, ec)
+		}
+	})
+
 	t.Run("all values extracted from json", func(t *testing.T) {
 		require := require.New(t)
 		b := []byte(`{
@@ -69,6 +80,7 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			"chain-db-cache-size": 7,
 			"block-id-cache-size": 8,
 			"fx-owner-cache-size": 9,
+			"validator-sets-cache-size": 10,
 			"checksums-enabled": true,
 			"mempool-prune-frequency": 60000000000
 		}`)
@@ -102,6 +114,7 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			ChainDBCacheSize:             7,
 			BlockIDCacheSize:             8,
 			FxOwnerCacheSize:             9,
+			ValidatorSetsCacheSize:       10,
 			ChecksumsEnabled:             true,
 			MempoolPruneFrequency:        time.Minute,
 		}
@@ -160,6 +173,7 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			ChainDBCacheSize:             7,
 			BlockIDCacheSize:             8,
 			FxOwnerCacheSize:             9,
+			ValidatorSetsCacheSize:       DefaultExecutionConfig.ValidatorSetsCacheSize,
 			ChecksumsEnabled:             true,
 			MempoolPruneFrequency:        30 * time.Minute,
 		}
@@ -38,6 +38,9 @@ type Metrics interface {
 	SetTimeUntilUnstake(time.Duration)
 	// Mark when this node will unstake from a supernet.
 	SetTimeUntilSupernetUnstake(supernetID ids.ID, timeUntilUnstake time.Duration)
+	// Mark the average time between a tx entering the mempool and the block
+	// including it being accepted.
+	SetBlockBuildLatency(time.Duration)
 }
 
 func New(
@@ -70,6 +73,11 @@ func New(
 			Name:      "total_staked",
 			Help:      "Amount (in nAVAX) of AVAX staked on the Primary Network",
 		}),
+		blockBuildLatency: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "block_build_latency",
+			Help:      "Rolling average of the time (in ns) between a tx entering the mempool and the block including it being accepted",
+		}),
 
 		validatorSetsCached: prometheus.NewCounter(prometheus.CounterOpts{
 			Namespace: namespace,
@@ -102,6 +110,7 @@ func New(
 		registerer.Register(m.timeUntilSupernetUnstake),
 		registerer.Register(m.localStake),
 		registerer.Register(m.totalStake),
+		registerer.Register(m.blockBuildLatency),
 
 		registerer.Register(m.validatorSetsCreated),
 		registerer.Register(m.validatorSetsCached),
@@ -121,6 +130,7 @@ type metrics struct {
 	timeUntilSupernetUnstake *prometheus.GaugeVec
 	localStake             prometheus.Gauge
 	totalStake             prometheus.Gauge
+	blockBuildLatency      prometheus.Gauge
 
 	validatorSetsCached     prometheus.Counter
 	validatorSetsCreated    prometheus.Counter
@@ -163,3 +173,7 @@ func (m *metrics) SetTimeUntilUnstake(timeUntilUnstake time.Duration) {
 func (m *metrics) SetTimeUntilSupernetUnstake(supernetID ids.ID, timeUntilUnstake time.Duration) {
 	m.timeUntilSupernetUnstake.WithLabelValues(supernetID.String()).Set(float64(timeUntilUnstake))
 }
+
+func (m *metrics) SetBlockBuildLatency(latency time.Duration) {
+	m.blockBuildLatency.Set(float64(latency))
+}
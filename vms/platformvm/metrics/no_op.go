@@ -47,6 +47,8 @@ func (noopMetrics) SetTimeUntilUnstake(time.Duration) {}
 
 func (noopMetrics) SetTimeUntilSupernetUnstake(ids.ID, time.Duration) {}
 
+func (noopMetrics) SetBlockBuildLatency(time.Duration) {}
+
 func (noopMetrics) SetSupernetPercentConnected(ids.ID, float64) {}
 
 func (noopMetrics) SetPercentConnected(float64) {}
@@ -4,13 +4,19 @@
 package platformvm
 
 import (
+	"cmp"
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"maps"
 	"math"
+	"math/big"
 	"net/http"
+	"reflect"
+	"slices"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -25,10 +31,13 @@ import (
 	"github.com/Juneo-io/juneogo/utils/crypto/bls"
 	"github.com/Juneo-io/juneogo/utils/crypto/secp256k1"
 	"github.com/Juneo-io/juneogo/utils/formatting"
+	"github.com/Juneo-io/juneogo/utils/hashing"
 	"github.com/Juneo-io/juneogo/utils/logging"
 	"github.com/Juneo-io/juneogo/utils/set"
 	"github.com/Juneo-io/juneogo/vms/components/avax"
 	"github.com/Juneo-io/juneogo/vms/components/keystore"
+	"github.com/Juneo-io/juneogo/vms/components/verify"
+	"github.com/Juneo-io/juneogo/vms/platformvm/block"
 	"github.com/Juneo-io/juneogo/vms/platformvm/fx"
 	"github.com/Juneo-io/juneogo/vms/platformvm/reward"
 	"github.com/Juneo-io/juneogo/vms/platformvm/signer"
@@ -41,21 +50,46 @@ import (
 	avajson "github.com/Juneo-io/juneogo/utils/json"
 	safemath "github.com/Juneo-io/juneogo/utils/math"
 	platformapi "github.com/Juneo-io/juneogo/vms/platformvm/api"
+	blockexecutor "github.com/Juneo-io/juneogo/vms/platformvm/block/executor"
+	txexecutor "github.com/Juneo-io/juneogo/vms/platformvm/txs/executor"
 )
 
 const (
-	// Max number of addresses that can be passed in as argument to GetUTXOs
+	// Max number of addresses GetUTXOs searches at once. More addresses are
+	// searched in chunks of at most this size.
 	maxGetUTXOsAddrs = 1024
 
+	// Max number of addresses that can be passed in as argument to GetUTXOs
+	maxGetUTXOsTotalAddrs = 8 * maxGetUTXOsAddrs
+
 	// Max number of addresses that can be passed in as argument to GetStake
 	maxGetStakeAddrs = 256
 
+	// Max number of addresses that can be passed in as argument to GetFeesPaid
+	maxGetFeesPaidAddrs = 256
+
+	// Max number of blocks GetFeesPaid can walk through in a single call
+	maxGetFeesPaidHeightRange = 1_000
+
+	// Max number of addresses that can be passed in as argument to
+	// GetChainsCreatedBy
+	maxGetChainsCreatedByAddrs = 256
+
 	// Max number of items allowed in a page
 	maxPageSize = 1024
 
+	// Max number of tx IDs that can be passed in as argument to GetTxStatuses
+	maxGetTxStatusesTxIDs = 1024
+
 	// Note: Staker attributes cache should be large enough so that no evictions
 	// happen when the API loops through all stakers.
 	stakerAttributesCacheSize = 100_000
+
+	// Min number of bytes of the tx ID prefix passed to GetTxByPrefix
+	minGetTxByPrefixBytes = 4
+
+	// Max number of matching tx IDs reported when a prefix is ambiguous
+	maxGetTxByPrefixMatches = 10
 )
 
 var (
@@ -63,6 +97,22 @@ var (
 	errPrimaryNetworkIsNotASupernet = errors.New("the primary network isn't a supernet")
 	errNoAddresses                = errors.New("no addresses provided")
 	errMissingBlockchainID        = errors.New("argument 'blockchainID' not given")
+	errNotABlockchain             = errors.New("not a blockchain")
+	errNoDelegation               = errors.New("validator doesn't accept delegators")
+	errInvalidHeightRange         = errors.New("invalid height range")
+	errUnknownSupernetOperation   = errors.New("unknown supernet operation")
+	errPermissionedSupernet       = errors.New("permissioned supernets don't accept delegators")
+	errHeightAboveLastAccepted    = errors.New("height is above the last accepted height")
+	errTooManyTxIDs               = errors.New("too many tx IDs")
+	errTooManyValidators          = errors.New("too many validators")
+	errNoCurrentStakers           = errors.New("no current stakers")
+	errTxPrefixNotHex             = errors.New("tx ID prefix must be hex encoded with a 0x prefix, cb58 isn't supported")
+	errNoTxWithPrefix             = errors.New("no tx ID with prefix")
+	errAmbiguousTxPrefix          = errors.New("ambiguous tx ID prefix")
+	errTxPrefixTooShort           = errors.New("tx ID prefix is too short")
+	errTxHeightNotIndexed         = errors.New("height of tx isn't indexed yet")
+
+	ErrReadOnlyNode = errors.New("node only serves read-only API requests")
 )
 
 // Service defines the API calls that can be made to the platform chain
@@ -70,6 +120,7 @@ type Service struct {
 	vm                    *VM
 	addrManager           avax.AddressManager
 	stakerAttributesCache *cache.LRU[ids.ID, *stakerAttributes]
+	balanceCache          *balanceCache
 }
 
 // All attributes are optional and may not be filled for each stakerTx.
@@ -79,6 +130,7 @@ type stakerAttributes struct {
 	validationRewardsOwner fx.Owner
 	delegationRewardsOwner fx.Owner
 	proofOfPossession      *signer.ProofOfPossession
+	metadata               []byte
 }
 
 // GetHeight returns the height of the last accepted block
@@ -97,6 +149,265 @@ func (s *Service) GetHeight(r *http.Request, _ *struct{}, response *api.GetHeigh
 	return err
 }
 
+// ProcessingBlock describes a verified block that has not been decided yet
+type ProcessingBlock struct {
+	ID     ids.ID         `json:"id"`
+	Height avajson.Uint64 `json:"height"`
+}
+
+// GetProcessingBlocksReply is the response from calling GetProcessingBlocks
+type GetProcessingBlocksReply struct {
+	Blocks []ProcessingBlock `json:"blocks"`
+}
+
+// GetProcessingBlocks returns the blocks that have been verified but not yet
+// accepted or rejected, sorted by increasing height
+func (s *Service) GetProcessingBlocks(_ *http.Request, _ *struct{}, reply *GetProcessingBlocksReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getProcessingBlocks"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	blks, err := s.vm.manager.ProcessingBlocks()
+	if err != nil {
+		return fmt.Errorf("couldn't get processing blocks: %w", err)
+	}
+
+	reply.Blocks = make([]ProcessingBlock, len(blks))
+	for i, blk := range blks {
+		reply.Blocks[i] = ProcessingBlock{
+			ID:     blk.ID(),
+			Height: avajson.Uint64(blk.Height()),
+		}
+	}
+	return nil
+}
+
+// VerifyBlockChainArgs are the arguments for calling VerifyBlockChain
+type VerifyBlockChainArgs struct {
+	// ID of a block that is processing on this node
+	BlockID ids.ID `json:"blockID"`
+}
+
+// VerifyBlockChainReply is the response from calling VerifyBlockChain
+type VerifyBlockChainReply struct {
+	// Blocks that passed verification, from the oldest ancestor that isn't
+	// accepted to the requested block
+	Verified []ids.ID `json:"verified"`
+	// First block of the chain that fails verification, if any
+	FailedBlockID *ids.ID `json:"failedBlockID,omitempty"`
+	// Error returned when verifying [FailedBlockID]
+	Error string `json:"error,omitempty"`
+}
+
+// VerifyBlockChain verifies again the processing block [args.BlockID] and its
+// ancestors back to the last accepted block, each against the state resulting
+// from its parent, and reports the first block that fails verification, which
+// helps debug why a block can't be accepted.
+//
+// The blocks are verified against a copy of the processing states, so calling
+// this method doesn't modify the state of the VM.
+func (s *Service) VerifyBlockChain(_ *http.Request, args *VerifyBlockChainArgs, reply *VerifyBlockChainReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "verifyBlockChain"),
+		zap.Stringer("blkID", args.BlockID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	verified, err := s.vm.manager.VerifyChain(args.BlockID)
+	var verificationErr *blockexecutor.BlockVerificationError
+	switch {
+	case errors.As(err, &verificationErr):
+		reply.FailedBlockID = &verificationErr.BlockID
+		reply.Error = verificationErr.Err.Error()
+	case err != nil:
+		return err
+	}
+
+	reply.Verified = verified
+	return nil
+}
+
+// GetBlockBuildLatencyReply is the response from calling GetBlockBuildLatency
+type GetBlockBuildLatencyReply struct {
+	// Latency is the rolling average, in nanoseconds, of the time between a tx
+	// entering the mempool and the block including it being accepted
+	Latency avajson.Uint64 `json:"latency"`
+}
+
+// GetBlockBuildLatency returns the rolling average of the time it takes for a
+// tx issued to this node to be included into an accepted block
+func (s *Service) GetBlockBuildLatency(_ *http.Request, _ *struct{}, reply *GetBlockBuildLatencyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getBlockBuildLatency"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	reply.Latency = avajson.Uint64(s.vm.manager.GetBlockBuildLatency())
+	return nil
+}
+
+// APIField describes a field of the arguments or of the reply of an API method
+type APIField struct {
+	// Name of the field in the JSON encoding
+	Name string `json:"name"`
+	// Go type of the field
+	Type string `json:"type"`
+}
+
+// APIMethod describes an API method along with the fields of its arguments
+// and of its reply
+type APIMethod struct {
+	Name  string     `json:"name"`
+	Args  []APIField `json:"args"`
+	Reply []APIField `json:"reply"`
+}
+
+// GetAPISchemaReply is the response from calling GetAPISchema
+type GetAPISchemaReply struct {
+	Methods []APIMethod `json:"methods"`
+}
+
+// GetAPISchema returns the methods exposed by this service along with the
+// fields of their arguments and replies, sorted by method name
+func (s *Service) GetAPISchema(_ *http.Request, _ *struct{}, reply *GetAPISchemaReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getAPISchema"),
+	)
+
+	reply.Methods = apiMethods(reflect.TypeOf(s), "platform")
+	return nil
+}
+
+var (
+	errorType       = reflect.TypeOf((*error)(nil)).Elem()
+	httpRequestType = reflect.TypeOf((*http.Request)(nil))
+)
+
+// apiMethods returns the methods of [serviceType] that are exposed over
+// JSON-RPC when the service is registered as [serviceName].
+//
+// Only methods of the form
+//
+//	func (*Service) Method(*http.Request, *Args, *Reply) error
+//
+// are exposed.
+func apiMethods(serviceType reflect.Type, serviceName string) []APIMethod {
+	var methods []APIMethod
+	for i := 0; i < serviceType.NumMethod(); i++ {
+		method := serviceType.Method(i)
+		methodType := method.Type
+		if methodType.NumIn() != 4 || methodType.NumOut() != 1 ||
+			methodType.In(1) != httpRequestType ||
+			methodType.In(2).Kind() != reflect.Pointer ||
+			methodType.In(3).Kind() != reflect.Pointer ||
+			methodType.Out(0) != errorType {
+			continue
+		}
+
+		name := strings.ToLower(method.Name[:1]) + method.Name[1:]
+		methods = append(methods, APIMethod{
+			Name:  serviceName + "." + name,
+			Args:  apiFields(methodType.In(2).Elem()),
+			Reply: apiFields(methodType.In(3).Elem()),
+		})
+	}
+	slices.SortFunc(methods, func(a, b APIMethod) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return methods
+}
+
+// apiFields returns the fields of [t] as they appear in its JSON encoding.
+// Fields of embedded structs are flattened.
+func apiFields(t reflect.Type) []APIField {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := []APIField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			fields = append(fields, apiFields(field.Type)...)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, APIField{
+			Name: name,
+			Type: field.Type.String(),
+		})
+	}
+	return fields
+}
+
+// GetReorgEventsArgs are the arguments for calling GetReorgEvents
+type GetReorgEventsArgs struct {
+	FromHeight avajson.Uint64 `json:"fromHeight"`
+	ToHeight   avajson.Uint64 `json:"toHeight"`
+}
+
+// ReorgEvent describes an accepted block that replaced a block previously
+// preferred by this node at the same height
+type ReorgEvent struct {
+	Height          avajson.Uint64 `json:"height"`
+	AcceptedBlockID ids.ID         `json:"acceptedBlockID"`
+	ReplacedBlockID ids.ID         `json:"replacedBlockID"`
+}
+
+// GetReorgEventsReply is the response from calling GetReorgEvents
+type GetReorgEventsReply struct {
+	Events []ReorgEvent `json:"events"`
+}
+
+// GetReorgEvents returns the reorg events observed by this node between
+// [args.FromHeight] and [args.ToHeight] inclusive, sorted by increasing height
+func (s *Service) GetReorgEvents(_ *http.Request, args *GetReorgEventsArgs, reply *GetReorgEventsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getReorgEvents"),
+		zap.Uint64("fromHeight", uint64(args.FromHeight)),
+		zap.Uint64("toHeight", uint64(args.ToHeight)),
+	)
+
+	if args.FromHeight > args.ToHeight {
+		return fmt.Errorf("%w: from height %d is above to height %d", errInvalidHeightRange, args.FromHeight, args.ToHeight)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	events := s.vm.manager.GetReorgEvents(uint64(args.FromHeight), uint64(args.ToHeight))
+	reply.Events = make([]ReorgEvent, len(events))
+	for i, event := range events {
+		reply.Events[i] = ReorgEvent{
+			Height:          avajson.Uint64(event.Height),
+			AcceptedBlockID: event.AcceptedBlockID,
+			ReplacedBlockID: event.ReplacedBlockID,
+		}
+	}
+	return nil
+}
+
 // ExportKeyArgs are arguments for ExportKey
 type ExportKeyArgs struct {
 	api.UserPass
@@ -142,6 +453,9 @@ func (s *Service) ExportKey(_ *http.Request, args *ExportKeyArgs, reply *ExportK
 
 type GetBalanceRequest struct {
 	Addresses []string `json:"addresses"`
+	// If true, the response also reports the effects of the transactions in
+	// the mempool on the balance of the addresses
+	IncludeMempool bool `json:"includeMempool"`
 }
 
 // Note: We explicitly duplicate AVAX out of the maps to ensure backwards
@@ -157,6 +471,13 @@ type GetBalanceResponse struct {
 	LockedStakeables    map[ids.ID]avajson.Uint64 `json:"lockedStakeables"`
 	LockedNotStakeables map[ids.ID]avajson.Uint64 `json:"lockedNotStakeables"`
 	UTXOIDs             []*avax.UTXOID            `json:"utxoIDs"`
+	// Amounts, in nJUNE, that the transactions in the mempool send to and spend
+	// from the addresses. They are not included in the balances above and are
+	// only reported if the mempool was requested.
+	PendingIncoming  avajson.Uint64            `json:"pendingIncoming,omitempty"`
+	PendingOutgoing  avajson.Uint64            `json:"pendingOutgoing,omitempty"`
+	PendingIncomings map[ids.ID]avajson.Uint64 `json:"pendingIncomings,omitempty"`
+	PendingOutgoings map[ids.ID]avajson.Uint64 `json:"pendingOutgoings,omitempty"`
 }
 
 // GetBalance gets the balance of an address
@@ -175,7 +496,7 @@ func (s *Service) GetBalance(_ *http.Request, args *GetBalanceRequest, response
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	utxos, err := avax.GetAllUTXOs(s.vm.state, addrs)
+	utxos, err := s.balanceCache.getAllUTXOs(addrs)
 	if err != nil {
 		return fmt.Errorf("couldn't get UTXO set of %v: %w", args.Addresses, err)
 	}
@@ -269,6 +590,152 @@ utxoFor:
 	response.Unlocked = response.Unlockeds[s.vm.ctx.JUNEAssetID]
 	response.LockedStakeable = response.LockedStakeables[s.vm.ctx.JUNEAssetID]
 	response.LockedNotStakeable = response.LockedNotStakeables[s.vm.ctx.JUNEAssetID]
+
+	if args.IncludeMempool {
+		pendingIncomings, pendingOutgoings := s.getPendingBalances(addrs, utxos)
+		response.PendingIncomings = newJSONBalanceMap(pendingIncomings)
+		response.PendingOutgoings = newJSONBalanceMap(pendingOutgoings)
+		response.PendingIncoming = response.PendingIncomings[s.vm.ctx.JUNEAssetID]
+		response.PendingOutgoing = response.PendingOutgoings[s.vm.ctx.JUNEAssetID]
+	}
+	return nil
+}
+
+// getPendingBalances returns, by asset, the amounts the txs in the mempool send
+// to [addrs] and the amounts they spend from UTXOs owned by [addrs]. [utxos]
+// are the accepted UTXOs referencing [addrs]. UTXOs produced by a tx in the
+// mempool and spent by another one are counted in both amounts.
+//
+// Invariant: [s.vm.ctx.Lock] is held.
+func (s *Service) getPendingBalances(
+	addrs set.Set[ids.ShortID],
+	utxos []*avax.UTXO,
+) (map[ids.ID]uint64, map[ids.ID]uint64) {
+	addBalance := func(balances map[ids.ID]uint64, assetID ids.ID, amount uint64) {
+		newBalance, err := safemath.Add64(balances[assetID], amount)
+		if err != nil {
+			balances[assetID] = math.MaxUint64
+		} else {
+			balances[assetID] = newBalance
+		}
+	}
+
+	ownedUTXOs := make(map[ids.ID]*avax.UTXO, len(utxos))
+	for _, utxo := range utxos {
+		ownedUTXOs[utxo.InputID()] = utxo
+	}
+
+	incomings := map[ids.ID]uint64{}
+	s.vm.Builder.Iterate(func(tx *txs.Tx) bool {
+		for _, utxo := range tx.UTXOs() {
+			if !isOwnedBy(utxo.Out, addrs) {
+				continue
+			}
+			ownedUTXOs[utxo.InputID()] = utxo
+			if out, ok := utxo.Out.(avax.Amounter); ok {
+				addBalance(incomings, utxo.AssetID(), out.Amount())
+			}
+		}
+		return true
+	})
+
+	outgoings := map[ids.ID]uint64{}
+	s.vm.Builder.Iterate(func(tx *txs.Tx) bool {
+		for inputID := range tx.Unsigned.InputIDs() {
+			utxo, ok := ownedUTXOs[inputID]
+			if !ok {
+				continue
+			}
+			if out, ok := utxo.Out.(avax.Amounter); ok {
+				addBalance(outgoings, utxo.AssetID(), out.Amount())
+			}
+		}
+		return true
+	})
+	return incomings, outgoings
+}
+
+// isOwnedBy returns true if [out] is a transfer output that one of [addrs] can
+// spend once it is unlocked.
+func isOwnedBy(out verify.State, addrs set.Set[ids.ShortID]) bool {
+	if lockOut, ok := out.(*stakeable.LockOut); ok {
+		out = lockOut.TransferableOut
+	}
+	transferOut, ok := out.(*secp256k1fx.TransferOutput)
+	if !ok {
+		return false
+	}
+	for _, addr := range transferOut.Addrs {
+		if addrs.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetLockedUTXOsArgs are the arguments for calling GetLockedUTXOs
+type GetLockedUTXOsArgs struct {
+	api.JSONAddresses
+}
+
+// LockedUTXO is a UTXO whose output is locked until [Locktime]
+type LockedUTXO struct {
+	avax.UTXOID
+	AssetID ids.ID         `json:"assetID"`
+	Amount  avajson.Uint64 `json:"amount"`
+	// Unix time, in seconds, at which the output becomes spendable
+	Locktime avajson.Uint64 `json:"locktime"`
+}
+
+// GetLockedUTXOsReply is the response from calling GetLockedUTXOs
+type GetLockedUTXOsReply struct {
+	// UTXOs sorted by increasing locktime
+	UTXOs []LockedUTXO `json:"utxos"`
+}
+
+// GetLockedUTXOs returns the stakeable locked UTXOs of [args.Addresses] that are
+// still locked, along with the time at which they unlock.
+func (s *Service) GetLockedUTXOs(_ *http.Request, args *GetLockedUTXOsArgs, reply *GetLockedUTXOsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getLockedUTXOs"),
+		logging.UserStrings("addresses", args.Addresses),
+	)
+
+	addrs, err := avax.ParseServiceAddresses(s.addrManager, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	utxos, err := avax.GetAllUTXOs(s.vm.state, addrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get UTXO set of %v: %w", args.Addresses, err)
+	}
+
+	currentTime := s.vm.clock.Unix()
+	reply.UTXOs = []LockedUTXO{}
+	for _, utxo := range utxos {
+		out, ok := utxo.Out.(*stakeable.LockOut)
+		if !ok || out.Locktime <= currentTime {
+			continue
+		}
+
+		reply.UTXOs = append(reply.UTXOs, LockedUTXO{
+			UTXOID:   utxo.UTXOID,
+			AssetID:  utxo.AssetID(),
+			Amount:   avajson.Uint64(out.Amount()),
+			Locktime: avajson.Uint64(out.Locktime),
+		})
+	}
+	slices.SortFunc(reply.UTXOs, func(a, b LockedUTXO) int {
+		if c := cmp.Compare(a.Locktime, b.Locktime); c != 0 {
+			return c
+		}
+		return a.UTXOID.Compare(&b.UTXOID)
+	})
 	return nil
 }
 
@@ -328,8 +795,8 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *ap
 	if len(args.Addresses) == 0 {
 		return errNoAddresses
 	}
-	if len(args.Addresses) > maxGetUTXOsAddrs {
-		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	if len(args.Addresses) > maxGetUTXOsTotalAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsTotalAddrs)
 	}
 
 	var sourceChain ids.ID
@@ -374,25 +841,37 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *ap
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	if sourceChain == s.vm.ctx.ChainID {
-		utxos, endAddr, endUTXOID, err = avax.GetPaginatedUTXOs(
-			s.vm.state,
-			addrSet,
-			startAddr,
-			startUTXO,
-			limit,
-		)
-	} else {
-		utxos, endAddr, endUTXOID, err = avax.GetAtomicUTXOs(
+	fetch := func(addrs set.Set[ids.ShortID], startAddr ids.ShortID, startUTXO ids.ID, limit int) ([]*avax.UTXO, ids.ShortID, ids.ID, error) {
+		if sourceChain == s.vm.ctx.ChainID {
+			return avax.GetPaginatedUTXOs(
+				s.vm.state,
+				addrs,
+				startAddr,
+				startUTXO,
+				limit,
+			)
+		}
+		return avax.GetAtomicUTXOs(
 			s.vm.ctx.SharedMemory,
 			txs.Codec,
 			sourceChain,
-			addrSet,
+			addrs,
 			startAddr,
 			startUTXO,
 			limit,
 		)
 	}
+
+	// More than [maxGetUTXOsAddrs] addresses are searched in sorted chunks, so
+	// the end index also identifies the chunk to resume from.
+	utxos, endAddr, endUTXOID, err = avax.GetChunkedUTXOs(
+		addrSet,
+		startAddr,
+		startUTXO,
+		limit,
+		maxGetUTXOsAddrs,
+		fetch,
+	)
 	if err != nil {
 		return fmt.Errorf("problem retrieving UTXOs: %w", err)
 	}
@@ -437,8 +916,28 @@ type GetSupernetResponse struct {
 	Locktime    avajson.Uint64 `json:"locktime"`
 	// supernet transformation tx ID for a permissionless supernet
 	SupernetTransformationTxID ids.ID `json:"supernetTransformationTxID"`
+	// staking parameters of a permissionless supernet
+	Staking *APISupernetStaking `json:"staking,omitempty"`
+}
+
+// APISupernetStaking is the staking configuration a permissionless supernet
+// was transformed with
+type APISupernetStaking struct {
+	AssetID           ids.ID         `json:"assetID"`
+	MinValidatorStake avajson.Uint64 `json:"minValidatorStake"`
+	MaxValidatorStake avajson.Uint64 `json:"maxValidatorStake"`
+	MinDelegatorStake avajson.Uint64 `json:"minDelegatorStake"`
+	// The minimum and maximum durations, in seconds, of a staking period
+	MinStakeDuration avajson.Uint64 `json:"minStakeDuration"`
+	MaxStakeDuration avajson.Uint64 `json:"maxStakeDuration"`
+	// The minimum and maximum delegation fees, out of 1,000,000, a validator
+	// can charge
+	MinDelegationFee avajson.Uint32 `json:"minDelegationFee"`
+	MaxDelegationFee avajson.Uint32 `json:"maxDelegationFee"`
 }
 
+// GetSupernet returns the owner of a supernet and, if it was transformed, its
+// staking configuration
 func (s *Service) GetSupernet(_ *http.Request, args *GetSupernetArgs, response *GetSupernetResponse) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -476,8 +975,26 @@ func (s *Service) GetSupernet(_ *http.Request, args *GetSupernetArgs, response *
 
 	switch supernetTransformationTx, err := s.vm.state.GetSupernetTransformation(args.SupernetID); err {
 	case nil:
+		transformSupernet, ok := supernetTransformationTx.Unsigned.(*txs.TransformSupernetTx)
+		if !ok {
+			return fmt.Errorf(
+				"unexpected supernet transformation tx type fetched %T",
+				supernetTransformationTx.Unsigned,
+			)
+		}
+
 		response.IsPermissioned = false
 		response.SupernetTransformationTxID = supernetTransformationTx.ID()
+		response.Staking = &APISupernetStaking{
+			AssetID:           transformSupernet.AssetID,
+			MinValidatorStake: avajson.Uint64(transformSupernet.MinValidatorStake),
+			MaxValidatorStake: avajson.Uint64(transformSupernet.MaxValidatorStake),
+			MinDelegatorStake: avajson.Uint64(transformSupernet.MinDelegatorStake),
+			MinStakeDuration:  avajson.Uint64(transformSupernet.MinStakeDuration),
+			MaxStakeDuration:  avajson.Uint64(transformSupernet.MaxStakeDuration),
+			MinDelegationFee:  avajson.Uint32(transformSupernet.MinDelegationFee),
+			MaxDelegationFee:  avajson.Uint32(transformSupernet.MaxDelegationFee),
+		}
 	case database.ErrNotFound:
 		response.IsPermissioned = true
 		response.SupernetTransformationTxID = ids.Empty
@@ -488,53 +1005,182 @@ func (s *Service) GetSupernet(_ *http.Request, args *GetSupernetArgs, response *
 	return nil
 }
 
-// APISupernet is a representation of a supernet used in API calls
-type APISupernet struct {
-	// ID of the supernet
-	ID ids.ID `json:"id"`
-
-	// Each element of [ControlKeys] the address of a public key.
-	// A transaction to add a validator to this supernet requires
-	// signatures from [Threshold] of these keys to be valid.
-	ControlKeys []string       `json:"controlKeys"`
-	Threshold   avajson.Uint32 `json:"threshold"`
+// GetSupernetOwnerHistoryArgs are the arguments to GetSupernetOwnerHistory
+type GetSupernetOwnerHistoryArgs struct {
+	// ID of the supernet to retrieve the owners of
+	SupernetID ids.ID `json:"supernetID"`
 }
 
-// GetSupernetsArgs are the arguments to GetSupernets
-type GetSupernetsArgs struct {
-	// IDs of the supernets to retrieve information about
-	// If omitted, gets all supernets
-	IDs []ids.ID `json:"ids"`
+// APISupernetOwnerChange is an owner of a supernet along with the height of
+// the block that made it effective
+type APISupernetOwnerChange struct {
+	// Height of the block that made [Owner] effective. Nil if the node can't
+	// tell it.
+	Height *avajson.Uint64    `json:"height"`
+	Owner  *platformapi.Owner `json:"owner"`
 }
 
-// GetSupernetsResponse is the response from calling GetSupernets
-type GetSupernetsResponse struct {
-	// Each element is a supernet that exists
-	// Null if there are no supernets other than the primary network
-	Supernets []APISupernet `json:"supernets"`
+// GetSupernetOwnerHistoryResponse is the response from calling
+// GetSupernetOwnerHistory
+type GetSupernetOwnerHistoryResponse struct {
+	// Owners of the supernet sorted by increasing height. The first entry is
+	// the owner the supernet was created with and the last entry is the
+	// current owner.
+	Owners []APISupernetOwnerChange `json:"owners"`
 }
 
-// GetSupernets returns the supernets whose ID are in [args.IDs]
-// The response will include the primary network
-func (s *Service) GetSupernets(_ *http.Request, args *GetSupernetsArgs, response *GetSupernetsResponse) error {
-	s.vm.ctx.Log.Debug("deprecated API called",
+// GetSupernetOwnerHistory returns the owners a supernet had since its creation.
+//
+// Owner changes are only recorded by nodes that executed the blocks issuing
+// them. If the creation of the supernet wasn't recorded, it is backfilled from
+// the CreateSupernetTx. If no change was recorded and the current owner differs
+// from the creation owner, the current owner is reported with an unknown
+// height.
+func (s *Service) GetSupernetOwnerHistory(_ *http.Request, args *GetSupernetOwnerHistoryArgs, response *GetSupernetOwnerHistoryResponse) error {
+	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
-		zap.String("method", "getSupernets"),
+		zap.String("method", "getSupernetOwnerHistory"),
+		zap.Stringer("supernetID", args.SupernetID),
 	)
 
+	if args.SupernetID == constants.PrimaryNetworkID {
+		return errPrimaryNetworkIsNotASupernet
+	}
+
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	getAll := len(args.IDs) == 0
-	if getAll {
-		supernets, err := s.vm.state.GetSupernets() // all supernets
-		if err != nil {
-			return fmt.Errorf("error getting supernets from database: %w", err)
-		}
+	currentOwner, err := s.vm.state.GetSupernetOwner(args.SupernetID)
+	if err != nil {
+		return err
+	}
 
-		response.Supernets = make([]APISupernet, len(supernets)+1)
-		for i, supernet := range supernets {
-			supernetID := supernet.ID()
+	supernetTx, _, err := s.vm.state.GetTx(args.SupernetID)
+	if err != nil {
+		return fmt.Errorf("couldn't get supernet %s: %w", args.SupernetID, err)
+	}
+	createSupernetTx, ok := supernetTx.Unsigned.(*txs.CreateSupernetTx)
+	if !ok {
+		return fmt.Errorf("%q is not a supernet", args.SupernetID)
+	}
+
+	var creationHeight *uint64
+	switch height, err := s.vm.state.GetTxHeight(args.SupernetID); err {
+	case nil:
+		creationHeight = &height
+	case database.ErrNotFound:
+	default:
+		return fmt.Errorf("couldn't get creation height of %s: %w", args.SupernetID, err)
+	}
+
+	history, err := s.vm.state.GetSupernetOwnerHistory(args.SupernetID)
+	if err != nil {
+		return fmt.Errorf("couldn't get owner history of %s: %w", args.SupernetID, err)
+	}
+
+	// Supernets created before owner changes were recorded are missing their
+	// creation entry.
+	recordedCreation := len(history) > 0 && creationHeight != nil && history[0].Height == *creationHeight
+	changes := make([]APISupernetOwnerChange, 0, len(history)+2)
+	if !recordedCreation {
+		creation, err := s.getAPISupernetOwnerChange(creationHeight, createSupernetTx.Owner)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, creation)
+	}
+	for _, change := range history {
+		height := change.Height
+		apiChange, err := s.getAPISupernetOwnerChange(&height, change.Owner)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, apiChange)
+	}
+	currentOutputOwners, _ := currentOwner.(*secp256k1fx.OutputOwners)
+	creationOutputOwners, _ := createSupernetTx.Owner.(*secp256k1fx.OutputOwners)
+	if len(history) == 0 && !currentOutputOwners.Equals(creationOutputOwners) {
+		// The ownership was transferred before owner changes were recorded, so
+		// the height of the transfer is unknown.
+		current, err := s.getAPISupernetOwnerChange(nil, currentOwner)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, current)
+	}
+
+	response.Owners = changes
+	return nil
+}
+
+// getAPISupernetOwnerChange returns the API representation of [owner] made
+// effective at [height]. [height] is nil if it is unknown.
+func (s *Service) getAPISupernetOwnerChange(height *uint64, owner fx.Owner) (APISupernetOwnerChange, error) {
+	outputOwners, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return APISupernetOwnerChange{}, fmt.Errorf("expected *secp256k1fx.OutputOwners but got %T", owner)
+	}
+	apiOwner, err := s.getAPIOwner(outputOwners)
+	if err != nil {
+		return APISupernetOwnerChange{}, fmt.Errorf("problem formatting owner: %w", err)
+	}
+	change := APISupernetOwnerChange{
+		Owner: apiOwner,
+	}
+	if height != nil {
+		apiHeight := avajson.Uint64(*height)
+		change.Height = &apiHeight
+	}
+	return change, nil
+}
+
+// APISupernet is a representation of a supernet used in API calls
+type APISupernet struct {
+	// ID of the supernet
+	ID ids.ID `json:"id"`
+
+	// Each element of [ControlKeys] the address of a public key.
+	// A transaction to add a validator to this supernet requires
+	// signatures from [Threshold] of these keys to be valid.
+	ControlKeys []string       `json:"controlKeys"`
+	Threshold   avajson.Uint32 `json:"threshold"`
+}
+
+// GetSupernetsArgs are the arguments to GetSupernets
+type GetSupernetsArgs struct {
+	// IDs of the supernets to retrieve information about
+	// If omitted, gets all supernets
+	IDs []ids.ID `json:"ids"`
+}
+
+// GetSupernetsResponse is the response from calling GetSupernets
+type GetSupernetsResponse struct {
+	// Each element is a supernet that exists
+	// Null if there are no supernets other than the primary network
+	Supernets []APISupernet `json:"supernets"`
+}
+
+// GetSupernets returns the supernets whose ID are in [args.IDs]
+// The response will include the primary network
+func (s *Service) GetSupernets(_ *http.Request, args *GetSupernetsArgs, response *GetSupernetsResponse) error {
+	s.vm.ctx.Log.Debug("deprecated API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getSupernets"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	getAll := len(args.IDs) == 0
+	if getAll {
+		supernets, err := s.vm.state.GetSupernets() // all supernets
+		if err != nil {
+			return fmt.Errorf("error getting supernets from database: %w", err)
+		}
+
+		response.Supernets = make([]APISupernet, len(supernets)+1)
+		for i, supernet := range supernets {
+			supernetID := supernet.ID()
 			if _, err := s.vm.state.GetSupernetTransformation(supernetID); err == nil {
 				response.Supernets[i] = APISupernet{
 					ID:          supernetID,
@@ -673,6 +1319,61 @@ func (s *Service) GetStakingAssetID(_ *http.Request, args *GetStakingAssetIDArgs
 	return nil
 }
 
+// GetRecognizedAssetsResponse is the response from calling GetRecognizedAssets
+type GetRecognizedAssetsResponse struct {
+	AssetIDs []ids.ID `json:"assetIDs"`
+}
+
+// GetRecognizedAssets returns the IDs of the assets currently recognized by the
+// P-chain. This includes JUNE, every asset that is held in at least one UTXO
+// and the staking asset of every permissionless supernet.
+func (s *Service) GetRecognizedAssets(_ *http.Request, _ *struct{}, response *GetRecognizedAssetsResponse) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getRecognizedAssets"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	assetIDs, err := s.vm.state.GetUTXOAssetIDs()
+	if err != nil {
+		return fmt.Errorf("couldn't get UTXO asset IDs: %w", err)
+	}
+	assetIDs.Add(s.vm.ctx.JUNEAssetID)
+
+	supernets, err := s.vm.state.GetSupernets()
+	if err != nil {
+		return fmt.Errorf("problem retrieving supernets: %w", err)
+	}
+	for _, supernet := range supernets {
+		supernetID := supernet.ID()
+		transformSupernetIntf, err := s.vm.state.GetSupernetTransformation(supernetID)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf(
+				"failed fetching supernet transformation for %s: %w",
+				supernetID,
+				err,
+			)
+		}
+		transformSupernet, ok := transformSupernetIntf.Unsigned.(*txs.TransformSupernetTx)
+		if !ok {
+			return fmt.Errorf(
+				"unexpected supernet transformation tx type fetched %T",
+				transformSupernetIntf.Unsigned,
+			)
+		}
+		assetIDs.Add(transformSupernet.AssetID)
+	}
+
+	response.AssetIDs = assetIDs.List()
+	utils.Sort(response.AssetIDs)
+	return nil
+}
+
 // GetCurrentValidatorsArgs are the arguments for calling GetCurrentValidators
 type GetCurrentValidatorsArgs struct {
 	// Supernet we're listing the validators of
@@ -683,6 +1384,11 @@ type GetCurrentValidatorsArgs struct {
 	// some nodeIDs are not currently validators, they
 	// will be omitted from the response.
 	NodeIDs []ids.NodeID `json:"nodeIDs"`
+	// RewardAddresses of the delegators to request. If
+	// [RewardAddresses] is not empty, only the delegators
+	// rewarding one of these addresses are returned and
+	// validators without such delegators are omitted.
+	RewardAddresses []string `json:"rewardAddresses"`
 }
 
 // GetCurrentValidatorsReply are the results from calling GetCurrentValidators.
@@ -706,11 +1412,16 @@ func (s *Service) loadStakerTxAttributes(txID ids.ID) (*stakerAttributes, error)
 
 	switch stakerTx := tx.Unsigned.(type) {
 	case txs.ValidatorTx:
-		var pop *signer.ProofOfPossession
+		var (
+			pop      *signer.ProofOfPossession
+			metadata []byte
+		)
 		if staker, ok := stakerTx.(*txs.AddPermissionlessValidatorTx); ok {
 			if s, ok := staker.Signer.(*signer.ProofOfPossession); ok {
 				pop = s
 			}
+			// Operators can tag their validator with the memo of the tx.
+			metadata = staker.Memo
 		}
 
 		attr = &stakerAttributes{
@@ -718,6 +1429,7 @@ func (s *Service) loadStakerTxAttributes(txID ids.ID) (*stakerAttributes, error)
 			validationRewardsOwner: stakerTx.ValidationRewardsOwner(),
 			delegationRewardsOwner: stakerTx.DelegationRewardsOwner(),
 			proofOfPossession:      pop,
+			metadata:               metadata,
 		}
 
 	case txs.DelegatorTx:
@@ -735,13 +1447,21 @@ func (s *Service) loadStakerTxAttributes(txID ids.ID) (*stakerAttributes, error)
 
 // GetCurrentValidators returns the current validators. If a single nodeID
 // is provided, full delegators information is also returned. Otherwise only
-// delegators' number and total weight is returned.
+// delegators' number and total weight is returned. If reward addresses are
+// provided, full information of the matching delegators is returned, while
+// the delegators' number and total weight still account for all of them.
 func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidatorsArgs, reply *GetCurrentValidatorsReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
 		zap.String("method", "getCurrentValidators"),
 	)
 
+	rewardAddrs, err := avax.ParseServiceAddresses(s.addrManager, args.RewardAddresses)
+	if err != nil {
+		return err
+	}
+	filterByRewardAddrs := rewardAddrs.Len() > 0
+
 	reply.Validators = []interface{}{}
 
 	// Validator's node ID as string --> Delegators to them
@@ -795,8 +1515,57 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 		}
 	}
 
+	// Delegators rewarding one of [rewardAddrs] and the validators they
+	// delegate to
+	var (
+		rewardedDelegators set.Set[ids.ID]
+		rewardedValidators set.Set[ids.NodeID]
+	)
+	if filterByRewardAddrs {
+		for _, staker := range targetStakers {
+			if !staker.Priority.IsDelegator() {
+				continue
+			}
+			attr, err := s.loadStakerTxAttributes(staker.TxID)
+			if err != nil {
+				return err
+			}
+			owner, ok := attr.rewardsOwner.(*secp256k1fx.OutputOwners)
+			if ok && ownedByAny(owner, rewardAddrs) {
+				rewardedDelegators.Add(staker.TxID)
+				rewardedValidators.Add(staker.NodeID)
+			}
+		}
+	}
+
+	if numNodeIDs == 0 {
+		numValidators := 0
+		for _, staker := range targetStakers {
+			if staker.Priority.IsValidator() && (!filterByRewardAddrs || rewardedValidators.Contains(staker.NodeID)) {
+				numValidators++
+			}
+		}
+		if maxValidators := s.vm.MaxValidatorsInResponse; maxValidators > 0 && numValidators > maxValidators {
+			return fmt.Errorf("%w: %s has %d validators but at most %d can be returned, request them by nodeIDs instead",
+				errTooManyValidators,
+				args.SupernetID,
+				numValidators,
+				maxValidators,
+			)
+		}
+	}
+
+	// Validator's node ID --> Number and total weight of all the delegators
+	// to them, including the ones filtered out of the response
+	vdrToDelegatorCount := map[ids.NodeID]avajson.Uint64{}
+	vdrToDelegatorWeight := map[ids.NodeID]avajson.Uint64{}
+
 	for _, currentStaker := range targetStakers {
 		nodeID := currentStaker.NodeID
+		if filterByRewardAddrs && currentStaker.Priority.IsValidator() && !rewardedValidators.Contains(nodeID) {
+			// Only keep the validators that are delegated to by [rewardAddrs]
+			continue
+		}
 		weight := avajson.Uint64(currentStaker.Weight)
 		apiStaker := platformapi.Staker{
 			TxID:        currentStaker.TxID,
@@ -860,25 +1629,35 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 				DelegationRewardOwner:  delegationRewardOwner,
 				DelegationFee:          delegationFee,
 				Signer:                 attr.proofOfPossession,
+				Metadata:               attr.metadata,
 			}
 			reply.Validators = append(reply.Validators, vdr)
 
 		case txs.PrimaryNetworkDelegatorCurrentPriority, txs.SupernetPermissionlessDelegatorCurrentPriority:
-			var rewardOwner *platformapi.Owner
+			vdrToDelegatorCount[nodeID]++
+			vdrToDelegatorWeight[nodeID] += weight
+
 			// If we are handling multiple nodeIDs, we don't return the
 			// delegator information.
-			if numNodeIDs == 1 {
-				attr, err := s.loadStakerTxAttributes(currentStaker.TxID)
+			includeDelegator := numNodeIDs == 1
+			if filterByRewardAddrs {
+				includeDelegator = rewardedDelegators.Contains(currentStaker.TxID)
+			}
+			if !includeDelegator {
+				continue
+			}
+
+			var rewardOwner *platformapi.Owner
+			attr, err := s.loadStakerTxAttributes(currentStaker.TxID)
+			if err != nil {
+				return err
+			}
+			owner, ok := attr.rewardsOwner.(*secp256k1fx.OutputOwners)
+			if ok {
+				rewardOwner, err = s.getAPIOwner(owner)
 				if err != nil {
 					return err
 				}
-				owner, ok := attr.rewardsOwner.(*secp256k1fx.OutputOwners)
-				if ok {
-					rewardOwner, err = s.getAPIOwner(owner)
-					if err != nil {
-						return err
-					}
-				}
 			}
 
 			delegator := platformapi.PrimaryDelegator{
@@ -917,16 +1696,13 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 			// always return a non-nil value.
 			delegators = []platformapi.PrimaryDelegator{}
 		}
-		delegatorCount := avajson.Uint64(len(delegators))
-		delegatorWeight := avajson.Uint64(0)
-		for _, d := range delegators {
-			delegatorWeight += d.Weight
-		}
+		delegatorCount := vdrToDelegatorCount[vdr.NodeID]
+		delegatorWeight := vdrToDelegatorWeight[vdr.NodeID]
 
 		vdr.DelegatorCount = &delegatorCount
 		vdr.DelegatorWeight = &delegatorWeight
 
-		if numNodeIDs == 1 {
+		if numNodeIDs == 1 || filterByRewardAddrs {
 			// queried a specific validator, load all of its delegators
 			vdr.Delegators = &delegators
 		}
@@ -936,63 +1712,613 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 	return nil
 }
 
-// GetCurrentSupplyArgs are the arguments for calling GetCurrentSupply
-type GetCurrentSupplyArgs struct {
+// ownedByAny returns true if any of [addrs] is one of the owners of [owner]
+func ownedByAny(owner *secp256k1fx.OutputOwners, addrs set.Set[ids.ShortID]) bool {
+	for _, addr := range owner.Addrs {
+		if addrs.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPendingValidatorsArgs are the arguments for calling GetPendingValidators
+type GetPendingValidatorsArgs struct {
+	// Supernet we're getting the pending validators of
+	// If omitted, defaults to primary network
 	SupernetID ids.ID `json:"supernetID"`
+	// NodeIDs of validators to request. If [NodeIDs]
+	// is empty, it fetches all pending validators. If
+	// some requested nodeIDs are not pending validators,
+	// they are omitted from the response.
+	NodeIDs []ids.NodeID `json:"nodeIDs"`
 }
 
-// GetCurrentSupplyReply are the results from calling GetCurrentSupply
-type GetCurrentSupplyReply struct {
-	Supply avajson.Uint64 `json:"supply"`
-	Height avajson.Uint64 `json:"height"`
+// GetPendingValidatorsReply are the results from calling GetPendingValidators.
+type GetPendingValidatorsReply struct {
+	Validators []interface{} `json:"validators"`
+	Delegators []interface{} `json:"delegators"`
 }
 
-// GetCurrentSupply returns an upper bound on the supply of AVAX in the system
-func (s *Service) GetCurrentSupply(r *http.Request, args *GetCurrentSupplyArgs, reply *GetCurrentSupplyReply) error {
+// GetPendingValidators returns the lists of pending validators and delegators.
+//
+// Pending permissionless validators are reported with an estimate of their
+// potential reward, as their actual potential reward is only computed once
+// they start validating.
+func (s *Service) GetPendingValidators(_ *http.Request, args *GetPendingValidatorsArgs, reply *GetPendingValidatorsReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
-		zap.String("method", "getCurrentSupply"),
+		zap.String("method", "getPendingValidators"),
 	)
 
+	reply.Validators = []interface{}{}
+	reply.Delegators = []interface{}{}
+
+	// Create set of nodeIDs
+	nodeIDs := set.Of(args.NodeIDs...)
+
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	supply, err := s.vm.state.GetCurrentSupply(args.SupernetID)
-	if err != nil {
-		return fmt.Errorf("fetching current supply failed: %w", err)
-	}
-	reply.Supply = avajson.Uint64(supply)
+	numNodeIDs := nodeIDs.Len()
+	targetStakers := make([]*state.Staker, 0, numNodeIDs)
+	if numNodeIDs == 0 { // Include all nodes
+		pendingStakerIterator, err := s.vm.state.GetPendingStakerIterator()
+		if err != nil {
+			return err
+		}
+		for pendingStakerIterator.Next() { // Iterates in order of increasing stop time
+			staker := pendingStakerIterator.Value()
+			if args.SupernetID != staker.SupernetID {
+				continue
+			}
+			targetStakers = append(targetStakers, staker)
+		}
+		pendingStakerIterator.Release()
+	} else {
+		for nodeID := range nodeIDs {
+			staker, err := s.vm.state.GetPendingValidator(args.SupernetID, nodeID)
+			switch err {
+			case nil:
+			case database.ErrNotFound:
+				// nothing to do, continue
+				continue
+			default:
+				return err
+			}
+			targetStakers = append(targetStakers, staker)
 
-	ctx := r.Context()
-	height, err := s.vm.GetCurrentHeight(ctx)
-	if err != nil {
-		return fmt.Errorf("fetching current height failed: %w", err)
+			delegatorsIt, err := s.vm.state.GetPendingDelegatorIterator(args.SupernetID, nodeID)
+			if err != nil {
+				return err
+			}
+			for delegatorsIt.Next() {
+				staker := delegatorsIt.Value()
+				targetStakers = append(targetStakers, staker)
+			}
+			delegatorsIt.Release()
+		}
 	}
-	reply.Height = avajson.Uint64(height)
 
-	return nil
-}
+	for _, pendingStaker := range targetStakers {
+		nodeID := pendingStaker.NodeID
+		weight := avajson.Uint64(pendingStaker.Weight)
+		apiStaker := platformapi.Staker{
+			TxID:        pendingStaker.TxID,
+			NodeID:      nodeID,
+			StartTime:   avajson.Uint64(pendingStaker.StartTime.Unix()),
+			EndTime:     avajson.Uint64(pendingStaker.EndTime.Unix()),
+			Weight:      weight,
+			StakeAmount: &weight,
+		}
 
-// GetRewardPoolSupplyArgs are the arguments for calling GetRewardPoolSupply
-type GetRewardPoolSupplyArgs struct {
-	SupernetID ids.ID `json:"supernetID"`
-}
+		switch pendingStaker.Priority {
+		case txs.PrimaryNetworkValidatorPendingPriority, txs.SupernetPermissionlessValidatorPendingPriority:
+			attr, err := s.loadStakerTxAttributes(pendingStaker.TxID)
+			if err != nil {
+				return err
+			}
 
-// GetRewardPoolSupplyReply are the results from calling GetRewardPoolSupply
-type GetRewardPoolSupplyReply struct {
-	RewardPoolSupply avajson.Uint64 `json:"rewardPoolSupply"`
-}
+			shares := attr.shares
+			delegationFee := avajson.Float32(100 * float32(shares) / float32(reward.PercentDenominator))
 
-// GetRewardPoolSupply returns an upper bound on the supply of AVAX in the system
-func (s *Service) GetRewardPoolSupply(_ *http.Request, args *GetRewardPoolSupplyArgs, reply *GetRewardPoolSupplyReply) error {
-	s.vm.ctx.Log.Debug("API called",
-		zap.String("service", "platform"),
-		zap.String("method", "getRewardPoolSupply"),
-	)
+			estimatedReward, err := s.estimatePotentialReward(pendingStaker)
+			if err != nil {
+				return err
+			}
+			jsonEstimatedReward := avajson.Uint64(estimatedReward)
 
-	rewardPoolSupply, err := s.vm.state.GetRewardPoolSupply(args.SupernetID)
-	reply.RewardPoolSupply = avajson.Uint64(rewardPoolSupply)
-	return err
+			connected := s.vm.uptimeManager.IsConnected(nodeID, args.SupernetID)
+			var (
+				validationRewardOwner *platformapi.Owner
+				delegationRewardOwner *platformapi.Owner
+			)
+			validationOwner, ok := attr.validationRewardsOwner.(*secp256k1fx.OutputOwners)
+			if ok {
+				validationRewardOwner, err = s.getAPIOwner(validationOwner)
+				if err != nil {
+					return err
+				}
+			}
+			delegationOwner, ok := attr.delegationRewardsOwner.(*secp256k1fx.OutputOwners)
+			if ok {
+				delegationRewardOwner, err = s.getAPIOwner(delegationOwner)
+				if err != nil {
+					return err
+				}
+			}
+
+			vdr := platformapi.PermissionlessValidator{
+				Staker:                   apiStaker,
+				Connected:                connected,
+				EstimatedPotentialReward: &jsonEstimatedReward,
+				RewardOwner:              validationRewardOwner,
+				ValidationRewardOwner:    validationRewardOwner,
+				DelegationRewardOwner:    delegationRewardOwner,
+				DelegationFee:            delegationFee,
+				Signer:                   attr.proofOfPossession,
+				Metadata:                 attr.metadata,
+			}
+			reply.Validators = append(reply.Validators, vdr)
+
+		case txs.PrimaryNetworkDelegatorApricotPendingPriority, txs.PrimaryNetworkDelegatorBanffPendingPriority, txs.SupernetPermissionlessDelegatorPendingPriority:
+			reply.Delegators = append(reply.Delegators, apiStaker)
+
+		case txs.SupernetPermissionedValidatorPendingPriority:
+			connected := s.vm.uptimeManager.IsConnected(nodeID, args.SupernetID)
+			reply.Validators = append(reply.Validators, platformapi.PermissionedValidator{
+				Staker:    apiStaker,
+				Connected: connected,
+			})
+
+		default:
+			return fmt.Errorf("unexpected staker priority %d", pendingStaker.Priority)
+		}
+	}
+	return nil
+}
+
+// GetNodeSupernetsArgs are the arguments for calling GetNodeSupernets
+type GetNodeSupernetsArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+}
+
+// NodeSupernet is a supernet validated by a node
+type NodeSupernet struct {
+	SupernetID ids.ID `json:"supernetID"`
+	// Weight of the node on the supernet, including the weight delegated to it
+	Weight avajson.Uint64 `json:"weight"`
+}
+
+// GetNodeSupernetsReply is the response from calling GetNodeSupernets
+type GetNodeSupernetsReply struct {
+	// Supernets the node currently validates, sorted by supernet ID
+	Supernets []NodeSupernet `json:"supernets"`
+}
+
+// GetNodeSupernets returns the supernets, including the primary network, that
+// [args.NodeID] currently validates along with its weight on each of them.
+func (s *Service) GetNodeSupernets(_ *http.Request, args *GetNodeSupernetsArgs, reply *GetNodeSupernetsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getNodeSupernets"),
+		zap.Stringer("nodeID", args.NodeID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	validatedSupernets, err := s.getValidatedSupernets(args.NodeID)
+	if err != nil {
+		return fmt.Errorf("couldn't retrieve validated supernets: %w", err)
+	}
+
+	reply.Supernets = make([]NodeSupernet, 0, len(validatedSupernets))
+	for supernetID, weight := range validatedSupernets {
+		reply.Supernets = append(reply.Supernets, NodeSupernet{
+			SupernetID: supernetID,
+			Weight:     avajson.Uint64(weight),
+		})
+	}
+	slices.SortFunc(reply.Supernets, func(a, b NodeSupernet) int {
+		return a.SupernetID.Compare(b.SupernetID)
+	})
+	return nil
+}
+
+// GetAccruedRewardArgs are the arguments for calling GetAccruedReward
+type GetAccruedRewardArgs struct {
+	// ID of the supernet the node validates
+	// If omitted, defaults to the primary network
+	SupernetID ids.ID     `json:"supernetID"`
+	NodeID     ids.NodeID `json:"nodeID"`
+}
+
+// GetAccruedRewardReply is the response from calling GetAccruedReward
+type GetAccruedRewardReply struct {
+	// Reward the validator is entitled to at the end of its staking period
+	PotentialReward avajson.Uint64 `json:"potentialReward"`
+	// Share of [PotentialReward] earned so far
+	AccruedReward avajson.Uint64 `json:"accruedReward"`
+	// Primary network uptime of the validator, as a percentage, over its
+	// staking period so far
+	Uptime avajson.Float32 `json:"uptime"`
+}
+
+// GetAccruedReward estimates the reward [args.NodeID] has earned so far for
+// validating [args.SupernetID]. The potential reward is prorated by the time
+// elapsed since the validator started staking, according to the current chain
+// time. If the validator's uptime is currently below the requirement to be
+// rewarded, the accrued reward is reported as 0.
+func (s *Service) GetAccruedReward(_ *http.Request, args *GetAccruedRewardArgs, reply *GetAccruedRewardReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getAccruedReward"),
+		zap.Stringer("supernetID", args.SupernetID),
+		zap.Stringer("nodeID", args.NodeID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	staker, err := s.vm.state.GetCurrentValidator(args.SupernetID, args.NodeID)
+	if err != nil {
+		return fmt.Errorf("couldn't get validator %s of %s: %w", args.NodeID, args.SupernetID, err)
+	}
+
+	// Mirror the check performed by block options when deciding whether to
+	// reward a staker, which only considers the primary network uptime.
+	primaryNetworkValidator, err := s.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, args.NodeID)
+	if err != nil {
+		return fmt.Errorf("couldn't get primary network validator %s: %w", args.NodeID, err)
+	}
+	uptime, err := s.vm.uptimeManager.CalculateUptimePercentFrom(
+		args.NodeID,
+		constants.PrimaryNetworkID,
+		primaryNetworkValidator.StartTime,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't calculate uptime of %s: %w", args.NodeID, err)
+	}
+
+	expectedUptimePercentage := s.vm.UptimePercentage
+	if args.SupernetID != constants.PrimaryNetworkID {
+		transformSupernet, err := txexecutor.GetTransformSupernetTx(s.vm.state, args.SupernetID)
+		if err != nil {
+			return fmt.Errorf("couldn't get transformation of %s: %w", args.SupernetID, err)
+		}
+		expectedUptimePercentage = float64(transformSupernet.UptimeRequirement) / reward.PercentDenominator
+	}
+
+	reply.PotentialReward = avajson.Uint64(staker.PotentialReward)
+	reply.Uptime = avajson.Float32(uptime * 100)
+	if uptime < expectedUptimePercentage {
+		return nil
+	}
+
+	var (
+		now           = s.vm.state.GetTimestamp()
+		stakingPeriod = staker.EndTime.Sub(staker.StartTime)
+		elapsed       = now.Sub(staker.StartTime)
+	)
+	switch {
+	case elapsed <= 0 || stakingPeriod <= 0:
+		reply.AccruedReward = 0
+	case elapsed >= stakingPeriod:
+		reply.AccruedReward = reply.PotentialReward
+	default:
+		// potentialReward * elapsed / stakingPeriod can't exceed
+		// potentialReward, but the intermediate product may overflow a uint64.
+		accrued := new(big.Int).SetUint64(staker.PotentialReward)
+		accrued.Mul(accrued, big.NewInt(int64(elapsed)))
+		accrued.Div(accrued, big.NewInt(int64(stakingPeriod)))
+		reply.AccruedReward = avajson.Uint64(accrued.Uint64())
+	}
+	return nil
+}
+
+// EstimateRewardArgs are the arguments for calling EstimateReward
+type EstimateRewardArgs struct {
+	// ID of the supernet to stake on
+	// If omitted, defaults to the primary network
+	SupernetID  ids.ID         `json:"supernetID"`
+	StakeAmount avajson.Uint64 `json:"stakeAmount"`
+	// Unix time at which the stake starts
+	// If omitted, defaults to the current chain time
+	StartTime avajson.Uint64 `json:"startTime"`
+	// Unix time at which the stake ends
+	EndTime avajson.Uint64 `json:"endTime"`
+}
+
+// EstimateRewardReply is the response from calling EstimateReward
+type EstimateRewardReply struct {
+	// Reward a staker would be entitled to at the end of its staking period
+	PotentialReward avajson.Uint64 `json:"potentialReward"`
+}
+
+// EstimateReward returns the reward a prospective staker would be entitled to,
+// computed with the state of the last accepted block. Rewards on a supernet are
+// capped by its reward pool supply, while the primary network mints its
+// rewards so they aren't capped.
+func (s *Service) EstimateReward(_ *http.Request, args *EstimateRewardArgs, reply *EstimateRewardReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "estimateReward"),
+		zap.Stringer("supernetID", args.SupernetID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	minStakeDuration := s.vm.MinStakeDuration
+	maxStakeDuration := s.vm.MaxStakeDuration
+	if args.SupernetID != constants.PrimaryNetworkID {
+		transformSupernet, err := txexecutor.GetTransformSupernetTx(s.vm.state, args.SupernetID)
+		if err != nil {
+			return fmt.Errorf("couldn't get transformation of %s: %w", args.SupernetID, err)
+		}
+		minStakeDuration = time.Duration(transformSupernet.MinStakeDuration) * time.Second
+		maxStakeDuration = time.Duration(transformSupernet.MaxStakeDuration) * time.Second
+	}
+
+	startTime := s.vm.state.GetTimestamp()
+	if args.StartTime != 0 {
+		startTime = time.Unix(int64(args.StartTime), 0)
+	}
+	endTime := time.Unix(int64(args.EndTime), 0)
+
+	switch duration := endTime.Sub(startTime); {
+	case duration < minStakeDuration:
+		return fmt.Errorf("%w: %s < %s", txexecutor.ErrStakeTooShort, duration, minStakeDuration)
+	case duration > maxStakeDuration:
+		return fmt.Errorf("%w: %s > %s", txexecutor.ErrStakeTooLong, duration, maxStakeDuration)
+	}
+
+	potentialReward, err := s.estimatePotentialReward(&state.Staker{
+		SupernetID: args.SupernetID,
+		Weight:     uint64(args.StakeAmount),
+		StartTime:  startTime,
+		EndTime:    endTime,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't estimate reward: %w", err)
+	}
+	reply.PotentialReward = avajson.Uint64(potentialReward)
+	return nil
+}
+
+// estimatePotentialReward estimates the reward [staker] will be entitled to
+// once it starts staking. The estimate is computed over the proposed staking
+// period using the current state. For supernets, it is capped by the current
+// reward pool supply, which may change before the staker is moved into the
+// current staker set.
+func (s *Service) estimatePotentialReward(staker *state.Staker) (uint64, error) {
+	backend := &txexecutor.Backend{
+		Rewards: reward.NewCalculator(s.vm.RewardConfig),
+	}
+	rewards, err := txexecutor.GetRewardsCalculator(backend, s.vm.state, staker.SupernetID)
+	if err != nil {
+		return 0, err
+	}
+
+	potentialReward := rewards.Calculate(
+		staker.EndTime.Sub(staker.StartTime),
+		staker.StartTime,
+		staker.Weight,
+	)
+	// Only the primary network can mint new tokens, supernets rewards are
+	// capped by their reward pool.
+	if staker.SupernetID == constants.PrimaryNetworkID {
+		return potentialReward, nil
+	}
+
+	rewardPoolSupply, err := s.vm.state.GetRewardPoolSupply(staker.SupernetID)
+	if err != nil {
+		return 0, err
+	}
+	return min(potentialReward, rewardPoolSupply), nil
+}
+
+// GetUpcomingRewardsArgs are the arguments for calling GetUpcomingRewards
+type GetUpcomingRewardsArgs struct {
+	// ID of the supernet the stakers stake on
+	// If omitted, defaults to the primary network
+	SupernetID ids.ID `json:"supernetID"`
+	// Max number of stakers to return
+	// If omitted or above [maxPageSize], defaults to [maxPageSize]
+	Limit avajson.Uint32 `json:"limit"`
+}
+
+// UpcomingReward is a current staker that will be rewarded at the end of its
+// staking period
+type UpcomingReward struct {
+	TxID        ids.ID     `json:"txID"`
+	NodeID      ids.NodeID `json:"nodeID"`
+	IsDelegator bool       `json:"isDelegator"`
+	// Unix time at which the stake ends
+	EndTime avajson.Uint64 `json:"endTime"`
+	// Reward the staker is entitled to at the end of its staking period
+	PotentialReward avajson.Uint64 `json:"potentialReward"`
+}
+
+// GetUpcomingRewardsReply is the response from calling GetUpcomingRewards
+type GetUpcomingRewardsReply struct {
+	// Stakers sorted by the order in which they will be rewarded
+	Rewards []UpcomingReward `json:"rewards"`
+}
+
+// GetUpcomingRewards returns the next [args.Limit] stakers of
+// [args.SupernetID] to be rewarded, in the order in which their staking
+// periods end. Permissioned supernet validators are not rewarded and are
+// omitted.
+func (s *Service) GetUpcomingRewards(_ *http.Request, args *GetUpcomingRewardsArgs, reply *GetUpcomingRewardsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getUpcomingRewards"),
+		zap.Stringer("supernetID", args.SupernetID),
+		zap.Uint32("limit", uint32(args.Limit)),
+	)
+
+	limit := int(args.Limit)
+	if limit <= 0 || maxPageSize < limit {
+		limit = maxPageSize
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	currentStakerIterator, err := s.vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return err
+	}
+	defer currentStakerIterator.Release()
+
+	reply.Rewards = []UpcomingReward{}
+	for len(reply.Rewards) < limit && currentStakerIterator.Next() {
+		staker := currentStakerIterator.Value()
+		if staker.SupernetID != args.SupernetID ||
+			staker.Priority == txs.SupernetPermissionedValidatorCurrentPriority {
+			continue
+		}
+		reply.Rewards = append(reply.Rewards, UpcomingReward{
+			TxID:            staker.TxID,
+			NodeID:          staker.NodeID,
+			IsDelegator:     !staker.Priority.IsValidator(),
+			EndTime:         avajson.Uint64(staker.EndTime.Unix()),
+			PotentialReward: avajson.Uint64(staker.PotentialReward),
+		})
+	}
+	return nil
+}
+
+// GetCurrentSupplyArgs are the arguments for calling GetCurrentSupply
+type GetCurrentSupplyArgs struct {
+	SupernetID ids.ID `json:"supernetID"`
+}
+
+// GetCurrentSupplyReply are the results from calling GetCurrentSupply
+type GetCurrentSupplyReply struct {
+	Supply avajson.Uint64 `json:"supply"`
+	Height avajson.Uint64 `json:"height"`
+}
+
+// GetCurrentSupply returns an upper bound on the supply of AVAX in the system
+func (s *Service) GetCurrentSupply(r *http.Request, args *GetCurrentSupplyArgs, reply *GetCurrentSupplyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getCurrentSupply"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	supply, err := s.vm.state.GetCurrentSupply(args.SupernetID)
+	if err != nil {
+		return fmt.Errorf("fetching current supply failed: %w", err)
+	}
+	reply.Supply = avajson.Uint64(supply)
+
+	ctx := r.Context()
+	height, err := s.vm.GetCurrentHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching current height failed: %w", err)
+	}
+	reply.Height = avajson.Uint64(height)
+
+	return nil
+}
+
+// GetProjectedSupplyArgs are the arguments for calling GetProjectedSupply
+type GetProjectedSupplyArgs struct {
+	SupernetID ids.ID `json:"supernetID"`
+	// Unix timestamp, in seconds, to project the supply to
+	Time avajson.Uint64 `json:"time"`
+}
+
+// GetProjectedSupplyReply are the results from calling GetProjectedSupply
+type GetProjectedSupplyReply struct {
+	Supply avajson.Uint64 `json:"supply"`
+	Height avajson.Uint64 `json:"height"`
+}
+
+// GetProjectedSupply returns an upper bound on the supply of the staking asset
+// of [args.SupernetID], JUNE for the primary network, at the requested time.
+//
+// The current supply is increased by the estimated potential reward of every
+// pending staker whose staking period ends by the requested time. This is an
+// upper bound as part of the rewards may be paid out of the reward pool, the
+// estimates are computed with the current reward parameters and stakers may
+// not be rewarded.
+func (s *Service) GetProjectedSupply(r *http.Request, args *GetProjectedSupplyArgs, reply *GetProjectedSupplyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getProjectedSupply"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	supply, err := s.vm.state.GetCurrentSupply(args.SupernetID)
+	if err != nil {
+		return fmt.Errorf("fetching current supply failed: %w", err)
+	}
+
+	atTime := time.Unix(int64(args.Time), 0)
+	pendingStakerIterator, err := s.vm.state.GetPendingStakerIterator()
+	if err != nil {
+		return err
+	}
+	defer pendingStakerIterator.Release()
+
+	for pendingStakerIterator.Next() {
+		staker := pendingStakerIterator.Value()
+		if staker.SupernetID != args.SupernetID ||
+			staker.Priority == txs.SupernetPermissionedValidatorPendingPriority ||
+			staker.EndTime.After(atTime) {
+			continue
+		}
+
+		potentialReward, err := s.estimatePotentialReward(staker)
+		if err != nil {
+			return err
+		}
+		supply, err = safemath.Add64(supply, potentialReward)
+		if err != nil {
+			return err
+		}
+	}
+	reply.Supply = avajson.Uint64(supply)
+
+	ctx := r.Context()
+	height, err := s.vm.GetCurrentHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching current height failed: %w", err)
+	}
+	reply.Height = avajson.Uint64(height)
+
+	return nil
+}
+
+// GetRewardPoolSupplyArgs are the arguments for calling GetRewardPoolSupply
+type GetRewardPoolSupplyArgs struct {
+	SupernetID ids.ID `json:"supernetID"`
+}
+
+// GetRewardPoolSupplyReply are the results from calling GetRewardPoolSupply
+type GetRewardPoolSupplyReply struct {
+	RewardPoolSupply avajson.Uint64 `json:"rewardPoolSupply"`
+}
+
+// GetRewardPoolSupply returns an upper bound on the supply of AVAX in the system
+func (s *Service) GetRewardPoolSupply(_ *http.Request, args *GetRewardPoolSupplyArgs, reply *GetRewardPoolSupplyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getRewardPoolSupply"),
+	)
+
+	rewardPoolSupply, err := s.vm.state.GetRewardPoolSupply(args.SupernetID)
+	reply.RewardPoolSupply = avajson.Uint64(rewardPoolSupply)
+	return err
 }
 
 // GetFeePoolValueReply are the results from calling GetFeePoolValue
@@ -1008,6 +2334,116 @@ func (s *Service) GetFeePoolValue(_ *http.Request, _ *struct{}, reply *GetFeePoo
 	return nil
 }
 
+// GetFeeConfigReply is the response from calling GetFeeConfig
+type GetFeeConfigReply struct {
+	// Unix timestamp, in seconds, the fees are effective at
+	Timestamp                     avajson.Uint64 `json:"timestamp"`
+	TxFee                         avajson.Uint64 `json:"txFee"`
+	CreateAssetTxFee              avajson.Uint64 `json:"createAssetTxFee"`
+	CreateSupernetTxFee           avajson.Uint64 `json:"createSupernetTxFee"`
+	TransformSupernetTxFee        avajson.Uint64 `json:"transformSupernetTxFee"`
+	CreateBlockchainTxFee         avajson.Uint64 `json:"createBlockchainTxFee"`
+	AddPrimaryNetworkValidatorFee avajson.Uint64 `json:"addPrimaryNetworkValidatorFee"`
+	AddPrimaryNetworkDelegatorFee avajson.Uint64 `json:"addPrimaryNetworkDelegatorFee"`
+	AddSupernetValidatorFee       avajson.Uint64 `json:"addSupernetValidatorFee"`
+	AddSupernetDelegatorFee       avajson.Uint64 `json:"addSupernetDelegatorFee"`
+}
+
+// GetFeeConfig returns the fees effective at the timestamp of the last
+// accepted block.
+func (s *Service) GetFeeConfig(_ *http.Request, _ *struct{}, reply *GetFeeConfigReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getFeeConfig"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	timestamp := s.vm.state.GetTimestamp()
+	reply.Timestamp = avajson.Uint64(timestamp.Unix())
+	reply.TxFee = avajson.Uint64(s.vm.TxFee)
+	reply.CreateAssetTxFee = avajson.Uint64(s.vm.CreateAssetTxFee)
+	reply.CreateSupernetTxFee = avajson.Uint64(s.vm.GetCreateSupernetTxFee(timestamp))
+	reply.TransformSupernetTxFee = avajson.Uint64(s.vm.TransformSupernetTxFee)
+	reply.CreateBlockchainTxFee = avajson.Uint64(s.vm.GetCreateBlockchainTxFee(timestamp))
+	reply.AddPrimaryNetworkValidatorFee = avajson.Uint64(s.vm.AddPrimaryNetworkValidatorFee)
+	reply.AddPrimaryNetworkDelegatorFee = avajson.Uint64(s.vm.AddPrimaryNetworkDelegatorFee)
+	reply.AddSupernetValidatorFee = avajson.Uint64(s.vm.AddSupernetValidatorFee)
+	reply.AddSupernetDelegatorFee = avajson.Uint64(s.vm.AddSupernetDelegatorFee)
+	return nil
+}
+
+// Operations whose fee can be fetched with GetSupernetOperationFee
+const (
+	AddValidatorOperation = "addValidator"
+	AddDelegatorOperation = "addDelegator"
+)
+
+// GetSupernetOperationFeeArgs are the arguments for calling
+// GetSupernetOperationFee
+type GetSupernetOperationFeeArgs struct {
+	// ID of the supernet the operation is performed on
+	// If omitted, defaults to the primary network
+	SupernetID ids.ID `json:"supernetID"`
+	// Either [AddValidatorOperation] or [AddDelegatorOperation]
+	Operation string `json:"operation"`
+}
+
+// GetSupernetOperationFeeReply is the response from calling
+// GetSupernetOperationFee
+type GetSupernetOperationFeeReply struct {
+	Fee avajson.Uint64 `json:"fee"`
+}
+
+// GetSupernetOperationFee returns the fee burned when performing [Operation] on
+// the supernet [SupernetID]. The fees are global: every supernet other than the
+// primary network charges the same fees. Delegating is only possible on the
+// primary network and on transformed supernets.
+func (s *Service) GetSupernetOperationFee(_ *http.Request, args *GetSupernetOperationFeeArgs, reply *GetSupernetOperationFeeReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getSupernetOperationFee"),
+		zap.Stringer("supernetID", args.SupernetID),
+		zap.String("operation", args.Operation),
+	)
+
+	if args.Operation != AddValidatorOperation && args.Operation != AddDelegatorOperation {
+		return fmt.Errorf("%w: %q", errUnknownSupernetOperation, args.Operation)
+	}
+
+	if args.SupernetID == constants.PrimaryNetworkID {
+		if args.Operation == AddValidatorOperation {
+			reply.Fee = avajson.Uint64(s.vm.AddPrimaryNetworkValidatorFee)
+		} else {
+			reply.Fee = avajson.Uint64(s.vm.AddPrimaryNetworkDelegatorFee)
+		}
+		return nil
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	if _, err := s.vm.state.GetSupernetOwner(args.SupernetID); err != nil {
+		return fmt.Errorf("failed fetching supernet %s: %w", args.SupernetID, err)
+	}
+
+	if args.Operation == AddValidatorOperation {
+		reply.Fee = avajson.Uint64(s.vm.AddSupernetValidatorFee)
+		return nil
+	}
+
+	switch _, err := s.vm.state.GetSupernetTransformation(args.SupernetID); err {
+	case nil:
+		reply.Fee = avajson.Uint64(s.vm.AddSupernetDelegatorFee)
+		return nil
+	case database.ErrNotFound:
+		return fmt.Errorf("%w: %s", errPermissionedSupernet, args.SupernetID)
+	default:
+		return err
+	}
+}
+
 // SampleValidatorsArgs are the arguments for calling SampleValidators
 type SampleValidatorsArgs struct {
 	// Number of validators in the sample
@@ -1016,11 +2452,25 @@ type SampleValidatorsArgs struct {
 	// ID of supernet to sample validators from
 	// If omitted, defaults to the primary network
 	SupernetID ids.ID `json:"supernetID"`
+
+	// If true, the weight and BLS public key of each sampled validator are
+	// also returned
+	IncludeDetails bool `json:"includeDetails"`
+}
+
+// SampledValidator is the weight and BLS public key of a sampled validator
+type SampledValidator struct {
+	NodeID    ids.NodeID     `json:"nodeID"`
+	Weight    avajson.Uint64 `json:"weight"`
+	PublicKey *string        `json:"publicKey"`
 }
 
 // SampleValidatorsReply are the results from calling Sample
 type SampleValidatorsReply struct {
 	Validators []ids.NodeID `json:"validators"`
+	// Only populated if [IncludeDetails] was set in the request. Contains one
+	// entry per element of [Validators], in the same order.
+	ValidatorDetails []SampledValidator `json:"validatorDetails,omitempty"`
 }
 
 // SampleValidators returns a sampling of the list of current validators
@@ -1042,6 +2492,124 @@ func (s *Service) SampleValidators(_ *http.Request, args *SampleValidatorsArgs,
 		utils.Sort(sample)
 		reply.Validators = sample
 	}
+
+	if !args.IncludeDetails {
+		return nil
+	}
+
+	reply.ValidatorDetails = make([]SampledValidator, 0, len(reply.Validators))
+	for _, nodeID := range reply.Validators {
+		vdr, ok := s.vm.Validators.GetValidator(args.SupernetID, nodeID)
+		if !ok {
+			return fmt.Errorf("%w: %s was removed from %s while sampling", database.ErrNotFound, nodeID, args.SupernetID)
+		}
+
+		details := SampledValidator{
+			NodeID: nodeID,
+			Weight: avajson.Uint64(vdr.Weight),
+		}
+		if vdr.PublicKey != nil {
+			pk, err := formatting.Encode(formatting.HexNC, bls.PublicKeyToCompressedBytes(vdr.PublicKey))
+			if err != nil {
+				return err
+			}
+			details.PublicKey = &pk
+		}
+		reply.ValidatorDetails = append(reply.ValidatorDetails, details)
+	}
+	return nil
+}
+
+// GetConnectedValidatorsArgs are the arguments for calling
+// GetConnectedValidators
+type GetConnectedValidatorsArgs struct {
+	// ID of the supernet to get the connected validators of
+	// If omitted, defaults to the primary network
+	SupernetID ids.ID `json:"supernetID"`
+}
+
+// GetConnectedValidatorsReply are the results from calling
+// GetConnectedValidators
+type GetConnectedValidatorsReply struct {
+	Validators []ids.NodeID `json:"validators"`
+}
+
+// GetConnectedValidators returns the current validators of a supernet this
+// node is connected to
+func (s *Service) GetConnectedValidators(_ *http.Request, args *GetConnectedValidatorsArgs, reply *GetConnectedValidatorsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getConnectedValidators"),
+		zap.Stringer("supernetID", args.SupernetID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	reply.Validators = []ids.NodeID{}
+	for _, nodeID := range s.vm.Validators.GetValidatorIDs(args.SupernetID) {
+		if s.vm.uptimeManager.IsConnected(nodeID, args.SupernetID) {
+			reply.Validators = append(reply.Validators, nodeID)
+		}
+	}
+	utils.Sort(reply.Validators)
+	return nil
+}
+
+// GetConnectedPeersArgs are the arguments for calling GetConnectedPeers
+type GetConnectedPeersArgs struct {
+	// ID of the supernet to get the validators of
+	// If omitted, defaults to the primary network
+	SupernetID ids.ID `json:"supernetID"`
+}
+
+// ConnectedPeer is the connectivity of a current validator
+type ConnectedPeer struct {
+	NodeID    ids.NodeID `json:"nodeID"`
+	Connected bool       `json:"connected"`
+	// Percentage of its current staking period the validator was observed
+	// to be online. Only reported for the supernets this node tracks.
+	Uptime *avajson.Float32 `json:"uptime,omitempty"`
+}
+
+// GetConnectedPeersReply are the results from calling GetConnectedPeers
+type GetConnectedPeersReply struct {
+	Peers []ConnectedPeer `json:"peers"`
+}
+
+// GetConnectedPeers returns whether this node is connected to each of the
+// current validators of a supernet, along with their observed uptime. Unlike
+// GetCurrentValidators, the staker txs aren't looked up, which makes it cheap
+// enough to be polled frequently.
+func (s *Service) GetConnectedPeers(_ *http.Request, args *GetConnectedPeersArgs, reply *GetConnectedPeersReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getConnectedPeers"),
+		zap.Stringer("supernetID", args.SupernetID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	nodeIDs := s.vm.Validators.GetValidatorIDs(args.SupernetID)
+	utils.Sort(nodeIDs)
+
+	reply.Peers = make([]ConnectedPeer, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		staker, err := s.vm.state.GetCurrentValidator(args.SupernetID, nodeID)
+		if err != nil {
+			return fmt.Errorf("couldn't get validator %s: %w", nodeID, err)
+		}
+		uptime, err := s.getAPIUptime(staker)
+		if err != nil {
+			return fmt.Errorf("couldn't get uptime of %s: %w", nodeID, err)
+		}
+		reply.Peers = append(reply.Peers, ConnectedPeer{
+			NodeID:    nodeID,
+			Connected: s.vm.uptimeManager.IsConnected(nodeID, args.SupernetID),
+			Uptime:    uptime,
+		})
+	}
 	return nil
 }
 
@@ -1246,6 +2814,9 @@ type APIBlockchain struct {
 
 	// The main asset used by this chain to pay the fees
 	ChainAssetID ids.ID `json:"chainAssetID"`
+
+	// True if this node currently validates the supernet of the blockchain
+	Validating bool `json:"validating"`
 }
 
 // GetBlockchainsResponse is the response from a call to GetBlockchains
@@ -1266,7 +2837,12 @@ func (s *Service) GetBlockchains(_ *http.Request, _ *struct{}, response *GetBloc
 
 	supernets, err := s.vm.state.GetSupernets()
 	if err != nil {
-		return fmt.Errorf("couldn't retrieve supernets: %w", err)
+		return fmt.Errorf("couldn't retrieve supernets: %w", err)
+	}
+
+	validatedSupernets, err := s.getValidatedSupernets(s.vm.ctx.NodeID)
+	if err != nil {
+		return fmt.Errorf("couldn't retrieve validated supernets: %w", err)
 	}
 
 	response.Blockchains = []APIBlockchain{}
@@ -1281,6 +2857,7 @@ func (s *Service) GetBlockchains(_ *http.Request, _ *struct{}, response *GetBloc
 			)
 		}
 
+		_, validating := validatedSupernets[supernetID]
 		for _, chainTx := range chains {
 			chainID := chainTx.ID()
 			chain, ok := chainTx.Unsigned.(*txs.CreateChainTx)
@@ -1293,6 +2870,7 @@ func (s *Service) GetBlockchains(_ *http.Request, _ *struct{}, response *GetBloc
 				SupernetID:     supernetID,
 				VMID:         chain.VMID,
 				ChainAssetID: chain.ChainAssetID,
+				Validating:   validating,
 			})
 		}
 	}
@@ -1301,6 +2879,7 @@ func (s *Service) GetBlockchains(_ *http.Request, _ *struct{}, response *GetBloc
 	if err != nil {
 		return fmt.Errorf("couldn't retrieve supernets: %w", err)
 	}
+	_, validating := validatedSupernets[constants.PrimaryNetworkID]
 	for _, chainTx := range chains {
 		chainID := chainTx.ID()
 		chain, ok := chainTx.Unsigned.(*txs.CreateChainTx)
@@ -1313,18 +2892,204 @@ func (s *Service) GetBlockchains(_ *http.Request, _ *struct{}, response *GetBloc
 			SupernetID:     constants.PrimaryNetworkID,
 			VMID:         chain.VMID,
 			ChainAssetID: chain.ChainAssetID,
+			Validating:   validating,
 		})
 	}
 
 	return nil
 }
 
+// getValidatedSupernets returns the supernets, including the primary network,
+// that [nodeID] currently validates, mapped to its weight on each of them.
+//
+// Invariant: [s.vm.ctx.Lock] is held.
+func (s *Service) getValidatedSupernets(nodeID ids.NodeID) (map[ids.ID]uint64, error) {
+	supernets, err := s.vm.state.GetSupernets()
+	if err != nil {
+		return nil, err
+	}
+
+	supernetIDs := make([]ids.ID, 0, len(supernets)+1)
+	supernetIDs = append(supernetIDs, constants.PrimaryNetworkID)
+	for _, supernet := range supernets {
+		supernetIDs = append(supernetIDs, supernet.ID())
+	}
+
+	validatedSupernets := make(map[ids.ID]uint64)
+	for _, supernetID := range supernetIDs {
+		if vdr, ok := s.vm.Validators.GetValidator(supernetID, nodeID); ok {
+			validatedSupernets[supernetID] = vdr.Weight
+		}
+	}
+	return validatedSupernets, nil
+}
+
+// GetChainsCreatedByArgs are the arguments for calling GetChainsCreatedBy
+type GetChainsCreatedByArgs struct {
+	api.JSONAddresses
+}
+
+// GetChainsCreatedByResponse is the response from calling GetChainsCreatedBy
+type GetChainsCreatedByResponse struct {
+	// blockchains whose creation tx was funded by the addresses
+	Blockchains []APIBlockchain `json:"blockchains"`
+}
+
+// GetChainsCreatedBy returns the blockchains whose CreateChainTx consumed an
+// input signed by any of [args.Addresses]. The signatures authorizing the
+// supernet operation aren't considered, as the supernet's control keys don't
+// create the chain.
+func (s *Service) GetChainsCreatedBy(_ *http.Request, args *GetChainsCreatedByArgs, response *GetChainsCreatedByResponse) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getChainsCreatedBy"),
+		logging.UserStrings("addresses", args.Addresses),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetChainsCreatedByAddrs {
+		return fmt.Errorf("%d addresses provided but this method can take at most %d", len(args.Addresses), maxGetChainsCreatedByAddrs)
+	}
+
+	addrs, err := avax.ParseServiceAddresses(s.addrManager, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	chainTxs, err := s.getChainTxs()
+	if err != nil {
+		return err
+	}
+
+	// Recovering the signers of the txs is expensive, so it is done without
+	// holding the lock.
+	response.Blockchains = []APIBlockchain{}
+	for supernetID, chains := range chainTxs {
+		for _, chainTx := range chains {
+			chainID := chainTx.ID()
+			chain, ok := chainTx.Unsigned.(*txs.CreateChainTx)
+			if !ok {
+				return fmt.Errorf("expected tx type *txs.CreateChainTx but got %T", chainTx.Unsigned)
+			}
+
+			paid, err := isPaidBy(chainTx, addrs)
+			if err != nil {
+				return fmt.Errorf("couldn't recover signers of tx %s: %w", chainID, err)
+			}
+			if !paid {
+				continue
+			}
+
+			response.Blockchains = append(response.Blockchains, APIBlockchain{
+				ID:           chainID,
+				Name:         chain.ChainName,
+				SupernetID:   supernetID,
+				VMID:         chain.VMID,
+				ChainAssetID: chain.ChainAssetID,
+			})
+		}
+	}
+	return nil
+}
+
+// getChainTxs returns the CreateChainTxs of every supernet, including the
+// primary network, keyed by supernet ID.
+func (s *Service) getChainTxs() (map[ids.ID][]*txs.Tx, error) {
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	supernets, err := s.vm.state.GetSupernets()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't retrieve supernets: %w", err)
+	}
+
+	supernetIDs := make([]ids.ID, 0, len(supernets)+1)
+	for _, supernet := range supernets {
+		supernetIDs = append(supernetIDs, supernet.ID())
+	}
+	supernetIDs = append(supernetIDs, constants.PrimaryNetworkID)
+
+	chainTxs := make(map[ids.ID][]*txs.Tx, len(supernetIDs))
+	for _, supernetID := range supernetIDs {
+		chains, err := s.vm.state.GetChains(supernetID)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"couldn't retrieve chains for supernet %q: %w",
+				supernetID,
+				err,
+			)
+		}
+		chainTxs[supernetID] = chains
+	}
+	return chainTxs, nil
+}
+
+// GetChainConfigArgs are the arguments for calling GetChainConfig
+type GetChainConfigArgs struct {
+	ChainID ids.ID `json:"chainID"`
+}
+
+// GetChainConfigResponse is the response from calling GetChainConfig
+type GetChainConfigResponse struct {
+	// Blockchain's (non-unique) human-readable name
+	Name string `json:"name"`
+
+	// Supernet that validates the blockchain
+	SupernetID ids.ID `json:"supernetID"`
+
+	// Virtual Machine the blockchain runs
+	VMID ids.ID `json:"vmID"`
+
+	// Feature extensions the blockchain runs with
+	FxIDs []ids.ID `json:"fxIDs"`
+}
+
+// GetChainConfig returns the configuration a blockchain was created with
+func (s *Service) GetChainConfig(_ *http.Request, args *GetChainConfigArgs, response *GetChainConfigResponse) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getChainConfig"),
+		zap.Stringer("chainID", args.ChainID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	chainTx, _, err := s.vm.state.GetTx(args.ChainID)
+	if err != nil {
+		return fmt.Errorf(
+			"problem retrieving blockchain %q: %w",
+			args.ChainID,
+			err,
+		)
+	}
+	chain, ok := chainTx.Unsigned.(*txs.CreateChainTx)
+	if !ok {
+		return fmt.Errorf("%w: %q", errNotABlockchain, args.ChainID)
+	}
+
+	response.Name = chain.ChainName
+	response.SupernetID = chain.SupernetID
+	response.VMID = chain.VMID
+	response.FxIDs = chain.FxIDs
+	if response.FxIDs == nil {
+		response.FxIDs = []ids.ID{}
+	}
+	return nil
+}
+
 func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, response *api.JSONTxID) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
 		zap.String("method", "issueTx"),
 	)
 
+	if s.vm.ReadOnlyAPI {
+		return ErrReadOnlyNode
+	}
+
 	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
 	if err != nil {
 		return fmt.Errorf("problem decoding transaction: %w", err)
@@ -1372,6 +3137,71 @@ func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetT
 	return err
 }
 
+type GetTxJSONArgs struct {
+	TxID ids.ID `json:"txID"`
+}
+
+type GetTxJSONReply struct {
+	TxID ids.ID `json:"txID"`
+	// Name of the type of the unsigned tx, such as "BaseTx" or
+	// "AddValidatorTx"
+	Type string `json:"type"`
+	// Decoded fields of the unsigned tx
+	UnsignedTx json.RawMessage `json:"unsignedTx"`
+}
+
+// GetTxJSON returns the type and the decoded fields of an accepted tx, so that
+// clients don't need the codec to inspect it.
+func (s *Service) GetTxJSON(_ *http.Request, args *GetTxJSONArgs, reply *GetTxJSONReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getTxJSON"),
+		zap.Stringer("txID", args.TxID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	tx, _, err := s.vm.state.GetTx(args.TxID)
+	if err != nil {
+		return fmt.Errorf("couldn't get tx: %w", err)
+	}
+
+	return s.formatTxJSON(tx, reply)
+}
+
+// DecodeTx parses the provided tx and returns its type and decoded fields, the
+// same way as GetTxJSON, without issuing it. Neither the state nor the mempool
+// are accessed.
+func (s *Service) DecodeTx(_ *http.Request, args *api.FormattedTx, reply *GetTxJSONReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "decodeTx"),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't parse tx: %w", err)
+	}
+	return s.formatTxJSON(tx, reply)
+}
+
+func (s *Service) formatTxJSON(tx *txs.Tx, reply *GetTxJSONReply) error {
+	tx.Unsigned.InitCtx(s.vm.ctx)
+	unsignedTx, err := json.Marshal(tx.Unsigned)
+	if err != nil {
+		return fmt.Errorf("couldn't marshal tx: %w", err)
+	}
+	reply.TxID = tx.ID()
+	reply.Type = reflect.TypeOf(tx.Unsigned).Elem().Name()
+	reply.UnsignedTx = unsignedTx
+	return nil
+}
+
 type GetTxStatusArgs struct {
 	TxID ids.ID `json:"txID"`
 }
@@ -1393,13 +3223,66 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	_, txStatus, err := s.vm.state.GetTx(args.TxID)
+	txStatus, err := s.getTxStatus(args.TxID)
+	if err != nil {
+		return err
+	}
+	*response = *txStatus
+	return nil
+}
+
+// GetTxStatusesArgs are the arguments for calling GetTxStatuses
+type GetTxStatusesArgs struct {
+	TxIDs []ids.ID `json:"txIDs"`
+}
+
+// GetTxStatusesReply is the response from calling GetTxStatuses
+type GetTxStatusesReply struct {
+	// Status of each requested tx, keyed by tx ID
+	Statuses map[ids.ID]GetTxStatusResponse `json:"statuses"`
+}
+
+// GetTxStatuses gets the status of multiple txs at once. At most
+// [maxGetTxStatusesTxIDs] tx IDs can be provided.
+func (s *Service) GetTxStatuses(_ *http.Request, args *GetTxStatusesArgs, reply *GetTxStatusesReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getTxStatuses"),
+		zap.Int("numTxIDs", len(args.TxIDs)),
+	)
+
+	if len(args.TxIDs) > maxGetTxStatusesTxIDs {
+		return fmt.Errorf("%w: %d tx IDs provided but this method can take at most %d",
+			errTooManyTxIDs,
+			len(args.TxIDs),
+			maxGetTxStatusesTxIDs,
+		)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	reply.Statuses = make(map[ids.ID]GetTxStatusResponse, len(args.TxIDs))
+	for _, txID := range args.TxIDs {
+		txStatus, err := s.getTxStatus(txID)
+		if err != nil {
+			return fmt.Errorf("couldn't get status of tx %s: %w", txID, err)
+		}
+		reply.Statuses[txID] = *txStatus
+	}
+	return nil
+}
+
+// getTxStatus returns the status of [txID] as seen by this node.
+//
+// Invariant: [s.vm.ctx.Lock] is held.
+func (s *Service) getTxStatus(txID ids.ID) (*GetTxStatusResponse, error) {
+	_, txStatus, err := s.vm.state.GetTx(txID)
 	if err == nil { // Found the status. Report it.
-		response.Status = txStatus
-		return nil
+		return &GetTxStatusResponse{Status: txStatus}, nil
 	}
 	if err != database.ErrNotFound {
-		return err
+		return nil, err
 	}
 
 	// The status of this transaction is not in the database - check if the tx
@@ -1407,37 +3290,160 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 	preferredID := s.vm.manager.Preferred()
 	onAccept, ok := s.vm.manager.GetState(preferredID)
 	if !ok {
-		return fmt.Errorf("could not retrieve state for block %s", preferredID)
+		return nil, fmt.Errorf("could not retrieve state for block %s", preferredID)
 	}
 
-	_, _, err = onAccept.GetTx(args.TxID)
+	_, _, err = onAccept.GetTx(txID)
 	if err == nil {
 		// Found the status in the preferred block's db. Report tx is processing.
-		response.Status = status.Processing
-		return nil
+		return &GetTxStatusResponse{Status: status.Processing}, nil
 	}
 	if err != database.ErrNotFound {
+		return nil, err
+	}
+
+	if _, ok := s.vm.Builder.Get(txID); ok {
+		// Found the tx in the mempool. Report tx is processing.
+		return &GetTxStatusResponse{Status: status.Processing}, nil
+	}
+
+	// Note: we check if tx is dropped only after having looked for it
+	// in the database and the mempool, because dropped txs may be re-issued.
+	reason := s.vm.Builder.GetDropReason(txID)
+	if reason == nil {
+		// The tx isn't being tracked by the node.
+		return &GetTxStatusResponse{Status: status.Unknown}, nil
+	}
+
+	// The tx was recently dropped because it was invalid.
+	return &GetTxStatusResponse{
+		Status: status.Dropped,
+		Reason: reason.Error(),
+	}, nil
+}
+
+// GetTxByPrefixArgs are the arguments for calling GetTxByPrefix
+type GetTxByPrefixArgs struct {
+	// Prefix of the tx ID, hex encoded with a 0x prefix
+	Prefix string `json:"prefix"`
+}
+
+// GetTxByPrefixReply is the response from calling GetTxByPrefix
+type GetTxByPrefixReply struct {
+	TxID ids.ID `json:"txID"`
+}
+
+// GetTxByPrefix returns the ID of the only committed tx whose ID starts with
+// [args.Prefix].
+//
+// The prefix must be hex encoded with a 0x prefix and be at least
+// [minGetTxByPrefixBytes] long so that it is looked up in the tx index with a
+// short key range. cb58 prefixes aren't supported: the leading characters of a
+// cb58 ID don't map to a prefix of its bytes.
+func (s *Service) GetTxByPrefix(_ *http.Request, args *GetTxByPrefixArgs, reply *GetTxByPrefixReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getTxByPrefix"),
+		zap.String("prefix", args.Prefix),
+	)
+
+	hexPrefix, ok := strings.CutPrefix(args.Prefix, "0x")
+	if !ok {
+		return fmt.Errorf("%w: %q", errTxPrefixNotHex, args.Prefix)
+	}
+	prefix, err := hex.DecodeString(hexPrefix)
+	if err != nil {
+		return fmt.Errorf("couldn't decode hex prefix %q: %w", args.Prefix, err)
+	}
+	if len(prefix) < minGetTxByPrefixBytes {
+		return fmt.Errorf("%w: %d bytes < %d", errTxPrefixTooShort, len(prefix), minGetTxByPrefixBytes)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	txIDs, err := s.vm.state.GetTxIDs(prefix, maxGetTxByPrefixMatches+1)
+	if err != nil {
+		return fmt.Errorf("couldn't look up tx IDs: %w", err)
+	}
+
+	switch {
+	case len(txIDs) > 1:
+		return fmt.Errorf("%w: %q matches %v", errAmbiguousTxPrefix, args.Prefix, txIDs[:min(len(txIDs), maxGetTxByPrefixMatches)])
+	case len(txIDs) == 0:
+		return fmt.Errorf("%w %q", errNoTxWithPrefix, args.Prefix)
+	}
+
+	reply.TxID = txIDs[0]
+	return nil
+}
+
+// GetTxConfirmationsArgs are the arguments for calling GetTxConfirmations
+type GetTxConfirmationsArgs struct {
+	TxID ids.ID `json:"txID"`
+}
+
+// GetTxConfirmationsReply is the response from calling GetTxConfirmations
+type GetTxConfirmationsReply struct {
+	// Number of accepted blocks, including the one that included the tx. Zero
+	// if the tx is still processing.
+	Confirmations avajson.Uint64 `json:"confirmations"`
+}
+
+// GetTxConfirmations returns how deep [args.TxID] is in the chain: the number of
+// accepted blocks built on top of the block that included it, plus one.
+func (s *Service) GetTxConfirmations(r *http.Request, args *GetTxConfirmationsArgs, reply *GetTxConfirmationsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getTxConfirmations"),
+		zap.Stringer("txID", args.TxID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	_, txStatus, err := s.vm.state.GetTx(args.TxID)
+	if err == database.ErrNotFound {
+		// The tx hasn't been accepted - check if it is processing.
+		preferredID := s.vm.manager.Preferred()
+		onAccept, ok := s.vm.manager.GetState(preferredID)
+		if !ok {
+			return fmt.Errorf("could not retrieve state for block %s", preferredID)
+		}
+
+		_, _, err = onAccept.GetTx(args.TxID)
+		if err == nil {
+			return nil
+		}
+		if err != database.ErrNotFound {
+			return err
+		}
+		if _, ok := s.vm.Builder.Get(args.TxID); ok {
+			return nil
+		}
+		return database.ErrNotFound
+	}
+	if err != nil {
 		return err
 	}
-
-	if _, ok := s.vm.Builder.Get(args.TxID); ok {
-		// Found the tx in the mempool. Report tx is processing.
-		response.Status = status.Processing
+	if txStatus != status.Committed {
 		return nil
 	}
 
-	// Note: we check if tx is dropped only after having looked for it
-	// in the database and the mempool, because dropped txs may be re-issued.
-	reason := s.vm.Builder.GetDropReason(args.TxID)
-	if reason == nil {
-		// The tx isn't being tracked by the node.
-		response.Status = status.Unknown
-		return nil
+	txHeight, err := s.vm.state.GetTxHeight(args.TxID)
+	if err == database.ErrNotFound {
+		// The tx was accepted before txs were indexed by height, and the
+		// index of the past txs is still being built.
+		return fmt.Errorf("%w: %s", errTxHeightNotIndexed, args.TxID)
 	}
-
-	// The tx was recently dropped because it was invalid.
-	response.Status = status.Dropped
-	response.Reason = reason.Error()
+	if err != nil {
+		return fmt.Errorf("couldn't get height of tx %s: %w", args.TxID, err)
+	}
+	lastAcceptedHeight, err := s.vm.GetCurrentHeight(r.Context())
+	if err != nil {
+		return fmt.Errorf("couldn't get last accepted height: %w", err)
+	}
+	reply.Confirmations = avajson.Uint64(lastAcceptedHeight - txHeight + 1)
 	return nil
 }
 
@@ -1548,6 +3554,281 @@ func (s *Service) GetStake(_ *http.Request, args *GetStakeArgs, response *GetSta
 	return nil
 }
 
+// GetAccountStateArgs are the arguments for calling GetAccountState
+type GetAccountStateArgs struct {
+	api.JSONAddresses
+}
+
+// GetAccountStateReply is the response from calling GetAccountState
+type GetAccountStateReply struct {
+	// Balances of the addresses, as returned by GetBalance
+	Balance GetBalanceResponse `json:"balance"`
+	// Amounts staked by the addresses, as returned by GetStake
+	Stake GetStakeReply `json:"stake"`
+	// Reward asset --> sum of the potential rewards of the current stakers
+	// rewarding the addresses
+	PendingRewards map[ids.ID]avajson.Uint64 `json:"pendingRewards"`
+	// Number of UTXOs held by the addresses
+	NumUTXOs avajson.Uint64 `json:"numUTXOs"`
+}
+
+// GetAccountState returns the balances, stake, pending rewards and UTXO count
+// of [args.Addresses] in a single call.
+//
+// The result is composed from GetBalance and GetStake, so each part may be
+// computed against a different accepted state.
+func (s *Service) GetAccountState(r *http.Request, args *GetAccountStateArgs, reply *GetAccountStateReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getAccountState"),
+		logging.UserStrings("addresses", args.Addresses),
+	)
+
+	if len(args.Addresses) > maxGetStakeAddrs {
+		return fmt.Errorf("%d addresses provided but this method can take at most %d", len(args.Addresses), maxGetStakeAddrs)
+	}
+
+	addrs, err := avax.ParseServiceAddresses(s.addrManager, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	err = s.GetBalance(r, &GetBalanceRequest{
+		Addresses: args.Addresses,
+	}, &reply.Balance)
+	if err != nil {
+		return fmt.Errorf("couldn't get balance: %w", err)
+	}
+	reply.NumUTXOs = avajson.Uint64(len(reply.Balance.UTXOIDs))
+
+	err = s.GetStake(r, &GetStakeArgs{
+		JSONAddresses: args.JSONAddresses,
+		Encoding:      formatting.Hex,
+	}, &reply.Stake)
+	if err != nil {
+		return fmt.Errorf("couldn't get stake: %w", err)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	pendingRewards, err := s.getPendingRewards(addrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get pending rewards: %w", err)
+	}
+	reply.PendingRewards = newJSONBalanceMap(pendingRewards)
+	return nil
+}
+
+// getPendingRewards returns, for each reward asset, the sum of the potential
+// rewards of the current stakers whose rewards owner contains one of [addrs].
+//
+// Invariant: [s.vm.ctx.Lock] is held.
+func (s *Service) getPendingRewards(addrs set.Set[ids.ShortID]) (map[ids.ID]uint64, error) {
+	currentStakerIterator, err := s.vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer currentStakerIterator.Release()
+
+	var (
+		pendingRewards = make(map[ids.ID]uint64)
+		rewardAssets   = map[ids.ID]ids.ID{
+			constants.PrimaryNetworkID: s.vm.ctx.JUNEAssetID,
+		}
+	)
+	for currentStakerIterator.Next() {
+		staker := currentStakerIterator.Value()
+		if staker.PotentialReward == 0 {
+			continue
+		}
+
+		// The staker attributes are cached, so repeated calls don't fetch the
+		// tx of every staker.
+		attr, err := s.loadStakerTxAttributes(staker.TxID)
+		if err != nil {
+			return nil, err
+		}
+
+		rewardsOwner := attr.validationRewardsOwner
+		if staker.Priority.IsDelegator() {
+			rewardsOwner = attr.rewardsOwner
+		}
+		owner, ok := rewardsOwner.(*secp256k1fx.OutputOwners)
+		if !ok || !slices.ContainsFunc(owner.Addrs, addrs.Contains) {
+			continue
+		}
+
+		// Rewards are paid in the staked asset of the supernet.
+		assetID, ok := rewardAssets[staker.SupernetID]
+		if !ok {
+			transformSupernetIntf, err := s.vm.state.GetSupernetTransformation(staker.SupernetID)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"failed fetching supernet transformation for %s: %w",
+					staker.SupernetID,
+					err,
+				)
+			}
+			transformSupernet, ok := transformSupernetIntf.Unsigned.(*txs.TransformSupernetTx)
+			if !ok {
+				return nil, fmt.Errorf(
+					"unexpected supernet transformation tx type %T",
+					transformSupernetIntf.Unsigned,
+				)
+			}
+			assetID = transformSupernet.AssetID
+			rewardAssets[staker.SupernetID] = assetID
+		}
+
+		newPendingRewards, err := safemath.Add64(pendingRewards[assetID], staker.PotentialReward)
+		if err != nil {
+			return nil, fmt.Errorf("pending rewards of asset %s: %w", assetID, err)
+		}
+		pendingRewards[assetID] = newPendingRewards
+	}
+	return pendingRewards, nil
+}
+
+// GetFeesPaidArgs are the arguments for calling GetFeesPaid
+type GetFeesPaidArgs struct {
+	api.JSONAddresses
+	// Height of the first block to include
+	FromHeight avajson.Uint64 `json:"fromHeight"`
+	// Height of the last block to include
+	ToHeight avajson.Uint64 `json:"toHeight"`
+}
+
+// GetFeesPaidReply is the response from calling GetFeesPaid
+type GetFeesPaidReply struct {
+	// Sum of the fees, in nJUNE, of the txs paid by the addresses
+	Fees avajson.Uint64 `json:"fees"`
+	// Number of txs paid by the addresses
+	NumTxs avajson.Uint64 `json:"numTxs"`
+}
+
+// GetFeesPaid returns the sum of the fees of the accepted txs whose inputs were
+// signed by any of [args.Addresses], in the blocks from [args.FromHeight] to
+// [args.ToHeight] inclusive.
+func (s *Service) GetFeesPaid(r *http.Request, args *GetFeesPaidArgs, reply *GetFeesPaidReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getFeesPaid"),
+		logging.UserStrings("addresses", args.Addresses),
+		zap.Uint64("fromHeight", uint64(args.FromHeight)),
+		zap.Uint64("toHeight", uint64(args.ToHeight)),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetFeesPaidAddrs {
+		return fmt.Errorf("%d addresses provided but this method can take at most %d", len(args.Addresses), maxGetFeesPaidAddrs)
+	}
+	if args.FromHeight > args.ToHeight {
+		return fmt.Errorf("%w: from height %d is above to height %d", errInvalidHeightRange, args.FromHeight, args.ToHeight)
+	}
+	if numBlocks := args.ToHeight - args.FromHeight; numBlocks >= maxGetFeesPaidHeightRange {
+		return fmt.Errorf("%w: %d blocks requested but this method can walk at most %d", errInvalidHeightRange, numBlocks+1, maxGetFeesPaidHeightRange)
+	}
+
+	addrs, err := avax.ParseServiceAddresses(s.addrManager, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	blks, err := s.getAcceptedBlocks(r.Context(), uint64(args.FromHeight), uint64(args.ToHeight))
+	if err != nil {
+		return err
+	}
+
+	// Recovering the signers of the txs is expensive, so it is done without
+	// holding the lock.
+	var (
+		fees   uint64
+		numTxs uint64
+	)
+	for _, blk := range blks {
+		for _, tx := range blk.Txs() {
+			paid, err := isPaidBy(tx, addrs)
+			if err != nil {
+				return fmt.Errorf("couldn't recover signers of tx %s: %w", tx.ID(), err)
+			}
+			if !paid {
+				continue
+			}
+
+			fees, err = safemath.Add64(fees, tx.Unsigned.ConsumedValue(s.vm.ctx.JUNEAssetID))
+			if err != nil {
+				return err
+			}
+			numTxs++
+		}
+	}
+
+	reply.Fees = avajson.Uint64(fees)
+	reply.NumTxs = avajson.Uint64(numTxs)
+	return nil
+}
+
+// getAcceptedBlocks returns the accepted blocks from [fromHeight] to
+// [toHeight] inclusive.
+func (s *Service) getAcceptedBlocks(ctx context.Context, fromHeight, toHeight uint64) ([]block.Block, error) {
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	lastAcceptedHeight, err := s.vm.GetCurrentHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get current height: %w", err)
+	}
+	if toHeight > lastAcceptedHeight {
+		return nil, fmt.Errorf("%w: to height %d is above last accepted height %d", errInvalidHeightRange, toHeight, lastAcceptedHeight)
+	}
+
+	blks := make([]block.Block, 0, toHeight-fromHeight+1)
+	for height := fromHeight; height <= toHeight; height++ {
+		blkID, err := s.vm.state.GetBlockIDAtHeight(height)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get block ID at height %d: %w", height, err)
+		}
+		blk, err := s.vm.state.GetStatelessBlock(blkID)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get block %s: %w", blkID, err)
+		}
+		blks = append(blks, blk)
+	}
+	return blks, nil
+}
+
+// isPaidBy returns true if any of the inputs consumed by [tx] was signed by one
+// of [addrs].
+func isPaidBy(tx *txs.Tx, addrs set.Set[ids.ShortID]) (bool, error) {
+	// The credentials of the consumed inputs come first, followed by the
+	// credentials authorizing supernet operations, if any.
+	numInputs := tx.Unsigned.InputIDs().Len()
+	if numInputs > len(tx.Creds) {
+		return false, nil
+	}
+
+	txHash := hashing.ComputeHash256(tx.Unsigned.Bytes())
+	for _, credIntf := range tx.Creds[:numInputs] {
+		cred, ok := credIntf.(*secp256k1fx.Credential)
+		if !ok {
+			continue
+		}
+		for _, sig := range cred.Sigs {
+			pk, err := secp256k1.RecoverPublicKeyFromHash(txHash, sig[:])
+			if err != nil {
+				return false, err
+			}
+			if addrs.Contains(pk.Address()) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 // GetMinStakeArgs are the arguments for calling GetMinStake.
 type GetMinStakeArgs struct {
 	SupernetID ids.ID `json:"supernetID"`
@@ -1559,6 +3840,16 @@ type GetMinStakeReply struct {
 	MinValidatorStake avajson.Uint64 `json:"minValidatorStake"`
 	// Minimum stake, in nAVAX, that can be delegated on the primary network
 	MinDelegatorStake avajson.Uint64 `json:"minDelegatorStake"`
+	// The maximum amount of tokens a validator can bond, including the stake
+	// delegated to it
+	MaxValidatorStake avajson.Uint64 `json:"maxValidatorStake"`
+	// The minimum and maximum durations, in seconds, of a staking period
+	MinStakeDuration avajson.Uint64 `json:"minStakeDuration"`
+	MaxStakeDuration avajson.Uint64 `json:"maxStakeDuration"`
+	// The minimum and maximum delegation fees, out of 1,000,000, a validator
+	// can charge
+	MinDelegationFee avajson.Uint32 `json:"minDelegationFee"`
+	MaxDelegationFee avajson.Uint32 `json:"maxDelegationFee"`
 }
 
 // GetMinStake returns the minimum staking amount in nAVAX.
@@ -1571,6 +3862,11 @@ func (s *Service) GetMinStake(_ *http.Request, args *GetMinStakeArgs, reply *Get
 	if args.SupernetID == constants.PrimaryNetworkID {
 		reply.MinValidatorStake = avajson.Uint64(s.vm.MinValidatorStake)
 		reply.MinDelegatorStake = avajson.Uint64(s.vm.MinDelegatorStake)
+		reply.MaxValidatorStake = avajson.Uint64(s.vm.MaxValidatorStake)
+		reply.MinStakeDuration = avajson.Uint64(s.vm.MinStakeDuration / time.Second)
+		reply.MaxStakeDuration = avajson.Uint64(s.vm.MaxStakeDuration / time.Second)
+		reply.MinDelegationFee = avajson.Uint32(s.vm.MinDelegationFee)
+		reply.MaxDelegationFee = avajson.Uint32(s.vm.MaxDelegationFee)
 		return nil
 	}
 
@@ -1595,7 +3891,126 @@ func (s *Service) GetMinStake(_ *http.Request, args *GetMinStakeArgs, reply *Get
 
 	reply.MinValidatorStake = avajson.Uint64(transformSupernet.MinValidatorStake)
 	reply.MinDelegatorStake = avajson.Uint64(transformSupernet.MinDelegatorStake)
+	reply.MaxValidatorStake = avajson.Uint64(transformSupernet.MaxValidatorStake)
+	reply.MinStakeDuration = avajson.Uint64(transformSupernet.MinStakeDuration)
+	reply.MaxStakeDuration = avajson.Uint64(transformSupernet.MaxStakeDuration)
+	reply.MinDelegationFee = avajson.Uint32(transformSupernet.MinDelegationFee)
+	reply.MaxDelegationFee = avajson.Uint32(transformSupernet.MaxDelegationFee)
+
+	return nil
+}
+
+// GetValidatorDelegationTermsArgs are the arguments for calling
+// GetValidatorDelegationTerms
+type GetValidatorDelegationTermsArgs struct {
+	SupernetID ids.ID     `json:"supernetID"`
+	NodeID     ids.NodeID `json:"nodeID"`
+}
+
+// GetValidatorDelegationTermsReply is the response from calling
+// GetValidatorDelegationTerms
+type GetValidatorDelegationTermsReply struct {
+	// Percentage of the delegators' rewards kept by the validator
+	DelegationFee avajson.Float32 `json:"delegationFee"`
+	// Maximum total weight, including its own, the validator may reach
+	MaxWeight avajson.Uint64 `json:"maxWeight"`
+	// Weight that can still be delegated to the validator from now until the
+	// end of its validation period
+	AvailableWeight avajson.Uint64 `json:"availableWeight"`
+	// True if a delegation of the minimum delegator stake currently fits in
+	// [AvailableWeight]
+	HasCapacity bool `json:"hasCapacity"`
+}
+
+// GetValidatorDelegationTerms returns the delegation fee of a current or
+// pending permissionless validator along with how much weight can still be
+// delegated to it.
+func (s *Service) GetValidatorDelegationTerms(_ *http.Request, args *GetValidatorDelegationTermsArgs, reply *GetValidatorDelegationTermsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getValidatorDelegationTerms"),
+		zap.Stringer("supernetID", args.SupernetID),
+		zap.Stringer("nodeID", args.NodeID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	validator, err := txexecutor.GetValidator(s.vm.state, args.SupernetID, args.NodeID)
+	if err != nil {
+		return fmt.Errorf(
+			"failed fetching validator %s on %s: %w",
+			args.NodeID,
+			args.SupernetID,
+			err,
+		)
+	}
+
+	switch validator.Priority {
+	case txs.PrimaryNetworkValidatorCurrentPriority,
+		txs.PrimaryNetworkValidatorPendingPriority,
+		txs.SupernetPermissionlessValidatorCurrentPriority,
+		txs.SupernetPermissionlessValidatorPendingPriority:
+	default:
+		return fmt.Errorf("%w: %s", errNoDelegation, args.NodeID)
+	}
+
+	attr, err := s.loadStakerTxAttributes(validator.TxID)
+	if err != nil {
+		return err
+	}
+
+	var (
+		minDelegatorStake        uint64
+		maxValidatorStake        uint64
+		maxValidatorWeightFactor uint64
+	)
+	if args.SupernetID == constants.PrimaryNetworkID {
+		minDelegatorStake = s.vm.MinDelegatorStake
+		maxValidatorStake = s.vm.MaxValidatorStake
+		maxValidatorWeightFactor = txexecutor.MaxValidatorWeightFactor
+	} else {
+		transformSupernet, err := txexecutor.GetTransformSupernetTx(s.vm.state, args.SupernetID)
+		if err != nil {
+			return fmt.Errorf(
+				"failed fetching supernet transformation for %s: %w",
+				args.SupernetID,
+				err,
+			)
+		}
+		minDelegatorStake = transformSupernet.MinDelegatorStake
+		maxValidatorStake = transformSupernet.MaxValidatorStake
+		maxValidatorWeightFactor = uint64(transformSupernet.MaxValidatorWeightFactor)
+	}
+
+	maxWeight, err := safemath.Mul64(maxValidatorWeightFactor, validator.Weight)
+	if err != nil {
+		maxWeight = math.MaxUint64
+	}
+	maxWeight = min(maxWeight, maxValidatorStake)
+
+	reply.DelegationFee = avajson.Float32(100 * float32(attr.shares) / float32(reward.PercentDenominator))
+	reply.MaxWeight = avajson.Uint64(maxWeight)
+
+	// A delegation can't start before the current chain time, nor before the
+	// validator starts validating.
+	startTime := s.vm.state.GetTimestamp()
+	if startTime.Before(validator.StartTime) {
+		startTime = validator.StartTime
+	}
+	if !startTime.Before(validator.EndTime) {
+		return nil
+	}
 
+	currentWeight, err := txexecutor.GetMaxWeight(s.vm.state, validator, startTime, validator.EndTime)
+	if err != nil {
+		return fmt.Errorf("failed computing weight of validator %s: %w", args.NodeID, err)
+	}
+	if currentWeight < maxWeight {
+		availableWeight := maxWeight - currentWeight
+		reply.AvailableWeight = avajson.Uint64(availableWeight)
+		reply.HasCapacity = availableWeight >= minDelegatorStake
+	}
 	return nil
 }
 
@@ -1695,6 +4110,53 @@ func (s *Service) GetTimestamp(_ *http.Request, _ *struct{}, reply *GetTimestamp
 	return nil
 }
 
+// GetNextRewardTimeReply is the response from GetNextRewardTime
+type GetNextRewardTimeReply struct {
+	// Time at which the next staker will be rewarded
+	NextRewardTime time.Time `json:"nextRewardTime"`
+	// ID of the tx that added the next staker to be rewarded
+	TxID ids.ID `json:"txID"`
+	// NodeID of the next staker to be rewarded
+	NodeID ids.NodeID `json:"nodeID"`
+	// Supernet of the next staker to be rewarded
+	SupernetID ids.ID `json:"supernetID"`
+}
+
+// GetNextRewardTime returns the end time of the current staker that will be
+// rewarded first, which is when the next reward block will be built.
+func (s *Service) GetNextRewardTime(_ *http.Request, _ *struct{}, reply *GetNextRewardTimeReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getNextRewardTime"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	// Current stakers are ordered by their end time.
+	currentStakerIterator, err := s.vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return err
+	}
+	defer currentStakerIterator.Release()
+
+	for currentStakerIterator.Next() {
+		staker := currentStakerIterator.Value()
+		// Permissioned supernet validators are removed with an AdvanceTimeTx
+		// rather than rewarded.
+		if staker.Priority == txs.SupernetPermissionedValidatorCurrentPriority {
+			continue
+		}
+
+		reply.NextRewardTime = staker.EndTime
+		reply.TxID = staker.TxID
+		reply.NodeID = staker.NodeID
+		reply.SupernetID = staker.SupernetID
+		return nil
+	}
+	return errNoCurrentStakers
+}
+
 // GetValidatorsAtArgs is the response from GetValidatorsAt
 type GetValidatorsAtArgs struct {
 	Height   avajson.Uint64 `json:"height"`
@@ -1788,6 +4250,39 @@ func (s *Service) GetValidatorsAt(r *http.Request, args *GetValidatorsAtArgs, re
 	return nil
 }
 
+// GetValidatorSetHashArgs are the arguments for calling GetValidatorSetHash
+type GetValidatorSetHashArgs struct {
+	Height     avajson.Uint64 `json:"height"`
+	SupernetID ids.ID         `json:"supernetID"`
+}
+
+// GetValidatorSetHashReply is the response from calling GetValidatorSetHash
+type GetValidatorSetHashReply struct {
+	Hash ids.ID `json:"hash"`
+}
+
+// GetValidatorSetHash returns a deterministic hash of the validator set of a
+// provided supernet at the specified height.
+func (s *Service) GetValidatorSetHash(r *http.Request, args *GetValidatorSetHashArgs, reply *GetValidatorSetHashReply) error {
+	height := uint64(args.Height)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getValidatorSetHash"),
+		zap.Uint64("height", height),
+		zap.Stringer("supernetID", args.SupernetID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	vdrs, err := s.vm.GetValidatorSet(r.Context(), height, args.SupernetID)
+	if err != nil {
+		return fmt.Errorf("failed to get validator set: %w", err)
+	}
+	reply.Hash = validators.HashValidatorSet(vdrs)
+	return nil
+}
+
 func (s *Service) GetBlock(_ *http.Request, args *api.GetBlockArgs, response *api.GetBlockResponse) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -1833,6 +4328,16 @@ func (s *Service) GetBlockByHeight(_ *http.Request, args *api.GetBlockByHeightAr
 	defer s.vm.ctx.Lock.Unlock()
 
 	blockID, err := s.vm.state.GetBlockIDAtHeight(uint64(args.Height))
+	if err == database.ErrNotFound {
+		lastAcceptedID := s.vm.manager.LastAccepted()
+		lastAccepted, err := s.vm.manager.GetStatelessBlock(lastAcceptedID)
+		if err != nil {
+			return fmt.Errorf("couldn't get last accepted block %s: %w", lastAcceptedID, err)
+		}
+		if lastAcceptedHeight := lastAccepted.Height(); uint64(args.Height) > lastAcceptedHeight {
+			return fmt.Errorf("%w: %d > %d", errHeightAboveLastAccepted, args.Height, lastAcceptedHeight)
+		}
+	}
 	if err != nil {
 		return fmt.Errorf("couldn't get block at height %d: %w", args.Height, err)
 	}
@@ -5,11 +5,15 @@ package platformvm
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"math/rand"
+	"net/http"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,21 +30,31 @@ import (
 	"github.com/Juneo-io/juneogo/ids"
 	"github.com/Juneo-io/juneogo/snow"
 	"github.com/Juneo-io/juneogo/snow/consensus/snowman"
+	"github.com/Juneo-io/juneogo/snow/uptime"
 	"github.com/Juneo-io/juneogo/snow/validators"
+	"github.com/Juneo-io/juneogo/utils"
 	"github.com/Juneo-io/juneogo/utils/constants"
 	"github.com/Juneo-io/juneogo/utils/crypto/bls"
 	"github.com/Juneo-io/juneogo/utils/crypto/secp256k1"
 	"github.com/Juneo-io/juneogo/utils/formatting"
 	"github.com/Juneo-io/juneogo/utils/logging"
+	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/utils/units"
+	"github.com/Juneo-io/juneogo/utils/wrappers"
 	"github.com/Juneo-io/juneogo/vms/components/avax"
+	"github.com/Juneo-io/juneogo/vms/nftfx"
 	"github.com/Juneo-io/juneogo/vms/platformvm/block"
 	"github.com/Juneo-io/juneogo/vms/platformvm/block/builder"
+	"github.com/Juneo-io/juneogo/vms/platformvm/reward"
 	"github.com/Juneo-io/juneogo/vms/platformvm/signer"
+	"github.com/Juneo-io/juneogo/vms/platformvm/stakeable"
 	"github.com/Juneo-io/juneogo/vms/platformvm/state"
 	"github.com/Juneo-io/juneogo/vms/platformvm/status"
 	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
 	"github.com/Juneo-io/juneogo/vms/platformvm/txs/txstest"
+	"github.com/Juneo-io/juneogo/vms/propertyfx"
 	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
+	"github.com/Juneo-io/juneogo/vms/types"
 	"github.com/Juneo-io/juneogo/wallet/supernet/primary/common"
 
 	avajson "github.com/Juneo-io/juneogo/utils/json"
@@ -76,14 +90,25 @@ var (
 
 func defaultService(t *testing.T) (*Service, *mutableSharedMemory, *txstest.Builder) {
 	vm, txBuilder, _, mutableSharedMemory := defaultVM(t, latestFork)
+	return newTestService(vm), mutableSharedMemory, txBuilder
+}
+
+// defaultFastService is like [defaultService] but doesn't create
+// [testSupernet1].
+func defaultFastService(t *testing.T) (*Service, *mutableSharedMemory, *txstest.Builder) {
+	vm, txBuilder, _, mutableSharedMemory := defaultFastVM(t, latestFork)
+	return newTestService(vm), mutableSharedMemory, txBuilder
+}
 
+func newTestService(vm *VM) *Service {
 	return &Service{
 		vm:          vm,
 		addrManager: avax.NewAddressManager(vm.ctx),
 		stakerAttributesCache: &cache.LRU[ids.ID, *stakerAttributes]{
 			Size: stakerAttributesCacheSize,
 		},
-	}, mutableSharedMemory, txBuilder
+		balanceCache: newBalanceCache(vm.state),
+	}
 }
 
 func TestExportKey(t *testing.T) {
@@ -206,6 +231,160 @@ func TestGetTxStatus(t *testing.T) {
 	require.Zero(resp.Reason)
 }
 
+func TestGetAccountState(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	// Genesis validators are both funded and staking
+	genesis, _ := defaultGenesis(t, service.vm.ctx.JUNEAssetID)
+	validator := genesis.Validators[0]
+	addr := "P-" + validator.RewardOwner.Addresses[0]
+	addrs := api.JSONAddresses{
+		Addresses: []string{addr},
+	}
+
+	var reply GetAccountStateReply
+	require.NoError(service.GetAccountState(nil, &GetAccountStateArgs{
+		JSONAddresses: addrs,
+	}, &reply))
+
+	var balance GetBalanceResponse
+	require.NoError(service.GetBalance(nil, &GetBalanceRequest{
+		Addresses: addrs.Addresses,
+	}, &balance))
+	require.Equal(balance, reply.Balance)
+	require.NotZero(reply.Balance.Balance)
+	require.Equal(avajson.Uint64(len(balance.UTXOIDs)), reply.NumUTXOs)
+
+	var stake GetStakeReply
+	require.NoError(service.GetStake(nil, &GetStakeArgs{
+		JSONAddresses: addrs,
+		Encoding:      formatting.Hex,
+	}, &stake))
+	require.Equal(stake, reply.Stake)
+	require.Equal(defaultWeight, uint64(reply.Stake.Staked))
+
+	service.vm.ctx.Lock.Lock()
+	staker, err := service.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, validator.NodeID)
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+	require.Equal(map[ids.ID]avajson.Uint64{
+		service.vm.ctx.JUNEAssetID: avajson.Uint64(staker.PotentialReward),
+	}, reply.PendingRewards)
+
+	args := &GetAccountStateArgs{
+		JSONAddresses: api.JSONAddresses{
+			Addresses: make([]string, maxGetStakeAddrs+1),
+		},
+	}
+	require.ErrorContains(service.GetAccountState(nil, args, &GetAccountStateReply{}), "can take at most")
+}
+
+func TestGetTxStatuses(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	var (
+		committedTxID = testSupernet1.ID()
+		unknownTxID   = ids.GenerateTestID()
+		reply         GetTxStatusesReply
+	)
+	require.NoError(service.GetTxStatuses(nil, &GetTxStatusesArgs{
+		TxIDs: []ids.ID{committedTxID, unknownTxID},
+	}, &reply))
+	require.Equal(map[ids.ID]GetTxStatusResponse{
+		committedTxID: {Status: status.Committed},
+		unknownTxID:   {Status: status.Unknown},
+	}, reply.Statuses)
+
+	err := service.GetTxStatuses(nil, &GetTxStatusesArgs{
+		TxIDs: make([]ids.ID, maxGetTxStatusesTxIDs+1),
+	}, &GetTxStatusesReply{})
+	require.ErrorIs(err, errTooManyTxIDs)
+}
+
+func TestGetRecognizedAssets(t *testing.T) {
+	require := require.New(t)
+	service, mutableSharedMemory, txBuilder := defaultService(t)
+
+	var response GetRecognizedAssetsResponse
+	require.NoError(service.GetRecognizedAssets(nil, nil, &response))
+	require.Equal([]ids.ID{service.vm.ctx.JUNEAssetID}, response.AssetIDs)
+
+	service.vm.ctx.Lock.Lock()
+
+	m := atomic.NewMemory(prefixdb.New([]byte{}, service.vm.db))
+
+	sm := m.NewSharedMemory(service.vm.ctx.ChainID)
+	peerSharedMemory := m.NewSharedMemory(service.vm.ctx.JVMChainID)
+
+	assetID := ids.GenerateTestID()
+	// #nosec G404
+	utxo := &avax.UTXO{
+		UTXOID: avax.UTXOID{
+			TxID:        ids.GenerateTestID(),
+			OutputIndex: rand.Uint32(),
+		},
+		Asset: avax.Asset{ID: assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1234567,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Locktime:  0,
+				Addrs:     []ids.ShortID{keys[0].PublicKey().Address()},
+				Threshold: 1,
+			},
+		},
+	}
+	utxoBytes, err := txs.Codec.Marshal(txs.CodecVersion, utxo)
+	require.NoError(err)
+
+	inputID := utxo.InputID()
+	require.NoError(peerSharedMemory.Apply(map[ids.ID]*atomic.Requests{
+		service.vm.ctx.ChainID: {
+			PutRequests: []*atomic.Element{
+				{
+					Key:   inputID[:],
+					Value: utxoBytes,
+					Traits: [][]byte{
+						keys[0].PublicKey().Address().Bytes(),
+					},
+				},
+			},
+		},
+	}))
+
+	mutableSharedMemory.SharedMemory = sm
+
+	tx, err := txBuilder.NewImportTx(
+		service.vm.ctx.JVMChainID,
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.ShortEmpty},
+		},
+		[]*secp256k1.PrivateKey{keys[0]},
+	)
+	require.NoError(err)
+
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+	service.vm.ctx.Lock.Lock()
+
+	block, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+
+	blk := block.(*blockexecutor.Block)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+
+	service.vm.ctx.Lock.Unlock()
+
+	expectedAssetIDs := []ids.ID{service.vm.ctx.JUNEAssetID, assetID}
+	response = GetRecognizedAssetsResponse{} // reset
+	require.NoError(service.GetRecognizedAssets(nil, nil, &response))
+	require.ElementsMatch(expectedAssetIDs, response.AssetIDs)
+}
+
 // Test issuing and then retrieving a transaction
 func TestGetTx(t *testing.T) {
 	type test struct {
@@ -362,6 +541,86 @@ func TestGetTx(t *testing.T) {
 	}
 }
 
+func TestGetTxJSON(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	var (
+		ownerAddr = ids.GenerateTestShortID()
+		utx       = &txs.CreateSupernetTx{
+			Owner: &secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{ownerAddr},
+			},
+		}
+	)
+	tx, err := txs.NewSigned(utx, txs.Codec, nil)
+	require.NoError(err)
+
+	reply := GetTxJSONReply{}
+	err = service.GetTxJSON(nil, &GetTxJSONArgs{TxID: tx.ID()}, &reply)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	service.vm.ctx.Lock.Lock()
+	service.vm.state.AddTx(tx, status.Committed)
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.GetTxJSON(nil, &GetTxJSONArgs{TxID: tx.ID()}, &reply))
+	require.Equal(tx.ID(), reply.TxID)
+	require.Equal("CreateSupernetTx", reply.Type)
+
+	var unsignedTx struct {
+		Owner struct {
+			Addresses []string `json:"addresses"`
+			Threshold uint32   `json:"threshold"`
+		} `json:"owner"`
+	}
+	require.NoError(json.Unmarshal(reply.UnsignedTx, &unsignedTx))
+
+	ownerAddrStr, err := service.addrManager.FormatLocalAddress(ownerAddr)
+	require.NoError(err)
+	require.Equal([]string{ownerAddrStr}, unsignedTx.Owner.Addresses)
+	require.Equal(uint32(1), unsignedTx.Owner.Threshold)
+}
+
+func TestDecodeTx(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	utx := &txs.CreateSupernetTx{
+		Owner: &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		},
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, nil)
+	require.NoError(err)
+
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+
+	reply := GetTxJSONReply{}
+	require.NoError(service.DecodeTx(nil, &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, &reply))
+	require.Equal(tx.ID(), reply.TxID)
+	require.Equal("CreateSupernetTx", reply.Type)
+
+	// Decoding a tx must not add it to the mempool
+	_, ok := service.vm.Builder.Get(tx.ID())
+	require.False(ok)
+
+	// Malformed txs are reported rather than decoded
+	txStr, err = formatting.Encode(formatting.Hex, tx.Bytes()[:len(tx.Bytes())/2])
+	require.NoError(err)
+	err = service.DecodeTx(nil, &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, &reply)
+	require.ErrorIs(err, wrappers.ErrInsufficientLength)
+}
+
 func TestGetBalance(t *testing.T) {
 	require := require.New(t)
 	service, _, _ := defaultService(t)
@@ -390,6 +649,138 @@ func TestGetBalance(t *testing.T) {
 	}
 }
 
+func TestGetBalanceIncludeMempool(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	var (
+		sender        = keys[1]
+		senderAddr    = sender.PublicKey().Address()
+		recipientAddr = ids.GenerateTestShortID()
+		amount        = units.MilliAvax
+	)
+	senderAddrStr, err := service.addrManager.FormatLocalAddress(senderAddr)
+	require.NoError(err)
+	recipientAddrStr, err := service.addrManager.FormatLocalAddress(recipientAddr)
+	require.NoError(err)
+
+	service.vm.ctx.Lock.Lock()
+	tx, err := txBuilder.NewBaseTx(
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: service.vm.ctx.JUNEAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: amount,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{recipientAddr},
+				},
+			},
+		}},
+		[]*secp256k1.PrivateKey{sender},
+		common.WithChangeOwner(&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{senderAddr},
+		}),
+	)
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+
+	// Without the mempool, only the accepted balance is reported.
+	reply := GetBalanceResponse{}
+	require.NoError(service.GetBalance(nil, &GetBalanceRequest{
+		Addresses: []string{senderAddrStr},
+	}, &reply))
+	require.Equal(avajson.Uint64(defaultBalance), reply.Balance)
+	require.Nil(reply.PendingIncomings)
+	require.Nil(reply.PendingOutgoings)
+
+	// The sender spends its genesis UTXO and receives the change.
+	reply = GetBalanceResponse{}
+	require.NoError(service.GetBalance(nil, &GetBalanceRequest{
+		Addresses:      []string{senderAddrStr},
+		IncludeMempool: true,
+	}, &reply))
+	require.Equal(avajson.Uint64(defaultBalance), reply.Balance)
+	require.Equal(avajson.Uint64(defaultBalance), reply.PendingOutgoing)
+	require.Equal(avajson.Uint64(defaultBalance-amount-defaultTxFee), reply.PendingIncoming)
+
+	// The recipient doesn't have any accepted balance yet.
+	reply = GetBalanceResponse{}
+	require.NoError(service.GetBalance(nil, &GetBalanceRequest{
+		Addresses:      []string{recipientAddrStr},
+		IncludeMempool: true,
+	}, &reply))
+	require.Zero(reply.Balance)
+	require.Equal(avajson.Uint64(amount), reply.PendingIncoming)
+	require.Zero(reply.PendingOutgoing)
+}
+
+func TestGetLockedUTXOs(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	var (
+		owner     = keys[4]
+		ownerAddr = owner.PublicKey().Address()
+		locktime  = uint64(service.vm.clock.Time().Add(time.Hour).Unix())
+		amount    = units.MilliAvax
+	)
+	ownerAddrStr, err := service.addrManager.FormatLocalAddress(ownerAddr)
+	require.NoError(err)
+
+	service.vm.ctx.Lock.Lock()
+	tx, err := txBuilder.NewBaseTx(
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: service.vm.ctx.JUNEAssetID},
+			Out: &stakeable.LockOut{
+				Locktime: locktime,
+				TransferableOut: &secp256k1fx.TransferOutput{
+					Amt: amount,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{ownerAddr},
+					},
+				},
+			},
+		}},
+		[]*secp256k1.PrivateKey{keys[0]},
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+	service.vm.ctx.Lock.Lock()
+
+	blk, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+
+	service.vm.ctx.Lock.Unlock()
+
+	args := GetLockedUTXOsArgs{
+		JSONAddresses: api.JSONAddresses{
+			Addresses: []string{ownerAddrStr},
+		},
+	}
+	var reply GetLockedUTXOsReply
+	require.NoError(service.GetLockedUTXOs(nil, &args, &reply))
+	require.Len(reply.UTXOs, 1)
+	lockedUTXO := reply.UTXOs[0]
+	require.Equal(tx.ID(), lockedUTXO.TxID)
+	require.Equal(service.vm.ctx.JUNEAssetID, lockedUTXO.AssetID)
+	require.Equal(avajson.Uint64(amount), lockedUTXO.Amount)
+	require.Equal(avajson.Uint64(locktime), lockedUTXO.Locktime)
+
+	// Once the locktime has passed, the UTXO is no longer reported
+	service.vm.clock.Set(time.Unix(int64(locktime), 0))
+	reply = GetLockedUTXOsReply{}
+	require.NoError(service.GetLockedUTXOs(nil, &args, &reply))
+	require.Empty(reply.UTXOs)
+}
+
 func TestGetStake(t *testing.T) {
 	require := require.New(t)
 	service, _, txBuilder := defaultService(t)
@@ -576,6 +967,137 @@ func TestGetStake(t *testing.T) {
 	require.Equal(stakeAmount+oldStake, outputs[0].Out.Amount()+outputs[1].Out.Amount()+outputs[2].Out.Amount())
 }
 
+func TestGetFeesPaid(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	var (
+		payer     = keys[4]
+		payerAddr = payer.PublicKey().Address()
+	)
+	payerAddrStr, err := service.addrManager.FormatLocalAddress(payerAddr)
+	require.NoError(err)
+
+	service.vm.ctx.Lock.Lock()
+	fromHeight, err := service.vm.GetCurrentHeight(context.Background())
+	require.NoError(err)
+	fromHeight++
+	service.vm.ctx.Lock.Unlock()
+
+	// Issue two fee-bearing txs paid by [payer]
+	for i := 0; i < 2; i++ {
+		service.vm.ctx.Lock.Lock()
+		tx, err := txBuilder.NewCreateSupernetTx(
+			&secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{payerAddr},
+			},
+			[]*secp256k1.PrivateKey{payer},
+		)
+		require.NoError(err)
+		service.vm.ctx.Lock.Unlock()
+
+		require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+		service.vm.ctx.Lock.Lock()
+
+		blk, err := service.vm.BuildBlock(context.Background())
+		require.NoError(err)
+		require.NoError(blk.Verify(context.Background()))
+		require.NoError(blk.Accept(context.Background()))
+		require.NoError(service.vm.SetPreference(context.Background(), service.vm.manager.LastAccepted()))
+
+		service.vm.ctx.Lock.Unlock()
+	}
+
+	service.vm.ctx.Lock.Lock()
+	toHeight, err := service.vm.GetCurrentHeight(context.Background())
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	args := GetFeesPaidArgs{
+		JSONAddresses: api.JSONAddresses{
+			Addresses: []string{payerAddrStr},
+		},
+		FromHeight: avajson.Uint64(fromHeight),
+		ToHeight:   avajson.Uint64(toHeight),
+	}
+	var reply GetFeesPaidReply
+	require.NoError(service.GetFeesPaid(&http.Request{}, &args, &reply))
+	require.Equal(GetFeesPaidReply{
+		Fees:   avajson.Uint64(2 * service.vm.CreateSupernetTxFee),
+		NumTxs: 2,
+	}, reply)
+
+	// The payer of [testSupernet1] didn't pay any tx in the range
+	keyAddrStr, err := service.addrManager.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+	args.Addresses = []string{keyAddrStr}
+	reply = GetFeesPaidReply{}
+	require.NoError(service.GetFeesPaid(&http.Request{}, &args, &reply))
+	require.Zero(reply.Fees)
+	require.Zero(reply.NumTxs)
+
+	// Heights above the last accepted height are rejected
+	args.ToHeight++
+	err = service.GetFeesPaid(&http.Request{}, &args, &reply)
+	require.ErrorIs(err, errInvalidHeightRange)
+}
+
+func TestGetTxConfirmations(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	newTx := func() *txs.Tx {
+		service.vm.ctx.Lock.Lock()
+		defer service.vm.ctx.Lock.Unlock()
+
+		tx, err := txBuilder.NewCreateSupernetTx(
+			&secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{keys[0].PublicKey().Address()},
+			},
+			[]*secp256k1.PrivateKey{keys[0]},
+		)
+		require.NoError(err)
+		return tx
+	}
+
+	// Accept the tx and then three more blocks on top of it
+	var txID ids.ID
+	for i := 0; i < 4; i++ {
+		tx := newTx()
+		if i == 0 {
+			txID = tx.ID()
+		}
+
+		require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+		service.vm.ctx.Lock.Lock()
+
+		blk, err := service.vm.BuildBlock(context.Background())
+		require.NoError(err)
+		require.NoError(blk.Verify(context.Background()))
+		require.NoError(blk.Accept(context.Background()))
+		require.NoError(service.vm.SetPreference(context.Background(), service.vm.manager.LastAccepted()))
+
+		service.vm.ctx.Lock.Unlock()
+	}
+
+	var reply GetTxConfirmationsReply
+	require.NoError(service.GetTxConfirmations(&http.Request{}, &GetTxConfirmationsArgs{TxID: txID}, &reply))
+	require.Equal(avajson.Uint64(4), reply.Confirmations)
+
+	// A processing tx has no confirmations
+	processingTx := newTx()
+	require.NoError(service.vm.Network.IssueTxFromRPC(processingTx))
+	reply = GetTxConfirmationsReply{}
+	require.NoError(service.GetTxConfirmations(&http.Request{}, &GetTxConfirmationsArgs{TxID: processingTx.ID()}, &reply))
+	require.Zero(reply.Confirmations)
+
+	// An unknown tx is reported as not found
+	err := service.GetTxConfirmations(&http.Request{}, &GetTxConfirmationsArgs{TxID: ids.GenerateTestID()}, &reply)
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
 func TestGetCurrentValidators(t *testing.T) {
 	require := require.New(t)
 	service, _, txBuilder := defaultService(t)
@@ -709,18 +1231,1918 @@ func TestGetCurrentValidators(t *testing.T) {
 	}
 }
 
-func TestGetTimestamp(t *testing.T) {
+func TestGetCurrentValidatorsMetadata(t *testing.T) {
 	require := require.New(t)
-	service, _, _ := defaultService(t)
+	service, _, txBuilder := defaultService(t)
 
-	reply := GetTimestampReply{}
-	require.NoError(service.GetTimestamp(nil, nil, &reply))
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+
+	rewardsOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	nodeID := ids.GenerateTestNodeID()
+	startTime := defaultValidateStartTime
+	endTime := startTime.Add(defaultMinStakingDuration)
+	metadata := []byte("operator=juneo;region=eu-west")
 
 	service.vm.ctx.Lock.Lock()
 
-	require.Equal(service.vm.state.GetTimestamp(), reply.Timestamp)
+	vdrTx, err := txBuilder.NewAddPermissionlessValidatorTx(
+		&txs.SupernetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(endTime.Unix()),
+				Wght:   service.vm.MinValidatorStake,
+			},
+			Supernet: constants.PrimaryNetworkID,
+		},
+		signer.NewProofOfPossession(sk),
+		service.vm.ctx.JUNEAssetID,
+		rewardsOwner,
+		rewardsOwner,
+		reward.PercentDenominator,
+		[]*secp256k1.PrivateKey{keys[0]},
+		common.WithMemo(metadata),
+	)
+	if err != nil {
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(err)
+	}
 
-	newTimestamp := reply.Timestamp.Add(time.Second)
+	staker, err := state.NewCurrentStaker(
+		vdrTx.ID(),
+		vdrTx.Unsigned.(*txs.AddPermissionlessValidatorTx),
+		startTime,
+		0,
+	)
+	if err != nil {
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(err)
+	}
+
+	service.vm.state.PutCurrentValidator(staker)
+	service.vm.state.AddTx(vdrTx, status.Committed)
+	err = service.vm.state.Commit()
+
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+
+	response := GetCurrentValidatorsReply{}
+	require.NoError(service.GetCurrentValidators(nil, &GetCurrentValidatorsArgs{
+		SupernetID: constants.PrimaryNetworkID,
+		NodeIDs:    []ids.NodeID{nodeID},
+	}, &response))
+	require.Len(response.Validators, 1)
+
+	vdr := response.Validators[0].(pchainapi.PermissionlessValidator)
+	require.Equal(nodeID, vdr.NodeID)
+	require.Equal(types.JSONByteSlice(metadata), vdr.Metadata)
+
+	// Validators staked without a memo aren't tagged.
+	response = GetCurrentValidatorsReply{}
+	require.NoError(service.GetCurrentValidators(nil, &GetCurrentValidatorsArgs{
+		SupernetID: constants.PrimaryNetworkID,
+		NodeIDs:    []ids.NodeID{genesisNodeIDs[0]},
+	}, &response))
+	require.Len(response.Validators, 1)
+	require.Empty(response.Validators[0].(pchainapi.PermissionlessValidator).Metadata)
+}
+
+func TestGetCurrentValidatorsMaxValidatorsInResponse(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultFastService(t)
+
+	// Grow the primary network validator set well beyond the genesis one.
+	const numExtraValidators = 1000
+	service.vm.ctx.Lock.Lock()
+	for i := 0; i < numExtraValidators; i++ {
+		service.vm.state.PutCurrentValidator(&state.Staker{
+			TxID:       ids.GenerateTestID(),
+			NodeID:     ids.GenerateTestNodeID(),
+			SupernetID: constants.PrimaryNetworkID,
+			Weight:     defaultWeight,
+			StartTime:  defaultValidateStartTime,
+			EndTime:    defaultValidateEndTime,
+			NextTime:   defaultValidateEndTime,
+			Priority:   txs.PrimaryNetworkValidatorCurrentPriority,
+		})
+	}
+	service.vm.ctx.Lock.Unlock()
+
+	numValidators := len(genesisNodeIDs) + numExtraValidators
+	service.vm.MaxValidatorsInResponse = numValidators - 1
+
+	args := GetCurrentValidatorsArgs{SupernetID: constants.PrimaryNetworkID}
+	reply := GetCurrentValidatorsReply{}
+	err := service.GetCurrentValidators(nil, &args, &reply)
+	require.ErrorIs(err, errTooManyValidators)
+
+	// Filtering by node ID isn't subject to the cap.
+	args.NodeIDs = genesisNodeIDs
+	reply = GetCurrentValidatorsReply{}
+	require.NoError(service.GetCurrentValidators(nil, &args, &reply))
+	require.Len(reply.Validators, len(genesisNodeIDs))
+}
+
+func TestGetCurrentValidatorsRewardAddresses(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	var (
+		rewardAddr      = ids.GenerateTestShortID()
+		otherRewardAddr = ids.GenerateTestShortID()
+		stakeAmount     = service.vm.MinDelegatorStake
+		startTime       = defaultValidateStartTime
+		endTime         = startTime.Add(defaultMinStakingDuration)
+	)
+
+	service.vm.ctx.Lock.Lock()
+
+	// Delegate to the first validator twice, rewarding different addresses,
+	// and to the second validator rewarding the other address
+	delegations := []struct {
+		nodeID ids.NodeID
+		addr   ids.ShortID
+	}{
+		{nodeID: genesisNodeIDs[0], addr: rewardAddr},
+		{nodeID: genesisNodeIDs[0], addr: otherRewardAddr},
+		{nodeID: genesisNodeIDs[1], addr: otherRewardAddr},
+	}
+	for _, delegation := range delegations {
+		delTx, err := txBuilder.NewAddDelegatorTx(
+			&txs.Validator{
+				NodeID: delegation.nodeID,
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(endTime.Unix()),
+				Wght:   stakeAmount,
+			},
+			&secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{delegation.addr},
+			},
+			[]*secp256k1.PrivateKey{keys[0]},
+		)
+		require.NoError(err)
+
+		staker, err := state.NewCurrentStaker(
+			delTx.ID(),
+			delTx.Unsigned.(*txs.AddDelegatorTx),
+			startTime,
+			0,
+		)
+		require.NoError(err)
+
+		service.vm.state.PutCurrentDelegator(staker)
+		service.vm.state.AddTx(delTx, status.Committed)
+	}
+	require.NoError(service.vm.state.Commit())
+
+	service.vm.ctx.Lock.Unlock()
+
+	rewardAddrStr, err := service.addrManager.FormatLocalAddress(rewardAddr)
+	require.NoError(err)
+
+	args := GetCurrentValidatorsArgs{
+		SupernetID:      constants.PrimaryNetworkID,
+		RewardAddresses: []string{rewardAddrStr},
+	}
+	response := GetCurrentValidatorsReply{}
+	require.NoError(service.GetCurrentValidators(nil, &args, &response))
+	require.Len(response.Validators, 1)
+
+	vdr := response.Validators[0].(pchainapi.PermissionlessValidator)
+	require.Equal(genesisNodeIDs[0], vdr.NodeID)
+	require.NotNil(vdr.Delegators)
+	require.Len(*vdr.Delegators, 1)
+
+	// The totals account for the delegators that were filtered out
+	require.Equal(avajson.Uint64(2), *vdr.DelegatorCount)
+	require.Equal(avajson.Uint64(2*stakeAmount), *vdr.DelegatorWeight)
+
+	delegator := (*vdr.Delegators)[0]
+	require.Equal(stakeAmount, uint64(delegator.Weight))
+	require.NotNil(delegator.RewardOwner)
+	require.Equal([]string{rewardAddrStr}, delegator.RewardOwner.Addresses)
+
+	// An address that isn't rewarded by any delegator filters out every
+	// validator
+	unknownAddrStr, err := service.addrManager.FormatLocalAddress(ids.GenerateTestShortID())
+	require.NoError(err)
+
+	args.RewardAddresses = []string{unknownAddrStr}
+	response = GetCurrentValidatorsReply{}
+	require.NoError(service.GetCurrentValidators(nil, &args, &response))
+	require.Empty(response.Validators)
+
+	// The cap on the number of validators applies after filtering
+	service.vm.MaxValidatorsInResponse = 1
+
+	args.RewardAddresses = nil
+	response = GetCurrentValidatorsReply{}
+	err = service.GetCurrentValidators(nil, &args, &response)
+	require.ErrorIs(err, errTooManyValidators)
+
+	args.RewardAddresses = []string{rewardAddrStr}
+	response = GetCurrentValidatorsReply{}
+	require.NoError(service.GetCurrentValidators(nil, &args, &response))
+	require.Len(response.Validators, 1)
+}
+
+func TestGetPendingValidators(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	// The genesis validators are all current validators
+	args := GetPendingValidatorsArgs{SupernetID: constants.PrimaryNetworkID}
+	response := GetPendingValidatorsReply{}
+	require.NoError(service.GetPendingValidators(nil, &args, &response))
+	require.Empty(response.Validators)
+	require.Empty(response.Delegators)
+
+	// Add a pending validator
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+
+	validationRewardsOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	delegationRewardsOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	nodeID := ids.GenerateTestNodeID()
+	startTime := service.vm.clock.Time().Add(txexecutor.SyncBound)
+	endTime := startTime.Add(defaultMinStakingDuration)
+
+	service.vm.ctx.Lock.Lock()
+
+	vdrTx, err := txBuilder.NewAddPermissionlessValidatorTx(
+		&txs.SupernetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(endTime.Unix()),
+				Wght:   service.vm.MinValidatorStake,
+			},
+			Supernet: constants.PrimaryNetworkID,
+		},
+		signer.NewProofOfPossession(sk),
+		service.vm.ctx.JUNEAssetID,
+		validationRewardsOwner,
+		delegationRewardsOwner,
+		reward.PercentDenominator,
+		[]*secp256k1.PrivateKey{keys[0]},
+		common.WithChangeOwner(&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{keys[0].PublicKey().Address()},
+		}),
+	)
+	require.NoError(err)
+
+	staker, err := state.NewPendingStaker(
+		vdrTx.ID(),
+		vdrTx.Unsigned.(*txs.AddPermissionlessValidatorTx),
+	)
+	require.NoError(err)
+
+	service.vm.state.PutPendingValidator(staker)
+	service.vm.state.AddTx(vdrTx, status.Committed)
+	require.NoError(service.vm.state.Commit())
+
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.GetPendingValidators(nil, &args, &response))
+	require.Len(response.Validators, 1)
+	require.Empty(response.Delegators)
+
+	vdr := response.Validators[0].(pchainapi.PermissionlessValidator)
+	require.Equal(nodeID, vdr.NodeID)
+	require.Equal(avajson.Uint64(startTime.Unix()), vdr.StartTime)
+	require.Equal(avajson.Uint64(endTime.Unix()), vdr.EndTime)
+	require.Nil(vdr.PotentialReward)
+	require.NotNil(vdr.EstimatedPotentialReward)
+	require.NotZero(*vdr.EstimatedPotentialReward)
+
+	validationRewardAddr, err := service.addrManager.FormatLocalAddress(validationRewardsOwner.Addrs[0])
+	require.NoError(err)
+	expectedValidationOwner := &pchainapi.Owner{
+		Locktime:  0,
+		Threshold: 1,
+		Addresses: []string{validationRewardAddr},
+	}
+	delegationRewardAddr, err := service.addrManager.FormatLocalAddress(delegationRewardsOwner.Addrs[0])
+	require.NoError(err)
+	expectedDelegationOwner := &pchainapi.Owner{
+		Locktime:  0,
+		Threshold: 1,
+		Addresses: []string{delegationRewardAddr},
+	}
+	require.Equal(expectedValidationOwner, vdr.ValidationRewardOwner)
+	require.Equal(expectedDelegationOwner, vdr.DelegationRewardOwner)
+}
+
+func TestGetProjectedSupply(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+
+	rewardsOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	startTime := service.vm.clock.Time().Add(txexecutor.SyncBound)
+	endTime := startTime.Add(defaultMinStakingDuration)
+
+	service.vm.ctx.Lock.Lock()
+
+	vdrTx, err := txBuilder.NewAddPermissionlessValidatorTx(
+		&txs.SupernetValidator{
+			Validator: txs.Validator{
+				NodeID: ids.GenerateTestNodeID(),
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(endTime.Unix()),
+				Wght:   service.vm.MinValidatorStake,
+			},
+			Supernet: constants.PrimaryNetworkID,
+		},
+		signer.NewProofOfPossession(sk),
+		service.vm.ctx.JUNEAssetID,
+		rewardsOwner,
+		rewardsOwner,
+		reward.PercentDenominator,
+		[]*secp256k1.PrivateKey{keys[0]},
+	)
+	if err != nil {
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(err)
+	}
+
+	staker, err := state.NewPendingStaker(
+		vdrTx.ID(),
+		vdrTx.Unsigned.(*txs.AddPermissionlessValidatorTx),
+	)
+	if err != nil {
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(err)
+	}
+
+	service.vm.state.PutPendingValidator(staker)
+	service.vm.state.AddTx(vdrTx, status.Committed)
+	err = service.vm.state.Commit()
+
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+
+	currentReply := GetCurrentSupplyReply{}
+	require.NoError(service.GetCurrentSupply(&http.Request{}, &GetCurrentSupplyArgs{
+		SupernetID: constants.PrimaryNetworkID,
+	}, &currentReply))
+
+	pendingReply := GetPendingValidatorsReply{}
+	require.NoError(service.GetPendingValidators(nil, &GetPendingValidatorsArgs{
+		SupernetID: constants.PrimaryNetworkID,
+	}, &pendingReply))
+	require.Len(pendingReply.Validators, 1)
+	vdr := pendingReply.Validators[0].(pchainapi.PermissionlessValidator)
+	require.NotNil(vdr.EstimatedPotentialReward)
+	potentialReward := uint64(*vdr.EstimatedPotentialReward)
+	require.NotZero(potentialReward)
+
+	// Before the pending validator ends, its reward isn't distributed.
+	reply := GetProjectedSupplyReply{}
+	require.NoError(service.GetProjectedSupply(&http.Request{}, &GetProjectedSupplyArgs{
+		SupernetID: constants.PrimaryNetworkID,
+		Time:       avajson.Uint64(endTime.Add(-time.Second).Unix()),
+	}, &reply))
+	require.Equal(currentReply.Supply, reply.Supply)
+
+	// At its end time, the pending validator's reward is included.
+	require.NoError(service.GetProjectedSupply(&http.Request{}, &GetProjectedSupplyArgs{
+		SupernetID: constants.PrimaryNetworkID,
+		Time:       avajson.Uint64(endTime.Unix()),
+	}, &reply))
+	require.Greater(reply.Supply, currentReply.Supply)
+	require.Equal(uint64(currentReply.Supply)+potentialReward, uint64(reply.Supply))
+}
+
+// testUptimeManager reports exactly [connected] as connected.
+type testUptimeManager struct {
+	uptime.Manager
+	connected set.Set[ids.NodeID]
+}
+
+func (m *testUptimeManager) IsConnected(nodeID ids.NodeID, _ ids.ID) bool {
+	return m.connected.Contains(nodeID)
+}
+
+func TestGetConnectedValidators(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultFastService(t)
+
+	connected := genesisNodeIDs[:2]
+	service.vm.ctx.Lock.Lock()
+	service.vm.uptimeManager = &testUptimeManager{
+		Manager:   service.vm.uptimeManager,
+		connected: set.Of(connected...),
+	}
+	service.vm.ctx.Lock.Unlock()
+
+	var response GetConnectedValidatorsReply
+	require.NoError(service.GetConnectedValidators(nil, &GetConnectedValidatorsArgs{
+		SupernetID: constants.PrimaryNetworkID,
+	}, &response))
+
+	expected := slices.Clone(connected)
+	utils.Sort(expected)
+	require.Equal(expected, response.Validators)
+}
+
+func TestGetConnectedPeers(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultFastService(t)
+
+	connected := set.Of(genesisNodeIDs[:2]...)
+	service.vm.ctx.Lock.Lock()
+	service.vm.uptimeManager = &testUptimeManager{
+		Manager:   service.vm.uptimeManager,
+		connected: connected,
+	}
+	service.vm.ctx.Lock.Unlock()
+
+	var response GetConnectedPeersReply
+	require.NoError(service.GetConnectedPeers(nil, &GetConnectedPeersArgs{
+		SupernetID: constants.PrimaryNetworkID,
+	}, &response))
+	require.Len(response.Peers, len(genesisNodeIDs))
+
+	nodeIDs := make([]ids.NodeID, len(response.Peers))
+	for i, peer := range response.Peers {
+		nodeIDs[i] = peer.NodeID
+		require.Equal(connected.Contains(peer.NodeID), peer.Connected)
+		require.NotNil(peer.Uptime)
+	}
+	require.True(utils.IsSortedAndUnique(nodeIDs))
+	require.ElementsMatch(genesisNodeIDs, nodeIDs)
+}
+
+func TestGetChainConfig(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	var (
+		vmID  = ids.ID{'t', 'e', 's', 't', 'v', 'm'}
+		fxIDs = []ids.ID{secp256k1fx.ID, nftfx.ID, propertyfx.ID}
+	)
+
+	service.vm.ctx.Lock.Lock()
+
+	tx, err := txBuilder.NewCreateChainTx(
+		testSupernet1.ID(),
+		nil,
+		vmID,
+		fxIDs,
+		"chain name",
+		ids.Empty,
+		[]*secp256k1.PrivateKey{testSupernet1ControlKeys[0], testSupernet1ControlKeys[1]},
+	)
+	require.NoError(err)
+
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+	service.vm.ctx.Lock.Lock()
+
+	blk, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+
+	service.vm.ctx.Lock.Unlock()
+
+	var response GetChainConfigResponse
+	require.NoError(service.GetChainConfig(nil, &GetChainConfigArgs{
+		ChainID: tx.ID(),
+	}, &response))
+
+	utils.Sort(fxIDs)
+	require.Equal(GetChainConfigResponse{
+		Name:       "chain name",
+		SupernetID: testSupernet1.ID(),
+		VMID:       vmID,
+		FxIDs:      fxIDs,
+	}, response)
+
+	// A supernet isn't a blockchain
+	err = service.GetChainConfig(nil, &GetChainConfigArgs{
+		ChainID: testSupernet1.ID(),
+	}, &response)
+	require.ErrorIs(err, errNotABlockchain)
+}
+
+func TestGetChainsCreatedBy(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	var (
+		creator     = testSupernet1ControlKeys[0]
+		creatorAddr = creator.Address()
+		// Only authorizes the creation of the chains on the supernet
+		controlKey = testSupernet1ControlKeys[1]
+		vmIDs      = []ids.ID{
+			{'t', 'e', 's', 't', 'v', 'm', '1'},
+			{'t', 'e', 's', 't', 'v', 'm', '2'},
+		}
+		expectedChains = make([]APIBlockchain, 0, len(vmIDs))
+	)
+	for i, vmID := range vmIDs {
+		service.vm.ctx.Lock.Lock()
+		chainName := fmt.Sprintf("chain %d", i)
+		tx, err := txBuilder.NewCreateChainTx(
+			testSupernet1.ID(),
+			nil,
+			vmID,
+			nil,
+			chainName,
+			ids.Empty,
+			[]*secp256k1.PrivateKey{creator, controlKey},
+			common.WithCustomAddresses(set.Of(creatorAddr)),
+			common.WithSupernetAuthKeys(secp256k1fx.NewKeychain(creator, controlKey)),
+		)
+		require.NoError(err)
+		service.vm.ctx.Lock.Unlock()
+
+		require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+		service.vm.ctx.Lock.Lock()
+
+		blk, err := service.vm.BuildBlock(context.Background())
+		require.NoError(err)
+		require.NoError(blk.Verify(context.Background()))
+		require.NoError(blk.Accept(context.Background()))
+		require.NoError(service.vm.SetPreference(context.Background(), service.vm.manager.LastAccepted()))
+
+		service.vm.ctx.Lock.Unlock()
+
+		expectedChains = append(expectedChains, APIBlockchain{
+			ID:         tx.ID(),
+			Name:       chainName,
+			SupernetID: testSupernet1.ID(),
+			VMID:       vmID,
+		})
+	}
+
+	creatorAddrStr, err := service.addrManager.FormatLocalAddress(creatorAddr)
+	require.NoError(err)
+
+	var response GetChainsCreatedByResponse
+	require.NoError(service.GetChainsCreatedBy(nil, &GetChainsCreatedByArgs{
+		JSONAddresses: api.JSONAddresses{
+			Addresses: []string{creatorAddrStr},
+		},
+	}, &response))
+	require.ElementsMatch(expectedChains, response.Blockchains)
+
+	// Chains created by other addresses aren't returned, even if the
+	// addresses authorized their creation on the supernet
+	for _, addr := range []ids.ShortID{controlKey.Address(), keys[4].Address()} {
+		addrStr, err := service.addrManager.FormatLocalAddress(addr)
+		require.NoError(err)
+		require.NoError(service.GetChainsCreatedBy(nil, &GetChainsCreatedByArgs{
+			JSONAddresses: api.JSONAddresses{
+				Addresses: []string{addrStr},
+			},
+		}, &response))
+		require.Empty(response.Blockchains)
+	}
+}
+
+func TestGetProcessingBlocks(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	var response GetProcessingBlocksReply
+	require.NoError(service.GetProcessingBlocks(nil, nil, &response))
+	require.Empty(response.Blocks)
+
+	service.vm.ctx.Lock.Lock()
+
+	preferredID := service.vm.manager.Preferred()
+	preferred, err := service.vm.manager.GetBlock(preferredID)
+	require.NoError(err)
+	preferredChainTime := preferred.Timestamp()
+	preferredHeight := preferred.Height()
+
+	// Build two competing blocks on top of the preferred block
+	expectedBlocks := make([]ProcessingBlock, 0, 2)
+	for _, key := range keys[:2] {
+		tx, err := txBuilder.NewCreateSupernetTx(
+			&secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{key.Address()},
+			},
+			[]*secp256k1.PrivateKey{key},
+		)
+		require.NoError(err)
+
+		statelessBlk, err := block.NewBanffStandardBlock(
+			preferredChainTime,
+			preferredID,
+			preferredHeight+1,
+			[]*txs.Tx{tx},
+		)
+		require.NoError(err)
+
+		blk := service.vm.manager.NewBlock(statelessBlk)
+		require.NoError(blk.Verify(context.Background()))
+
+		expectedBlocks = append(expectedBlocks, ProcessingBlock{
+			ID:     blk.ID(),
+			Height: avajson.Uint64(preferredHeight + 1),
+		})
+	}
+
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.GetProcessingBlocks(nil, nil, &response))
+	require.ElementsMatch(expectedBlocks, response.Blocks)
+}
+
+func TestVerifyBlockChain(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+
+	lastAcceptedID := service.vm.manager.LastAccepted()
+	lastAccepted, err := service.vm.manager.GetBlock(lastAcceptedID)
+	require.NoError(err)
+	chainTime := lastAccepted.Timestamp()
+
+	// Build a two-block chain on top of the last accepted block where only
+	// the parent has been verified.
+	var (
+		parentID = lastAcceptedID
+		height   = lastAccepted.Height()
+		chain    = make([]snowman.Block, 0, 2)
+	)
+	for _, key := range keys[:2] {
+		tx, err := txBuilder.NewCreateSupernetTx(
+			&secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{key.Address()},
+			},
+			[]*secp256k1.PrivateKey{key},
+		)
+		require.NoError(err)
+
+		height++
+		statelessBlk, err := block.NewBanffStandardBlock(
+			chainTime,
+			parentID,
+			height,
+			[]*txs.Tx{tx},
+		)
+		require.NoError(err)
+
+		blk := service.vm.manager.NewBlock(statelessBlk)
+		chain = append(chain, blk)
+		parentID = blk.ID()
+	}
+	require.NoError(chain[0].Verify(context.Background()))
+
+	service.vm.ctx.Lock.Unlock()
+
+	verifyBlockChain := func(blkID ids.ID) (*VerifyBlockChainReply, error) {
+		reply := &VerifyBlockChainReply{}
+		err := service.VerifyBlockChain(nil, &VerifyBlockChainArgs{
+			BlockID: blkID,
+		}, reply)
+		return reply, err
+	}
+
+	reply, err := verifyBlockChain(chain[0].ID())
+	require.NoError(err)
+	require.Equal([]ids.ID{chain[0].ID()}, reply.Verified)
+	require.Nil(reply.FailedBlockID)
+	require.Empty(reply.Error)
+
+	reply, err = verifyBlockChain(lastAcceptedID)
+	require.NoError(err)
+	require.Empty(reply.Verified)
+
+	// Blocks that aren't processing are never verified by the call.
+	_, err = verifyBlockChain(chain[1].ID())
+	require.ErrorIs(err, blockexecutor.ErrBlockNotProcessing)
+
+	service.vm.ctx.Lock.Lock()
+	processingBlks, err := service.vm.manager.ProcessingBlocks()
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+	require.Len(processingBlks, 1)
+
+	// Once its parent is verified, the whole chain is reported.
+	service.vm.ctx.Lock.Lock()
+	err = chain[1].Verify(context.Background())
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+
+	reply, err = verifyBlockChain(chain[1].ID())
+	require.NoError(err)
+	require.Equal([]ids.ID{chain[0].ID(), chain[1].ID()}, reply.Verified)
+	require.Nil(reply.FailedBlockID)
+
+	// A block that was verified while the local clock was ahead is too far in
+	// the future once the clock is moved back, so it fails verification again.
+	service.vm.ctx.Lock.Lock()
+	tx, err := txBuilder.NewCreateSupernetTx(
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{keys[2].Address()},
+		},
+		[]*secp256k1.PrivateKey{keys[2]},
+	)
+	require.NoError(err)
+
+	futureTime := chainTime.Add(2 * txexecutor.SyncBound)
+	statelessBlk, err := block.NewBanffStandardBlock(
+		futureTime,
+		parentID,
+		height+1,
+		[]*txs.Tx{tx},
+	)
+	require.NoError(err)
+
+	futureBlk := service.vm.manager.NewBlock(statelessBlk)
+	service.vm.clock.Set(futureTime)
+	err = futureBlk.Verify(context.Background())
+	service.vm.clock.Set(chainTime)
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+
+	reply, err = verifyBlockChain(futureBlk.ID())
+	require.NoError(err)
+	require.Equal([]ids.ID{chain[0].ID(), chain[1].ID()}, reply.Verified)
+	require.Equal(futureBlk.ID(), *reply.FailedBlockID)
+	require.NotEmpty(reply.Error)
+
+	// The failed verification doesn't modify the processing blocks.
+	service.vm.ctx.Lock.Lock()
+	processingBlks, err = service.vm.manager.ProcessingBlocks()
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+	require.Len(processingBlks, 3)
+}
+
+func TestGetBlockBuildLatency(t *testing.T) {
+	require := require.New(t)
+
+	// Txs may already be issued while initializing the service, so every
+	// measured latency is bounded by the duration of the whole test.
+	start := time.Now()
+	service, _, txBuilder := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	tx, err := txBuilder.NewCreateSupernetTx(
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{keys[0].Address()},
+		},
+		[]*secp256k1.PrivateKey{keys[0]},
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+
+	service.vm.ctx.Lock.Lock()
+	blk, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+	service.vm.ctx.Lock.Unlock()
+
+	maxLatency := time.Since(start)
+
+	var response GetBlockBuildLatencyReply
+	require.NoError(service.GetBlockBuildLatency(nil, nil, &response))
+	require.Positive(response.Latency)
+	require.LessOrEqual(time.Duration(response.Latency), maxLatency)
+}
+
+func TestGetReorgEvents(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+
+	preferredID := service.vm.manager.Preferred()
+	preferred, err := service.vm.manager.GetBlock(preferredID)
+	require.NoError(err)
+
+	// Build two competing blocks on top of the preferred block.
+	competingBlks := make([]snowman.Block, 2)
+	for i := range competingBlks {
+		tx, err := txBuilder.NewCreateSupernetTx(
+			&secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{keys[i].Address()},
+			},
+			[]*secp256k1.PrivateKey{keys[i]},
+		)
+		require.NoError(err)
+
+		statelessBlk, err := block.NewBanffStandardBlock(
+			preferred.Timestamp(),
+			preferredID,
+			preferred.Height()+1,
+			[]*txs.Tx{tx},
+		)
+		require.NoError(err)
+
+		blk := service.vm.manager.NewBlock(statelessBlk)
+		require.NoError(blk.Verify(context.Background()))
+		competingBlks[i] = blk
+	}
+	replacedBlk, acceptedBlk := competingBlks[0], competingBlks[1]
+
+	// Switch the preference from the first block to the second one before
+	// accepting it.
+	require.NoError(service.vm.SetPreference(context.Background(), replacedBlk.ID()))
+	require.NoError(service.vm.SetPreference(context.Background(), acceptedBlk.ID()))
+	require.NoError(acceptedBlk.Accept(context.Background()))
+	require.NoError(replacedBlk.Reject(context.Background()))
+	service.vm.ctx.Lock.Unlock()
+
+	height := acceptedBlk.Height()
+	args := GetReorgEventsArgs{
+		FromHeight: avajson.Uint64(height),
+		ToHeight:   avajson.Uint64(height),
+	}
+	var reply GetReorgEventsReply
+	require.NoError(service.GetReorgEvents(nil, &args, &reply))
+	require.Equal([]ReorgEvent{
+		{
+			Height:          avajson.Uint64(height),
+			AcceptedBlockID: acceptedBlk.ID(),
+			ReplacedBlockID: replacedBlk.ID(),
+		},
+	}, reply.Events)
+
+	args.FromHeight = avajson.Uint64(height + 1)
+	args.ToHeight = avajson.Uint64(height + 1)
+	require.NoError(service.GetReorgEvents(nil, &args, &reply))
+	require.Empty(reply.Events)
+
+	args.FromHeight = avajson.Uint64(height + 1)
+	args.ToHeight = avajson.Uint64(height)
+	err = service.GetReorgEvents(nil, &args, &reply)
+	require.ErrorIs(err, errInvalidHeightRange)
+}
+
+func TestGetAPISchema(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	var reply GetAPISchemaReply
+	require.NoError(service.GetAPISchema(nil, nil, &reply))
+
+	methods := make(map[string]APIMethod, len(reply.Methods))
+	for _, method := range reply.Methods {
+		methods[method.Name] = method
+	}
+
+	require.Equal(APIMethod{
+		Name:  "platform.getHeight",
+		Args:  []APIField{},
+		Reply: []APIField{{Name: "height", Type: "json.Uint64"}},
+	}, methods["platform.getHeight"])
+
+	getBalance := methods["platform.getBalance"]
+	require.Equal([]APIField{
+		{Name: "addresses", Type: "[]string"},
+		{Name: "includeMempool", Type: "bool"},
+	}, getBalance.Args)
+	require.Contains(getBalance.Reply, APIField{Name: "balance", Type: "json.Uint64"})
+	require.Contains(getBalance.Reply, APIField{Name: "utxoIDs", Type: "[]*avax.UTXOID"})
+
+	// Embedded structs are flattened.
+	require.Contains(methods["platform.exportKey"].Args, APIField{Name: "username", Type: "string"})
+
+	require.Contains(methods, "platform.getAPISchema")
+	require.True(slices.IsSortedFunc(reply.Methods, func(a, b APIMethod) int {
+		return strings.Compare(a.Name, b.Name)
+	}))
+}
+
+func TestGetValidatorDelegationTerms(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	var (
+		nodeID      = ids.GenerateTestNodeID()
+		weight      = service.vm.MinValidatorStake
+		maxWeight   = txexecutor.MaxValidatorWeightFactor * weight
+		rewardOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+	)
+
+	service.vm.ctx.Lock.Lock()
+
+	startTime := service.vm.state.GetTimestamp()
+	endTime := startTime.Add(defaultMinStakingDuration)
+
+	vdrTx, err := txBuilder.NewAddValidatorTx(
+		&txs.Validator{
+			NodeID: nodeID,
+			Start:  uint64(startTime.Unix()),
+			End:    uint64(endTime.Unix()),
+			Wght:   weight,
+		},
+		rewardOwner,
+		20_0000,
+		[]*secp256k1.PrivateKey{keys[0]},
+	)
+	require.NoError(err)
+
+	vdrStaker, err := state.NewCurrentStaker(
+		vdrTx.ID(),
+		vdrTx.Unsigned.(*txs.AddValidatorTx),
+		startTime,
+		0,
+	)
+	require.NoError(err)
+
+	service.vm.state.PutCurrentValidator(vdrStaker)
+	service.vm.state.AddTx(vdrTx, status.Committed)
+	require.NoError(service.vm.state.Commit())
+
+	service.vm.ctx.Lock.Unlock()
+
+	args := GetValidatorDelegationTermsArgs{
+		SupernetID: constants.PrimaryNetworkID,
+		NodeID:     nodeID,
+	}
+	var reply GetValidatorDelegationTermsReply
+	require.NoError(service.GetValidatorDelegationTerms(nil, &args, &reply))
+	require.Equal(GetValidatorDelegationTermsReply{
+		DelegationFee:   20,
+		MaxWeight:       avajson.Uint64(maxWeight),
+		AvailableWeight: avajson.Uint64(maxWeight - weight),
+		HasCapacity:     true,
+	}, reply)
+
+	// Fill the validator up to just below the minimum delegator stake of its
+	// delegation cap
+	delegatorWeight := maxWeight - weight - service.vm.MinDelegatorStake + 1
+
+	service.vm.ctx.Lock.Lock()
+
+	delTx, err := txBuilder.NewAddDelegatorTx(
+		&txs.Validator{
+			NodeID: nodeID,
+			Start:  uint64(startTime.Unix()),
+			End:    uint64(endTime.Unix()),
+			Wght:   delegatorWeight,
+		},
+		rewardOwner,
+		[]*secp256k1.PrivateKey{keys[1]},
+	)
+	require.NoError(err)
+
+	delStaker, err := state.NewCurrentStaker(
+		delTx.ID(),
+		delTx.Unsigned.(*txs.AddDelegatorTx),
+		startTime,
+		0,
+	)
+	require.NoError(err)
+
+	service.vm.state.PutCurrentDelegator(delStaker)
+	service.vm.state.AddTx(delTx, status.Committed)
+	require.NoError(service.vm.state.Commit())
+
+	service.vm.ctx.Lock.Unlock()
+
+	reply = GetValidatorDelegationTermsReply{}
+	require.NoError(service.GetValidatorDelegationTerms(nil, &args, &reply))
+	require.Equal(GetValidatorDelegationTermsReply{
+		DelegationFee:   20,
+		MaxWeight:       avajson.Uint64(maxWeight),
+		AvailableWeight: avajson.Uint64(service.vm.MinDelegatorStake - 1),
+		HasCapacity:     false,
+	}, reply)
+
+	// Permissioned supernet validators don't accept delegators
+	args = GetValidatorDelegationTermsArgs{
+		SupernetID: testSupernet1.ID(),
+		NodeID:     genesisNodeIDs[0],
+	}
+	service.vm.ctx.Lock.Lock()
+
+	supernetVdrTx, err := txBuilder.NewAddSupernetValidatorTx(
+		&txs.SupernetValidator{
+			Validator: txs.Validator{
+				NodeID: genesisNodeIDs[0],
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(endTime.Unix()),
+				Wght:   1,
+			},
+			Supernet: testSupernet1.ID(),
+		},
+		[]*secp256k1.PrivateKey{testSupernet1ControlKeys[0], testSupernet1ControlKeys[1]},
+	)
+	require.NoError(err)
+
+	supernetVdrStaker, err := state.NewCurrentStaker(
+		supernetVdrTx.ID(),
+		supernetVdrTx.Unsigned.(*txs.AddSupernetValidatorTx),
+		startTime,
+		0,
+	)
+	require.NoError(err)
+
+	service.vm.state.PutCurrentValidator(supernetVdrStaker)
+	service.vm.state.AddTx(supernetVdrTx, status.Committed)
+	require.NoError(service.vm.state.Commit())
+
+	service.vm.ctx.Lock.Unlock()
+
+	err = service.GetValidatorDelegationTerms(nil, &args, &reply)
+	require.ErrorIs(err, errNoDelegation)
+}
+
+func TestGetSupernet(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	err := service.GetSupernet(nil, &GetSupernetArgs{
+		SupernetID: constants.PrimaryNetworkID,
+	}, &GetSupernetResponse{})
+	require.ErrorIs(err, errPrimaryNetworkIsNotASupernet)
+
+	err = service.GetSupernet(nil, &GetSupernetArgs{
+		SupernetID: ids.GenerateTestID(),
+	}, &GetSupernetResponse{})
+	require.ErrorIs(err, database.ErrNotFound)
+
+	supernetID := testSupernet1.ID()
+	reply := GetSupernetResponse{}
+	require.NoError(service.GetSupernet(nil, &GetSupernetArgs{
+		SupernetID: supernetID,
+	}, &reply))
+	require.True(reply.IsPermissioned)
+	require.NotEmpty(reply.ControlKeys)
+	require.Nil(reply.Staking)
+
+	transformSupernetTx := &txs.Tx{
+		Unsigned: &txs.TransformSupernetTx{
+			Supernet:          supernetID,
+			AssetID:           ids.GenerateTestID(),
+			MinValidatorStake: 1,
+			MaxValidatorStake: 100,
+			MinDelegatorStake: 2,
+			MinStakeDuration:  60,
+			MaxStakeDuration:  3600,
+			MinDelegationFee:  20_000,
+			MaxDelegationFee:  500_000,
+			SupernetAuth:      &secp256k1fx.Input{},
+		},
+	}
+	require.NoError(transformSupernetTx.Initialize(txs.Codec))
+
+	service.vm.ctx.Lock.Lock()
+	service.vm.state.AddSupernetTransformation(transformSupernetTx)
+	service.vm.ctx.Lock.Unlock()
+
+	reply = GetSupernetResponse{}
+	require.NoError(service.GetSupernet(nil, &GetSupernetArgs{
+		SupernetID: supernetID,
+	}, &reply))
+	require.False(reply.IsPermissioned)
+	require.Equal(transformSupernetTx.ID(), reply.SupernetTransformationTxID)
+	require.Equal(&APISupernetStaking{
+		AssetID:           transformSupernetTx.Unsigned.(*txs.TransformSupernetTx).AssetID,
+		MinValidatorStake: 1,
+		MaxValidatorStake: 100,
+		MinDelegatorStake: 2,
+		MinStakeDuration:  60,
+		MaxStakeDuration:  3600,
+		MinDelegationFee:  20_000,
+		MaxDelegationFee:  500_000,
+	}, reply.Staking)
+}
+
+func TestGetSupernetOwnerHistory(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	creationBlk, err := service.vm.manager.GetBlock(service.vm.manager.LastAccepted())
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	createSupernetTx := testSupernet1.Unsigned.(*txs.CreateSupernetTx)
+	creationOwner, err := service.getAPIOwner(createSupernetTx.Owner.(*secp256k1fx.OutputOwners))
+	require.NoError(err)
+	creationHeight := avajson.Uint64(creationBlk.Height())
+
+	args := GetSupernetOwnerHistoryArgs{
+		SupernetID: testSupernet1.ID(),
+	}
+	var response GetSupernetOwnerHistoryResponse
+	require.NoError(service.GetSupernetOwnerHistory(nil, &args, &response))
+	require.Equal([]APISupernetOwnerChange{
+		{
+			Height: &creationHeight,
+			Owner:  creationOwner,
+		},
+	}, response.Owners)
+
+	// Transfer the ownership of the supernet
+	newOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{keys[3].PublicKey().Address()},
+	}
+
+	service.vm.ctx.Lock.Lock()
+
+	tx, err := txBuilder.NewTransferSupernetOwnershipTx(
+		testSupernet1.ID(),
+		newOwner,
+		[]*secp256k1.PrivateKey{testSupernet1ControlKeys[0], testSupernet1ControlKeys[1]},
+	)
+	require.NoError(err)
+
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+	service.vm.ctx.Lock.Lock()
+
+	transferBlk, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(transferBlk.Verify(context.Background()))
+	require.NoError(transferBlk.Accept(context.Background()))
+
+	service.vm.ctx.Lock.Unlock()
+
+	newAPIOwner, err := service.getAPIOwner(newOwner)
+	require.NoError(err)
+	transferHeight := avajson.Uint64(transferBlk.Height())
+
+	response = GetSupernetOwnerHistoryResponse{}
+	require.NoError(service.GetSupernetOwnerHistory(nil, &args, &response))
+	require.Equal([]APISupernetOwnerChange{
+		{
+			Height: &creationHeight,
+			Owner:  creationOwner,
+		},
+		{
+			Height: &transferHeight,
+			Owner:  newAPIOwner,
+		},
+	}, response.Owners)
+}
+
+func TestGetSupernetOwnerHistoryBackfill(t *testing.T) {
+	newOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{keys[3].PublicKey().Address()},
+	}
+	creationHeight := avajson.Uint64(1)
+
+	tests := []struct {
+		name              string
+		creationHeightErr error
+		// If nil, the supernet is still owned by its creation owner
+		currentOwner    *secp256k1fx.OutputOwners
+		expectedHeights []*avajson.Uint64
+	}{
+		{
+			name:              "creation height unknown",
+			creationHeightErr: database.ErrNotFound,
+			expectedHeights:   []*avajson.Uint64{nil},
+		},
+		{
+			name:            "transferred before the index",
+			currentOwner:    newOwner,
+			expectedHeights: []*avajson.Uint64{&creationHeight, nil},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			service, _, _ := defaultService(t)
+
+			// [testSupernet1] is created by [defaultService].
+			supernetID := testSupernet1.ID()
+			creationOwner := testSupernet1.Unsigned.(*txs.CreateSupernetTx).Owner.(*secp256k1fx.OutputOwners)
+			expectedOwners := []*secp256k1fx.OutputOwners{creationOwner}
+			currentOwner := creationOwner
+			if test.currentOwner != nil {
+				currentOwner = test.currentOwner
+				expectedOwners = append(expectedOwners, test.currentOwner)
+			}
+
+			state := state.NewMockState(ctrl)
+			state.EXPECT().GetSupernetOwner(supernetID).Return(currentOwner, nil)
+			state.EXPECT().GetTx(supernetID).Return(testSupernet1, status.Committed, nil)
+			state.EXPECT().GetTxHeight(supernetID).Return(uint64(creationHeight), test.creationHeightErr)
+			state.EXPECT().GetSupernetOwnerHistory(supernetID).Return(nil, nil)
+
+			legacyService := &Service{
+				vm: &VM{
+					state: state,
+					ctx:   service.vm.ctx,
+				},
+				addrManager: service.addrManager,
+			}
+
+			args := GetSupernetOwnerHistoryArgs{
+				SupernetID: supernetID,
+			}
+			var response GetSupernetOwnerHistoryResponse
+			require.NoError(legacyService.GetSupernetOwnerHistory(nil, &args, &response))
+
+			expectedChanges := make([]APISupernetOwnerChange, len(expectedOwners))
+			for i, owner := range expectedOwners {
+				apiOwner, err := service.getAPIOwner(owner)
+				require.NoError(err)
+				expectedChanges[i] = APISupernetOwnerChange{
+					Height: test.expectedHeights[i],
+					Owner:  apiOwner,
+				}
+			}
+			require.Equal(expectedChanges, response.Owners)
+		})
+	}
+}
+
+func TestGetFeeConfig(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultFastService(t)
+
+	service.vm.AddSupernetValidatorFee = 8 * defaultTxFee
+
+	var reply GetFeeConfigReply
+	require.NoError(service.GetFeeConfig(nil, nil, &reply))
+
+	service.vm.ctx.Lock.Lock()
+	timestamp := service.vm.state.GetTimestamp()
+	service.vm.ctx.Lock.Unlock()
+
+	require.Equal(avajson.Uint64(timestamp.Unix()), reply.Timestamp)
+	require.Equal(avajson.Uint64(service.vm.TxFee), reply.TxFee)
+	require.Equal(avajson.Uint64(service.vm.GetCreateSupernetTxFee(timestamp)), reply.CreateSupernetTxFee)
+	require.Equal(avajson.Uint64(service.vm.TransformSupernetTxFee), reply.TransformSupernetTxFee)
+	require.Equal(avajson.Uint64(service.vm.GetCreateBlockchainTxFee(timestamp)), reply.CreateBlockchainTxFee)
+	require.Equal(avajson.Uint64(8*defaultTxFee), reply.AddSupernetValidatorFee)
+}
+
+func TestGetSupernetOperationFeeIsGlobal(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	service.vm.AddPrimaryNetworkValidatorFee = 10 * defaultTxFee
+	service.vm.AddPrimaryNetworkDelegatorFee = 9 * defaultTxFee
+	service.vm.AddSupernetValidatorFee = 8 * defaultTxFee
+	service.vm.AddSupernetDelegatorFee = 7 * defaultTxFee
+
+	getFee := func(supernetID ids.ID, operation string) (uint64, error) {
+		var reply GetSupernetOperationFeeReply
+		err := service.GetSupernetOperationFee(nil, &GetSupernetOperationFeeArgs{
+			SupernetID: supernetID,
+			Operation:  operation,
+		}, &reply)
+		return uint64(reply.Fee), err
+	}
+
+	fee, err := getFee(constants.PrimaryNetworkID, AddValidatorOperation)
+	require.NoError(err)
+	require.Equal(10*defaultTxFee, fee)
+
+	fee, err = getFee(constants.PrimaryNetworkID, AddDelegatorOperation)
+	require.NoError(err)
+	require.Equal(9*defaultTxFee, fee)
+
+	_, err = getFee(constants.PrimaryNetworkID, "removeValidator")
+	require.ErrorIs(err, errUnknownSupernetOperation)
+
+	_, err = getFee(ids.GenerateTestID(), AddValidatorOperation)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// Permissioned supernets can add validators, at the global supernet fee,
+	// but not delegators
+	fee, err = getFee(testSupernet1.ID(), AddValidatorOperation)
+	require.NoError(err)
+	require.Equal(8*defaultTxFee, fee)
+
+	_, err = getFee(testSupernet1.ID(), AddDelegatorOperation)
+	require.ErrorIs(err, errPermissionedSupernet)
+
+	// Transforming the supernet enables delegation, still at the global
+	// supernet fees
+	service.vm.ctx.Lock.Lock()
+	service.vm.state.AddSupernetTransformation(&txs.Tx{
+		Unsigned: &txs.TransformSupernetTx{
+			Supernet: testSupernet1.ID(),
+			AssetID:  ids.GenerateTestID(),
+		},
+	})
+	service.vm.ctx.Lock.Unlock()
+
+	fee, err = getFee(testSupernet1.ID(), AddValidatorOperation)
+	require.NoError(err)
+	require.Equal(8*defaultTxFee, fee)
+
+	fee, err = getFee(testSupernet1.ID(), AddDelegatorOperation)
+	require.NoError(err)
+	require.Equal(7*defaultTxFee, fee)
+
+	// Supernets have no fees of their own, so changing the global supernet
+	// fee changes the fee of every supernet
+	service.vm.AddSupernetValidatorFee = 5 * defaultTxFee
+
+	fee, err = getFee(testSupernet1.ID(), AddValidatorOperation)
+	require.NoError(err)
+	require.Equal(5*defaultTxFee, fee)
+
+	fee, err = getFee(constants.PrimaryNetworkID, AddValidatorOperation)
+	require.NoError(err)
+	require.Equal(10*defaultTxFee, fee)
+}
+
+func TestGetMinStake(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	reply := GetMinStakeReply{}
+	require.NoError(service.GetMinStake(nil, &GetMinStakeArgs{
+		SupernetID: constants.PrimaryNetworkID,
+	}, &reply))
+	require.Equal(GetMinStakeReply{
+		MinValidatorStake: avajson.Uint64(defaultMinValidatorStake),
+		MinDelegatorStake: avajson.Uint64(defaultMinDelegatorStake),
+		MaxValidatorStake: avajson.Uint64(defaultMaxValidatorStake),
+		MinStakeDuration:  avajson.Uint64(defaultMinStakingDuration / time.Second),
+		MaxStakeDuration:  avajson.Uint64(defaultMaxStakingDuration / time.Second),
+		MinDelegationFee:  avajson.Uint32(service.vm.MinDelegationFee),
+		MaxDelegationFee:  avajson.Uint32(service.vm.MaxDelegationFee),
+	}, reply)
+
+	// Permissioned supernets don't have staking parameters
+	err := service.GetMinStake(nil, &GetMinStakeArgs{
+		SupernetID: testSupernet1.ID(),
+	}, &GetMinStakeReply{})
+	require.ErrorIs(err, database.ErrNotFound)
+
+	service.vm.ctx.Lock.Lock()
+	service.vm.state.AddSupernetTransformation(&txs.Tx{
+		Unsigned: &txs.TransformSupernetTx{
+			Supernet:          testSupernet1.ID(),
+			AssetID:           ids.GenerateTestID(),
+			MinValidatorStake: 1,
+			MaxValidatorStake: 100,
+			MinDelegatorStake: 2,
+			MinStakeDuration:  60,
+			MaxStakeDuration:  3600,
+			MinDelegationFee:  20_000,
+			MaxDelegationFee:  500_000,
+		},
+	})
+	service.vm.ctx.Lock.Unlock()
+
+	reply = GetMinStakeReply{}
+	require.NoError(service.GetMinStake(nil, &GetMinStakeArgs{
+		SupernetID: testSupernet1.ID(),
+	}, &reply))
+	require.Equal(GetMinStakeReply{
+		MinValidatorStake: 1,
+		MinDelegatorStake: 2,
+		MaxValidatorStake: 100,
+		MinStakeDuration:  60,
+		MaxStakeDuration:  3600,
+		MinDelegationFee:  20_000,
+		MaxDelegationFee:  500_000,
+	}, reply)
+}
+
+func TestGetNodeSupernets(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	// Genesis validators only validate the primary network
+	nodeID := genesisNodeIDs[0]
+	args := GetNodeSupernetsArgs{NodeID: nodeID}
+	reply := GetNodeSupernetsReply{}
+	require.NoError(service.GetNodeSupernets(nil, &args, &reply))
+	require.Equal([]NodeSupernet{
+		{SupernetID: constants.PrimaryNetworkID, Weight: avajson.Uint64(defaultWeight)},
+	}, reply.Supernets)
+
+	service.vm.ctx.Lock.Lock()
+
+	startTime := service.vm.clock.Time()
+	supernetVdrTx, err := txBuilder.NewAddSupernetValidatorTx(
+		&txs.SupernetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(startTime.Add(defaultMinStakingDuration).Unix()),
+				Wght:   1234,
+			},
+			Supernet: testSupernet1.ID(),
+		},
+		[]*secp256k1.PrivateKey{testSupernet1ControlKeys[0], testSupernet1ControlKeys[1]},
+	)
+	if err != nil {
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(err)
+	}
+
+	supernetVdrStaker, err := state.NewCurrentStaker(
+		supernetVdrTx.ID(),
+		supernetVdrTx.Unsigned.(*txs.AddSupernetValidatorTx),
+		startTime,
+		0,
+	)
+	if err != nil {
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(err)
+	}
+
+	service.vm.state.PutCurrentValidator(supernetVdrStaker)
+	service.vm.state.AddTx(supernetVdrTx, status.Committed)
+	err = service.vm.state.Commit()
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+
+	reply = GetNodeSupernetsReply{}
+	require.NoError(service.GetNodeSupernets(nil, &args, &reply))
+	expected := []NodeSupernet{
+		{SupernetID: constants.PrimaryNetworkID, Weight: avajson.Uint64(defaultWeight)},
+		{SupernetID: testSupernet1.ID(), Weight: 1234},
+	}
+	slices.SortFunc(expected, func(a, b NodeSupernet) int {
+		return a.SupernetID.Compare(b.SupernetID)
+	})
+	require.Equal(expected, reply.Supernets)
+
+	// Nodes that aren't validating return no supernets
+	args = GetNodeSupernetsArgs{NodeID: ids.GenerateTestNodeID()}
+	reply = GetNodeSupernetsReply{}
+	require.NoError(service.GetNodeSupernets(nil, &args, &reply))
+	require.Empty(reply.Supernets)
+}
+
+func TestGetAccruedReward(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultFastService(t)
+
+	nodeID := genesisNodeIDs[0]
+	service.vm.ctx.Lock.Lock()
+	staker, err := service.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(err)
+	}
+	if err := service.vm.Connected(context.Background(), nodeID, nil); err != nil {
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(err)
+	}
+	service.vm.ctx.Lock.Unlock()
+
+	var (
+		args = GetAccruedRewardArgs{
+			SupernetID: constants.PrimaryNetworkID,
+			NodeID:     nodeID,
+		}
+		stakingPeriod = staker.EndTime.Sub(staker.StartTime)
+		lastAccrued   avajson.Uint64
+	)
+	for i := 1; i <= 4; i++ {
+		now := staker.StartTime.Add(stakingPeriod * time.Duration(i) / 4)
+		service.vm.ctx.Lock.Lock()
+		service.vm.clock.Set(now)
+		service.vm.state.SetTimestamp(now)
+		service.vm.ctx.Lock.Unlock()
+
+		reply := GetAccruedRewardReply{}
+		require.NoError(service.GetAccruedReward(nil, &args, &reply))
+		require.Equal(avajson.Uint64(staker.PotentialReward), reply.PotentialReward)
+		require.Greater(reply.AccruedReward, lastAccrued)
+		require.LessOrEqual(reply.AccruedReward, reply.PotentialReward)
+		lastAccrued = reply.AccruedReward
+	}
+	require.Equal(avajson.Uint64(staker.PotentialReward), lastAccrued)
+
+	// The accrued reward stops growing once the staking period is over
+	service.vm.ctx.Lock.Lock()
+	service.vm.clock.Set(staker.EndTime.Add(time.Hour))
+	service.vm.state.SetTimestamp(staker.EndTime.Add(time.Hour))
+	service.vm.ctx.Lock.Unlock()
+
+	reply := GetAccruedRewardReply{}
+	require.NoError(service.GetAccruedReward(nil, &args, &reply))
+	require.Equal(reply.PotentialReward, reply.AccruedReward)
+}
+
+func TestGetUpcomingRewards(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	args := GetUpcomingRewardsArgs{SupernetID: constants.PrimaryNetworkID}
+	reply := GetUpcomingRewardsReply{}
+	require.NoError(service.GetUpcomingRewards(nil, &args, &reply))
+	require.Len(reply.Rewards, len(genesisNodeIDs))
+	for i, reward := range reply.Rewards {
+		require.False(reward.IsDelegator)
+		if i > 0 {
+			require.LessOrEqual(reply.Rewards[i-1].EndTime, reward.EndTime)
+		}
+	}
+
+	// The limit keeps the first stakers to be rewarded
+	args.Limit = 2
+	limitedReply := GetUpcomingRewardsReply{}
+	require.NoError(service.GetUpcomingRewards(nil, &args, &limitedReply))
+	require.Equal(reply.Rewards[:2], limitedReply.Rewards)
+
+	// Permissioned supernet validators are not rewarded
+	service.vm.ctx.Lock.Lock()
+
+	startTime := service.vm.clock.Time()
+	supernetVdrTx, err := txBuilder.NewAddSupernetValidatorTx(
+		&txs.SupernetValidator{
+			Validator: txs.Validator{
+				NodeID: genesisNodeIDs[0],
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(startTime.Add(defaultMinStakingDuration).Unix()),
+				Wght:   1234,
+			},
+			Supernet: testSupernet1.ID(),
+		},
+		[]*secp256k1.PrivateKey{testSupernet1ControlKeys[0], testSupernet1ControlKeys[1]},
+	)
+	if err != nil {
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(err)
+	}
+
+	supernetVdrStaker, err := state.NewCurrentStaker(
+		supernetVdrTx.ID(),
+		supernetVdrTx.Unsigned.(*txs.AddSupernetValidatorTx),
+		startTime,
+		0,
+	)
+	if err != nil {
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(err)
+	}
+
+	service.vm.state.PutCurrentValidator(supernetVdrStaker)
+	service.vm.state.AddTx(supernetVdrTx, status.Committed)
+	err = service.vm.state.Commit()
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+
+	args = GetUpcomingRewardsArgs{SupernetID: testSupernet1.ID()}
+	reply = GetUpcomingRewardsReply{}
+	require.NoError(service.GetUpcomingRewards(nil, &args, &reply))
+	require.Empty(reply.Rewards)
+}
+
+func TestGetBlockchainsValidating(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultFastService(t)
+	service.vm.ctx.NodeID = genesisNodeIDs[0]
+
+	createSupernetTx, err := txs.NewSigned(&txs.CreateSupernetTx{
+		Owner: &secp256k1fx.OutputOwners{},
+	}, txs.Codec, nil)
+	require.NoError(err)
+	supernetID := createSupernetTx.ID()
+
+	chainAssetID := ids.GenerateTestID()
+	newCreateChainTx := func(supernetID ids.ID, name string) *txs.Tx {
+		tx, err := txs.NewSigned(&txs.CreateChainTx{
+			SupernetID:   supernetID,
+			ChainName:    name,
+			ChainAssetID: chainAssetID,
+			VMID:         ids.GenerateTestID(),
+			SupernetAuth: &secp256k1fx.Input{},
+		}, txs.Codec, nil)
+		require.NoError(err)
+		return tx
+	}
+	var (
+		primaryChainTx  = newCreateChainTx(constants.PrimaryNetworkID, "primary")
+		supernetChainTx = newCreateChainTx(supernetID, "supernet")
+	)
+
+	service.vm.ctx.Lock.Lock()
+	service.vm.state.AddSupernet(createSupernetTx)
+	service.vm.state.AddChain(primaryChainTx)
+	service.vm.state.AddChain(supernetChainTx)
+	service.vm.ctx.Lock.Unlock()
+
+	// The node only validates the primary network.
+	reply := GetBlockchainsResponse{}
+	require.NoError(service.GetBlockchains(nil, nil, &reply))
+
+	blockchains := make(map[ids.ID]APIBlockchain, len(reply.Blockchains))
+	for _, blockchain := range reply.Blockchains {
+		blockchains[blockchain.ID] = blockchain
+	}
+	require.Equal(APIBlockchain{
+		ID:           primaryChainTx.ID(),
+		Name:         "primary",
+		SupernetID:   constants.PrimaryNetworkID,
+		VMID:         primaryChainTx.Unsigned.(*txs.CreateChainTx).VMID,
+		ChainAssetID: chainAssetID,
+		Validating:   true,
+	}, blockchains[primaryChainTx.ID()])
+	require.Equal(APIBlockchain{
+		ID:           supernetChainTx.ID(),
+		Name:         "supernet",
+		SupernetID:   supernetID,
+		VMID:         supernetChainTx.Unsigned.(*txs.CreateChainTx).VMID,
+		ChainAssetID: chainAssetID,
+		Validating:   false,
+	}, blockchains[supernetChainTx.ID()])
+}
+
+func TestGetTxByPrefix(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	newTx := func() *txs.Tx {
+		tx, err := txs.NewSigned(&txs.CreateSupernetTx{
+			Owner: &secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+			},
+		}, txs.Codec, nil)
+		require.NoError(err)
+		return tx
+	}
+	committedTx := newTx()
+	abortedTx := newTx()
+
+	service.vm.ctx.Lock.Lock()
+	service.vm.state.AddTx(committedTx, status.Committed)
+	service.vm.state.AddTx(abortedTx, status.Aborted)
+	err := service.vm.state.Commit()
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+
+	committedTxID := committedTx.ID()
+	for _, prefix := range []string{
+		"0x" + hex.EncodeToString(committedTxID[:minGetTxByPrefixBytes]),
+		"0x" + hex.EncodeToString(committedTxID[:]),
+	} {
+		reply := GetTxByPrefixReply{}
+		require.NoError(service.GetTxByPrefix(nil, &GetTxByPrefixArgs{Prefix: prefix}, &reply), prefix)
+		require.Equal(committedTxID, reply.TxID, prefix)
+	}
+
+	abortedTxID := abortedTx.ID()
+	err = service.GetTxByPrefix(nil, &GetTxByPrefixArgs{Prefix: "0x" + hex.EncodeToString(abortedTxID[:])}, &GetTxByPrefixReply{})
+	require.ErrorIs(err, errNoTxWithPrefix)
+
+	unknownTxID := ids.GenerateTestID()
+	err = service.GetTxByPrefix(nil, &GetTxByPrefixArgs{Prefix: "0x" + hex.EncodeToString(unknownTxID[:])}, &GetTxByPrefixReply{})
+	require.ErrorIs(err, errNoTxWithPrefix)
+
+	err = service.GetTxByPrefix(nil, &GetTxByPrefixArgs{Prefix: "0x" + hex.EncodeToString(committedTxID[:minGetTxByPrefixBytes-1])}, &GetTxByPrefixReply{})
+	require.ErrorIs(err, errTxPrefixTooShort)
+
+	err = service.GetTxByPrefix(nil, &GetTxByPrefixArgs{Prefix: committedTxID.String()[:16]}, &GetTxByPrefixReply{})
+	require.ErrorIs(err, errTxPrefixNotHex)
+}
+
+func TestGetNextRewardTime(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	// The genesis validators are all rewarded at the same time.
+	reply := GetNextRewardTimeReply{}
+	require.NoError(service.GetNextRewardTime(nil, nil, &reply))
+	require.Equal(defaultValidateEndTime.Unix(), reply.NextRewardTime.Unix())
+
+	var (
+		earliestEndTime = defaultValidateEndTime.Add(-2 * time.Hour)
+		earliestStaker  = &state.Staker{
+			TxID:       ids.GenerateTestID(),
+			NodeID:     ids.GenerateTestNodeID(),
+			SupernetID: constants.PrimaryNetworkID,
+			Weight:     defaultWeight,
+			StartTime:  defaultValidateStartTime,
+			EndTime:    earliestEndTime,
+			NextTime:   earliestEndTime,
+			Priority:   txs.PrimaryNetworkValidatorCurrentPriority,
+		}
+		laterEndTime = defaultValidateEndTime.Add(-time.Hour)
+		laterStaker  = &state.Staker{
+			TxID:       ids.GenerateTestID(),
+			NodeID:     ids.GenerateTestNodeID(),
+			SupernetID: constants.PrimaryNetworkID,
+			Weight:     defaultWeight,
+			StartTime:  defaultValidateStartTime,
+			EndTime:    laterEndTime,
+			NextTime:   laterEndTime,
+			Priority:   txs.PrimaryNetworkValidatorCurrentPriority,
+		}
+	)
+	service.vm.ctx.Lock.Lock()
+	service.vm.state.PutCurrentValidator(laterStaker)
+	service.vm.state.PutCurrentValidator(earliestStaker)
+	service.vm.ctx.Lock.Unlock()
+
+	reply = GetNextRewardTimeReply{}
+	require.NoError(service.GetNextRewardTime(nil, nil, &reply))
+	require.Equal(GetNextRewardTimeReply{
+		NextRewardTime: earliestEndTime,
+		TxID:           earliestStaker.TxID,
+		NodeID:         earliestStaker.NodeID,
+		SupernetID:     constants.PrimaryNetworkID,
+	}, reply)
+
+	// Permissioned supernet validators aren't rewarded, so they are skipped
+	// even if they end first.
+	permissionedEndTime := earliestEndTime.Add(-time.Hour)
+	service.vm.ctx.Lock.Lock()
+	service.vm.state.PutCurrentValidator(&state.Staker{
+		TxID:       ids.GenerateTestID(),
+		NodeID:     ids.GenerateTestNodeID(),
+		SupernetID: testSupernet1.ID(),
+		Weight:     defaultWeight,
+		StartTime:  defaultValidateStartTime,
+		EndTime:    permissionedEndTime,
+		NextTime:   permissionedEndTime,
+		Priority:   txs.SupernetPermissionedValidatorCurrentPriority,
+	})
+	service.vm.ctx.Lock.Unlock()
+
+	reply = GetNextRewardTimeReply{}
+	require.NoError(service.GetNextRewardTime(nil, nil, &reply))
+	require.Equal(GetNextRewardTimeReply{
+		NextRewardTime: earliestEndTime,
+		TxID:           earliestStaker.TxID,
+		NodeID:         earliestStaker.NodeID,
+		SupernetID:     constants.PrimaryNetworkID,
+	}, reply)
+}
+
+func TestEstimateReward(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultFastService(t)
+
+	var (
+		stakeAmount = 2 * defaultWeight
+		startTime   = defaultGenesisTime.Add(time.Hour)
+		duration    = 30 * defaultMinStakingDuration
+		calculator  = reward.NewCalculator(service.vm.RewardConfig)
+	)
+
+	reply := EstimateRewardReply{}
+	require.NoError(service.EstimateReward(nil, &EstimateRewardArgs{
+		SupernetID:  constants.PrimaryNetworkID,
+		StakeAmount: avajson.Uint64(stakeAmount),
+		StartTime:   avajson.Uint64(startTime.Unix()),
+		EndTime:     avajson.Uint64(startTime.Add(duration).Unix()),
+	}, &reply))
+	require.Equal(calculator.Calculate(duration, startTime, stakeAmount), uint64(reply.PotentialReward))
+	require.NotZero(reply.PotentialReward)
+
+	// Omitting the start time defaults to the chain time.
+	service.vm.ctx.Lock.Lock()
+	chainTime := service.vm.state.GetTimestamp()
+	service.vm.ctx.Lock.Unlock()
+
+	reply = EstimateRewardReply{}
+	require.NoError(service.EstimateReward(nil, &EstimateRewardArgs{
+		SupernetID:  constants.PrimaryNetworkID,
+		StakeAmount: avajson.Uint64(stakeAmount),
+		EndTime:     avajson.Uint64(chainTime.Add(duration).Unix()),
+	}, &reply))
+	require.Equal(calculator.Calculate(duration, chainTime, stakeAmount), uint64(reply.PotentialReward))
+
+	err := service.EstimateReward(nil, &EstimateRewardArgs{
+		SupernetID:  constants.PrimaryNetworkID,
+		StakeAmount: avajson.Uint64(stakeAmount),
+		StartTime:   avajson.Uint64(startTime.Unix()),
+		EndTime:     avajson.Uint64(startTime.Add(defaultMinStakingDuration - time.Second).Unix()),
+	}, &EstimateRewardReply{})
+	require.ErrorIs(err, txexecutor.ErrStakeTooShort)
+
+	err = service.EstimateReward(nil, &EstimateRewardArgs{
+		SupernetID:  constants.PrimaryNetworkID,
+		StakeAmount: avajson.Uint64(stakeAmount),
+		StartTime:   avajson.Uint64(startTime.Unix()),
+		EndTime:     avajson.Uint64(startTime.Add(defaultMaxStakingDuration + time.Second).Unix()),
+	}, &EstimateRewardReply{})
+	require.ErrorIs(err, txexecutor.ErrStakeTooLong)
+}
+
+func TestGetRewardUTXOs(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	var (
+		rewardedTxID   = ids.GenerateTestID()
+		unrewardedTxID = ids.GenerateTestID()
+		rewardUTXO     = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        rewardedTxID,
+				OutputIndex: 1,
+			},
+			Asset: avax.Asset{ID: service.vm.ctx.JUNEAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{keys[0].Address()},
+				},
+			},
+		}
+	)
+
+	service.vm.ctx.Lock.Lock()
+	service.vm.state.AddRewardUTXO(rewardedTxID, rewardUTXO)
+	if err := service.vm.state.Commit(); err != nil {
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(err)
+	}
+	service.vm.ctx.Lock.Unlock()
+
+	expectedUTXOBytes, err := txs.GenesisCodec.Marshal(txs.CodecVersion, rewardUTXO)
+	require.NoError(err)
+	expectedUTXO, err := formatting.Encode(formatting.Hex, expectedUTXOBytes)
+	require.NoError(err)
+
+	reply := GetRewardUTXOsReply{}
+	require.NoError(service.GetRewardUTXOs(nil, &api.GetTxArgs{
+		TxID:     rewardedTxID,
+		Encoding: formatting.Hex,
+	}, &reply))
+	require.Equal(avajson.Uint64(1), reply.NumFetched)
+	require.Equal([]string{expectedUTXO}, reply.UTXOs)
+
+	// A tx that wasn't rewarded returns an empty list rather than an error
+	reply = GetRewardUTXOsReply{}
+	require.NoError(service.GetRewardUTXOs(nil, &api.GetTxArgs{
+		TxID:     unrewardedTxID,
+		Encoding: formatting.Hex,
+	}, &reply))
+	require.Zero(reply.NumFetched)
+	require.NotNil(reply.UTXOs)
+	require.Empty(reply.UTXOs)
+}
+
+func TestSampleValidators(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultFastService(t)
+
+	args := SampleValidatorsArgs{
+		Size:       avajson.Uint16(len(genesisNodeIDs)),
+		SupernetID: constants.PrimaryNetworkID,
+	}
+	reply := SampleValidatorsReply{}
+	require.NoError(service.SampleValidators(nil, &args, &reply))
+	require.Len(reply.Validators, len(genesisNodeIDs))
+	require.Empty(reply.ValidatorDetails)
+
+	args.IncludeDetails = true
+	reply = SampleValidatorsReply{}
+	require.NoError(service.SampleValidators(nil, &args, &reply))
+	require.Len(reply.Validators, len(genesisNodeIDs))
+	require.Len(reply.ValidatorDetails, len(reply.Validators))
+	for i, details := range reply.ValidatorDetails {
+		require.Equal(reply.Validators[i], details.NodeID)
+		require.Equal(avajson.Uint64(defaultWeight), details.Weight)
+		require.Nil(details.PublicKey)
+	}
+}
+
+func TestGetTimestamp(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	reply := GetTimestampReply{}
+	require.NoError(service.GetTimestamp(nil, nil, &reply))
+
+	service.vm.ctx.Lock.Lock()
+
+	require.Equal(service.vm.state.GetTimestamp(), reply.Timestamp)
+
+	newTimestamp := reply.Timestamp.Add(time.Second)
 	service.vm.state.SetTimestamp(newTimestamp)
 
 	service.vm.ctx.Lock.Unlock()
@@ -729,6 +3151,71 @@ func TestGetTimestamp(t *testing.T) {
 	require.Equal(newTimestamp, reply.Timestamp)
 }
 
+func TestReadOnlyAPI(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+	service.vm.ReadOnlyAPI = true
+
+	service.vm.ctx.Lock.Lock()
+	tx, err := txBuilder.NewBaseTx(
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: service.vm.ctx.JUNEAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.MilliAvax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+				},
+			},
+		}},
+		[]*secp256k1.PrivateKey{keys[0]},
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+	args := &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}
+
+	// Mutating endpoints are rejected.
+	err = service.IssueTx(nil, args, &api.JSONTxID{})
+	require.ErrorIs(err, ErrReadOnlyNode)
+
+	adminService := &AdminService{
+		vm:           service.vm,
+		addrManager:  service.addrManager,
+		balanceCache: service.balanceCache,
+	}
+	err = adminService.WarmBalanceCache(nil, &api.JSONAddresses{
+		Addresses: []string{testAddress},
+	}, &WarmBalanceCacheReply{})
+	require.ErrorIs(err, ErrReadOnlyNode)
+
+	service.vm.ctx.Lock.Lock()
+	_, ok := service.vm.Builder.Get(tx.ID())
+	service.vm.ctx.Lock.Unlock()
+	require.False(ok)
+
+	// Queries are served normally.
+	reply := GetTimestampReply{}
+	require.NoError(service.GetTimestamp(nil, nil, &reply))
+
+	service.vm.ctx.Lock.Lock()
+	lastAcceptedID := service.vm.manager.LastAccepted()
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(service.VerifyBlockChain(nil, &VerifyBlockChainArgs{
+		BlockID: lastAcceptedID,
+	}, &VerifyBlockChainReply{}))
+
+	service.vm.ReadOnlyAPI = false
+	response := api.JSONTxID{}
+	require.NoError(service.IssueTx(nil, args, &response))
+	require.Equal(tx.ID(), response.TxID)
+}
+
 func TestGetBlock(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -813,6 +3300,25 @@ func TestGetBlock(t *testing.T) {
 	}
 }
 
+func TestGetValidatorSetHash(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultFastService(t)
+
+	service.vm.ctx.Lock.Lock()
+	height, err := service.vm.GetCurrentHeight(context.Background())
+	require.NoError(err)
+	vdrs, err := service.vm.GetValidatorSet(context.Background(), height, constants.PrimaryNetworkID)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	var reply GetValidatorSetHashReply
+	require.NoError(service.GetValidatorSetHash(&http.Request{}, &GetValidatorSetHashArgs{
+		Height:     avajson.Uint64(height),
+		SupernetID: constants.PrimaryNetworkID,
+	}, &reply))
+	require.Equal(validators.HashValidatorSet(vdrs), reply.Hash)
+}
+
 func TestGetValidatorsAtReplyMarshalling(t *testing.T) {
 	require := require.New(t)
 
@@ -860,13 +3366,43 @@ func TestServiceGetBlockByHeight(t *testing.T) {
 	}
 
 	tests := []test{
+		{
+			name: "block height above last accepted",
+			serviceAndExpectedBlockFunc: func(_ *testing.T, ctrl *gomock.Controller) (*Service, interface{}) {
+				lastAccepted := block.NewMockBlock(ctrl)
+				lastAccepted.EXPECT().Height().Return(blockHeight - 1)
+
+				state := state.NewMockState(ctrl)
+				state.EXPECT().GetBlockIDAtHeight(blockHeight).Return(ids.Empty, database.ErrNotFound)
+
+				manager := blockexecutor.NewMockManager(ctrl)
+				manager.EXPECT().LastAccepted().Return(blockID)
+				manager.EXPECT().GetStatelessBlock(blockID).Return(lastAccepted, nil)
+				return &Service{
+					vm: &VM{
+						state:   state,
+						manager: manager,
+						ctx: &snow.Context{
+							Log: logging.NoLog{},
+						},
+					},
+				}, nil
+			},
+			encoding:    formatting.Hex,
+			expectedErr: errHeightAboveLastAccepted,
+		},
 		{
 			name: "block height not found",
 			serviceAndExpectedBlockFunc: func(_ *testing.T, ctrl *gomock.Controller) (*Service, interface{}) {
+				lastAccepted := block.NewMockBlock(ctrl)
+				lastAccepted.EXPECT().Height().Return(blockHeight + 1)
+
 				state := state.NewMockState(ctrl)
 				state.EXPECT().GetBlockIDAtHeight(blockHeight).Return(ids.Empty, database.ErrNotFound)
 
 				manager := blockexecutor.NewMockManager(ctrl)
+				manager.EXPECT().LastAccepted().Return(blockID)
+				manager.EXPECT().GetStatelessBlock(blockID).Return(lastAccepted, nil)
 				return &Service{
 					vm: &VM{
 						state:   state,
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Juneo-io/juneogo/database"
+	"github.com/Juneo-io/juneogo/utils/constants"
+	"github.com/Juneo-io/juneogo/version"
+	"github.com/Juneo-io/juneogo/vms/components/avax"
+	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
+
+	smcon "github.com/Juneo-io/juneogo/snow/consensus/snowman"
+)
+
+var (
+	errNotValidatorTx    = errors.New("not a validator tx")
+	errNotAmounter       = errors.New("reward output without an amount")
+	errInvalidUptimeRate = errors.New("uptime must be in [0, 1]")
+)
+
+// SimulateStakeCycle is meant to be used by tests. It issues [validatorTx],
+// which must add a primary network validator, and drives the validator through
+// its staking period, by advancing the clock of [vm] and building blocks, until
+// it is removed from the current validator set. The validator is connected for
+// the first [uptime] fraction of its staking period. Returns the amount
+// rewarded to the validator.
+//
+// Invariant: [vm.ctx.Lock] is held.
+func SimulateStakeCycle(vm *VM, validatorTx *txs.Tx, uptime float64) (uint64, error) {
+	if uptime < 0 || uptime > 1 {
+		return 0, fmt.Errorf("%w: %f", errInvalidUptimeRate, uptime)
+	}
+	validator, ok := validatorTx.Unsigned.(txs.ValidatorTx)
+	if !ok {
+		return 0, fmt.Errorf("%w: %T", errNotValidatorTx, validatorTx.Unsigned)
+	}
+
+	ctx := context.Background()
+	vm.ctx.Lock.Unlock()
+	err := vm.issueTxFromRPC(validatorTx)
+	vm.ctx.Lock.Lock()
+	if err != nil {
+		return 0, err
+	}
+	if err := acceptNextBlock(ctx, vm); err != nil {
+		return 0, err
+	}
+
+	nodeID := validator.NodeID()
+	staker, err := vm.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Only report the validator as connected for [uptime] of its staking
+	// period.
+	stakingPeriod := staker.EndTime.Sub(staker.StartTime)
+	if err := vm.Connected(ctx, nodeID, version.CurrentApp); err != nil {
+		return 0, err
+	}
+	vm.clock.Set(staker.StartTime.Add(time.Duration(uptime * float64(stakingPeriod))))
+	if err := vm.Disconnected(ctx, nodeID); err != nil {
+		return 0, err
+	}
+	vm.clock.Set(staker.EndTime)
+
+	// Accept the preferred option of the proposal blocks until the validator
+	// is rewarded.
+	for {
+		_, err := vm.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+		if err == database.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if err := acceptNextBlock(ctx, vm); err != nil {
+			return 0, err
+		}
+	}
+
+	rewardUTXOs, err := vm.state.GetRewardUTXOs(validatorTx.ID())
+	if err != nil {
+		return 0, err
+	}
+
+	var rewards uint64
+	for _, utxo := range rewardUTXOs {
+		out, ok := utxo.Out.(avax.Amounter)
+		if !ok {
+			return 0, fmt.Errorf("%w: %T", errNotAmounter, utxo.Out)
+		}
+		rewards += out.Amount()
+	}
+	return rewards, nil
+}
+
+// acceptNextBlock builds the next block of [vm] and accepts it. If the block is
+// a proposal block, its preferred option is accepted as well.
+//
+// Invariant: [vm.ctx.Lock] is held.
+func acceptNextBlock(ctx context.Context, vm *VM) error {
+	blk, err := vm.Builder.BuildBlock(ctx)
+	if err != nil {
+		return err
+	}
+	if err := blk.Verify(ctx); err != nil {
+		return err
+	}
+
+	var preferred smcon.Block
+	if oracleBlk, ok := blk.(smcon.OracleBlock); ok {
+		options, err := oracleBlk.Options(ctx)
+		switch {
+		case err == nil:
+			preferred = options[0]
+			if err := preferred.Verify(ctx); err != nil {
+				return err
+			}
+		case !errors.Is(err, smcon.ErrNotOracle):
+			return err
+		}
+	}
+
+	if err := blk.Accept(ctx); err != nil {
+		return err
+	}
+	if preferred != nil {
+		if err := preferred.Accept(ctx); err != nil {
+			return err
+		}
+	}
+	return vm.SetPreference(ctx, vm.manager.LastAccepted())
+}
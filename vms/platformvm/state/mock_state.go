@@ -19,6 +19,7 @@ import (
 	ids "github.com/Juneo-io/juneogo/ids"
 	validators "github.com/Juneo-io/juneogo/snow/validators"
 	logging "github.com/Juneo-io/juneogo/utils/logging"
+	set "github.com/Juneo-io/juneogo/utils/set"
 	avax "github.com/Juneo-io/juneogo/vms/components/avax"
 	block "github.com/Juneo-io/juneogo/vms/platformvm/block"
 	fx "github.com/Juneo-io/juneogo/vms/platformvm/fx"
@@ -1560,6 +1561,21 @@ func (mr *MockStateMockRecorder) GetSupernetOwner(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupernetOwner", reflect.TypeOf((*MockState)(nil).GetSupernetOwner), arg0)
 }
 
+// GetSupernetOwnerHistory mocks base method.
+func (m *MockState) GetSupernetOwnerHistory(arg0 ids.ID) ([]SupernetOwnerChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupernetOwnerHistory", arg0)
+	ret0, _ := ret[0].([]SupernetOwnerChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupernetOwnerHistory indicates an expected call of GetSupernetOwnerHistory.
+func (mr *MockStateMockRecorder) GetSupernetOwnerHistory(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupernetOwnerHistory", reflect.TypeOf((*MockState)(nil).GetSupernetOwnerHistory), arg0)
+}
+
 // GetSupernetTransformation mocks base method.
 func (m *MockState) GetSupernetTransformation(arg0 ids.ID) (*txs.Tx, error) {
 	m.ctrl.T.Helper()
@@ -1620,6 +1636,36 @@ func (mr *MockStateMockRecorder) GetTx(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockState)(nil).GetTx), arg0)
 }
 
+// GetTxHeight mocks base method.
+func (m *MockState) GetTxHeight(arg0 ids.ID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxHeight", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxHeight indicates an expected call of GetTxHeight.
+func (mr *MockStateMockRecorder) GetTxHeight(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxHeight", reflect.TypeOf((*MockState)(nil).GetTxHeight), arg0)
+}
+
+// GetTxIDs mocks base method.
+func (m *MockState) GetTxIDs(arg0 []byte, arg1 int) ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxIDs", arg0, arg1)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxIDs indicates an expected call of GetTxIDs.
+func (mr *MockStateMockRecorder) GetTxIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxIDs", reflect.TypeOf((*MockState)(nil).GetTxIDs), arg0, arg1)
+}
+
 // GetUTXO mocks base method.
 func (m *MockState) GetUTXO(arg0 ids.ID) (*avax.UTXO, error) {
 	m.ctrl.T.Helper()
@@ -1635,6 +1681,21 @@ func (mr *MockStateMockRecorder) GetUTXO(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXO", reflect.TypeOf((*MockState)(nil).GetUTXO), arg0)
 }
 
+// GetUTXOAssetIDs mocks base method.
+func (m *MockState) GetUTXOAssetIDs() (set.Set[ids.ID], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUTXOAssetIDs")
+	ret0, _ := ret[0].(set.Set[ids.ID])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUTXOAssetIDs indicates an expected call of GetUTXOAssetIDs.
+func (mr *MockStateMockRecorder) GetUTXOAssetIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXOAssetIDs", reflect.TypeOf((*MockState)(nil).GetUTXOAssetIDs))
+}
+
 // GetUptime mocks base method.
 func (m *MockState) GetUptime(arg0 ids.NodeID, arg1 ids.ID) (time.Duration, time.Time, error) {
 	m.ctrl.T.Helper()
@@ -1651,6 +1712,20 @@ func (mr *MockStateMockRecorder) GetUptime(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUptime", reflect.TypeOf((*MockState)(nil).GetUptime), arg0, arg1)
 }
 
+// IndexTxHeights mocks base method.
+func (m *MockState) IndexTxHeights(arg0 sync.Locker, arg1 logging.Logger) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexTxHeights", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IndexTxHeights indicates an expected call of IndexTxHeights.
+func (mr *MockStateMockRecorder) IndexTxHeights(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexTxHeights", reflect.TypeOf((*MockState)(nil).IndexTxHeights), arg0, arg1)
+}
+
 // PutCurrentDelegator mocks base method.
 func (m *MockState) PutCurrentDelegator(arg0 *Staker) {
 	m.ctrl.T.Helper()
@@ -31,6 +31,7 @@ import (
 	"github.com/Juneo-io/juneogo/utils/crypto/bls"
 	"github.com/Juneo-io/juneogo/utils/hashing"
 	"github.com/Juneo-io/juneogo/utils/logging"
+	"github.com/Juneo-io/juneogo/utils/set"
 	"github.com/Juneo-io/juneogo/utils/timer"
 	"github.com/Juneo-io/juneogo/utils/wrappers"
 	"github.com/Juneo-io/juneogo/vms/components/avax"
@@ -71,10 +72,12 @@ var (
 	ValidatorWeightDiffsPrefix    = []byte("flatValidatorDiffs")
 	ValidatorPublicKeyDiffsPrefix = []byte("flatPublicKeyDiffs")
 	TxPrefix                      = []byte("tx")
+	TxHeightPrefix                = []byte("txHeight")
 	RewardUTXOsPrefix             = []byte("rewardUTXOs")
 	UTXOPrefix                    = []byte("utxo")
 	SupernetPrefix                  = []byte("supernet")
 	SupernetOwnerPrefix             = []byte("supernetOwner")
+	SupernetOwnerHistoryPrefix      = []byte("supernetOwnerHistory")
 	TransformedSupernetPrefix       = []byte("transformedSupernet")
 	SupplyPrefix                  = []byte("supply")
 	rewardsSupplyPrefix           = []byte("rewardsSupply")
@@ -89,6 +92,7 @@ var (
 	HeightsIndexedKey   = []byte("heights indexed")
 	InitializedKey      = []byte("initialized")
 	BlocksReindexedKey  = []byte("blocks reindexed")
+	TxHeightsIndexedKey = []byte("tx heights indexed")
 )
 
 // Chain collects all methods to manage the state of the chain for block
@@ -146,6 +150,26 @@ type State interface {
 	GetSupernets() ([]*txs.Tx, error)
 	GetChains(supernetID ids.ID) ([]*txs.Tx, error)
 
+	// GetSupernetOwnerHistory returns the committed owners of [supernetID]
+	// sorted by the height at which they became effective.
+	GetSupernetOwnerHistory(supernetID ids.ID) ([]SupernetOwnerChange, error)
+
+	// GetTxHeight returns the height of the accepted block that included
+	// [txID], which must have been accepted. Txs included in the genesis are
+	// at height 0. If the tx was accepted before txs were indexed by height
+	// and [IndexTxHeights] hasn't finished yet, [database.ErrNotFound] is
+	// returned.
+	GetTxHeight(txID ids.ID) (uint64, error)
+
+	// GetTxIDs returns, in order, up to [limit] IDs of the txs whose ID starts
+	// with [prefix] and whose status is [status.Committed]. Every tx of the
+	// index under [prefix] may be read, so [prefix] should be selective.
+	GetTxIDs(prefix []byte, limit int) ([]ids.ID, error)
+
+	// GetUTXOAssetIDs returns the IDs of every asset that has at least one
+	// committed UTXO.
+	GetUTXOAssetIDs() (set.Set[ids.ID], error)
+
 	// ApplyValidatorWeightDiffs iterates from [startHeight] towards the genesis
 	// block until it has applied all of the diffs up to and including
 	// [endHeight]. Applying the diffs modifies [validators].
@@ -196,6 +220,12 @@ type State interface {
 	// TODO: Remove after v1.12.x is activated
 	ReindexBlocks(lock sync.Locker, log logging.Logger) error
 
+	// IndexTxHeights indexes the txs accepted before txs were indexed by the
+	// height of the block that included them. If this database has already
+	// indexed them, this function will return immediately, without iterating
+	// over the database.
+	IndexTxHeights(lock sync.Locker, log logging.Logger) error
+
 	// Commit changes to the base database.
 	Commit() error
 
@@ -257,6 +287,8 @@ type stateBlk struct {
  * | '-- blockID -> block bytes
  * |-. txs
  * | '-- txID -> tx bytes + tx status
+ * |-. txHeights
+ * | '-- txID -> height
  * |- rewardUTXOs
  * | '-. txID
  * |   '-. list
@@ -268,6 +300,8 @@ type stateBlk struct {
  * |   '-- txID -> nil
  * |-. supernetOwners
  * | '-. supernetID -> owner
+ * |-. supernetOwnerHistory
+ * | '-. supernetID + height -> owner
  * |-. chains
  * | '-. supernetID
  * |   '-. list
@@ -333,6 +367,8 @@ type state struct {
 	txCache  cache.Cacher[ids.ID, *txAndStatus] // txID -> {*txs.Tx, Status}. If the entry is nil, it isn't in the database
 	txDB     database.Database
 
+	txHeightDB database.Database // txID -> height of the block that included it
+
 	addedRewardUTXOs map[ids.ID][]*avax.UTXO            // map of txID -> []*UTXO
 	rewardUTXOsCache cache.Cacher[ids.ID, []*avax.UTXO] // txID -> []*UTXO
 	rewardUTXODB     database.Database
@@ -351,6 +387,10 @@ type state struct {
 	supernetOwnerCache cache.Cacher[ids.ID, fxOwnerAndSize] // cache of supernetID -> owner if the entry is nil, it is not in the database
 	supernetOwnerDB    database.Database
 
+	// Supernet ID --> Owner set since the last commit
+	supernetOwnerChanges   map[ids.ID]fx.Owner
+	supernetOwnerHistoryDB database.Database
+
 	transformedSupernets     map[ids.ID]*txs.Tx            // map of supernetID -> transformSupernetTx
 	transformedSupernetCache cache.Cacher[ids.ID, *txs.Tx] // cache of supernetID -> transformSupernetTx if the entry is nil, it is not in the database
 	transformedSupernetDB    database.Database
@@ -421,6 +461,13 @@ type txAndStatus struct {
 	status status.Status
 }
 
+// SupernetOwnerChange is an owner of a supernet along with the height of the
+// block that made it effective.
+type SupernetOwnerChange struct {
+	Height uint64
+	Owner  fx.Owner
+}
+
 type fxOwnerAndSize struct {
 	owner fx.Owner
 	size  int
@@ -657,6 +704,8 @@ func newState(
 		txDB:     prefixdb.New(TxPrefix, baseDB),
 		txCache:  txCache,
 
+		txHeightDB: prefixdb.New(TxHeightPrefix, baseDB),
+
 		addedRewardUTXOs: make(map[ids.ID][]*avax.UTXO),
 		rewardUTXODB:     rewardUTXODB,
 		rewardUTXOsCache: rewardUTXOsCache,
@@ -672,6 +721,9 @@ func newState(
 		supernetOwnerDB:    supernetOwnerDB,
 		supernetOwnerCache: supernetOwnerCache,
 
+		supernetOwnerChanges:   make(map[ids.ID]fx.Owner),
+		supernetOwnerHistoryDB: prefixdb.New(SupernetOwnerHistoryPrefix, baseDB),
+
 		transformedSupernets:     make(map[ids.ID]*txs.Tx),
 		transformedSupernetCache: transformedSupernetCache,
 		transformedSupernetDB:    prefixdb.New(TransformedSupernetPrefix, baseDB),
@@ -835,12 +887,38 @@ func (s *state) GetSupernetOwner(supernetID ids.ID) (fx.Owner, error) {
 		return nil, fmt.Errorf("%q %w", supernetID, errIsNotSupernet)
 	}
 
-	s.SetSupernetOwner(supernetID, supernet.Owner)
+	// The owner is persisted to avoid looking up the tx again, but it isn't
+	// an ownership change.
+	s.supernetOwners[supernetID] = supernet.Owner
 	return supernet.Owner, nil
 }
 
 func (s *state) SetSupernetOwner(supernetID ids.ID, owner fx.Owner) {
 	s.supernetOwners[supernetID] = owner
+	s.supernetOwnerChanges[supernetID] = owner
+}
+
+func (s *state) GetSupernetOwnerHistory(supernetID ids.ID) ([]SupernetOwnerChange, error) {
+	it := s.supernetOwnerHistoryDB.NewIteratorWithPrefix(supernetID[:])
+	defer it.Release()
+
+	var history []SupernetOwnerChange
+	for it.Next() {
+		height, err := database.ParseUInt64(it.Key()[ids.IDLen:])
+		if err != nil {
+			return nil, err
+		}
+
+		var owner fx.Owner
+		if _, err := block.GenesisCodec.Unmarshal(it.Value(), &owner); err != nil {
+			return nil, err
+		}
+		history = append(history, SupernetOwnerChange{
+			Height: height,
+			Owner:  owner,
+		})
+	}
+	return history, it.Error()
 }
 
 func (s *state) GetSupernetTransformation(supernetID ids.ID) (*txs.Tx, error) {
@@ -963,6 +1041,47 @@ func (s *state) GetTx(txID ids.ID) (*txs.Tx, status.Status, error) {
 	return ptx.tx, ptx.status, nil
 }
 
+func (s *state) GetTxHeight(txID ids.ID) (uint64, error) {
+	height, err := database.GetUInt64(s.txHeightDB, txID[:])
+	if err != database.ErrNotFound {
+		return height, err
+	}
+
+	// Once every block has been indexed, only the txs of the genesis, which
+	// were written before the index existed, can be missing.
+	indexed, err := s.singletonDB.Has(TxHeightsIndexedKey)
+	if err != nil {
+		return 0, err
+	}
+	if !indexed {
+		return 0, database.ErrNotFound
+	}
+	return 0, nil
+}
+
+func (s *state) GetTxIDs(prefix []byte, limit int) ([]ids.ID, error) {
+	it := s.txDB.NewIteratorWithPrefix(prefix)
+	defer it.Release()
+
+	var txIDs []ids.ID
+	for len(txIDs) < limit && it.Next() {
+		stx := txBytesAndStatus{}
+		if _, err := txs.GenesisCodec.Unmarshal(it.Value(), &stx); err != nil {
+			return nil, err
+		}
+		if stx.Status != status.Committed {
+			continue
+		}
+
+		txID, err := ids.ToID(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		txIDs = append(txIDs, txID)
+	}
+	return txIDs, it.Error()
+}
+
 func (s *state) AddTx(tx *txs.Tx, status status.Status) {
 	s.addedTxs[tx.ID()] = &txAndStatus{
 		tx:     tx,
@@ -1017,6 +1136,10 @@ func (s *state) UTXOIDs(addr []byte, start ids.ID, limit int) ([]ids.ID, error)
 	return s.utxoState.UTXOIDs(addr, start, limit)
 }
 
+func (s *state) GetUTXOAssetIDs() (set.Set[ids.ID], error) {
+	return s.utxoState.AssetIDs()
+}
+
 func (s *state) AddUTXO(utxo *avax.UTXO) {
 	s.modifiedUTXOs[utxo.InputID()] = utxo
 }
@@ -1739,11 +1862,12 @@ func (s *state) write(updateValidators bool, height uint64) error {
 		s.writeCurrentStakers(updateValidators, height, codecVersion),
 		s.writePendingStakers(),
 		s.WriteValidatorMetadata(s.currentValidatorList, s.currentSupernetValidatorList, codecVersion), // Must be called after writeCurrentStakers
-		s.writeTXs(),
+		s.writeTXs(height),
 		s.writeRewardUTXOs(),
 		s.writeUTXOs(),
 		s.writeSupernets(),
 		s.writeSupernetOwners(),
+		s.writeSupernetOwnerHistory(height),
 		s.writeTransformedSupernets(),
 		s.writeSupernetSupplies(),
 		s.writeSupernetRewardsSupplies(),
@@ -1774,6 +1898,7 @@ func (s *state) Close() error {
 		s.currentValidatorsDB.Close(),
 		s.validatorsDB.Close(),
 		s.txDB.Close(),
+		s.txHeightDB.Close(),
 		s.rewardUTXODB.Close(),
 		s.utxoDB.Close(),
 		s.supernetBaseDB.Close(),
@@ -2230,7 +2355,7 @@ func writePendingDiff(
 	return nil
 }
 
-func (s *state) writeTXs() error {
+func (s *state) writeTXs(height uint64) error {
 	for txID, txStatus := range s.addedTxs {
 		txID := txID
 
@@ -2254,6 +2379,9 @@ func (s *state) writeTXs() error {
 		if err := s.txDB.Put(txID[:], txBytes); err != nil {
 			return fmt.Errorf("failed to add tx: %w", err)
 		}
+		if err := database.PutUInt64(s.txHeightDB, txID[:], height); err != nil {
+			return fmt.Errorf("failed to add tx height: %w", err)
+		}
 	}
 	return nil
 }
@@ -2331,6 +2459,25 @@ func (s *state) writeSupernetOwners() error {
 	return nil
 }
 
+func (s *state) writeSupernetOwnerHistory(height uint64) error {
+	for supernetID, owner := range s.supernetOwnerChanges {
+		delete(s.supernetOwnerChanges, supernetID)
+
+		ownerBytes, err := block.GenesisCodec.Marshal(block.CodecVersion, &owner)
+		if err != nil {
+			return fmt.Errorf("failed to marshal supernet owner: %w", err)
+		}
+
+		key := make([]byte, ids.IDLen+database.Uint64Size)
+		copy(key, supernetID[:])
+		copy(key[ids.IDLen:], database.PackUInt64(height))
+		if err := s.supernetOwnerHistoryDB.Put(key, ownerBytes); err != nil {
+			return fmt.Errorf("failed to write supernet owner history: %w", err)
+		}
+	}
+	return nil
+}
+
 func (s *state) writeTransformedSupernets() error {
 	for supernetID, tx := range s.transformedSupernets {
 		txID := tx.ID()
@@ -2585,6 +2732,149 @@ func (s *state) ReindexBlocks(lock sync.Locker, log logging.Logger) error {
 	return s.Commit()
 }
 
+func (s *state) IndexTxHeights(lock sync.Locker, log logging.Logger) error {
+	has, err := s.singletonDB.Has(TxHeightsIndexedKey)
+	if err != nil {
+		return err
+	}
+	if has {
+		log.Info("tx heights already indexed")
+		return nil
+	}
+
+	// Blocks accepted after grabbing this iterator have their txs indexed
+	// when they are written, so we don't need to check them.
+	blockIterator := s.blockDB.NewIterator()
+	// Releasing is done using a closure to ensure that updating blockIterator
+	// will result in having the most recent iterator released when executing
+	// the deferred function.
+	defer func() {
+		blockIterator.Release()
+	}()
+
+	log.Info("starting tx height indexing")
+
+	var (
+		startTime        = time.Now()
+		lastCommit       = startTime
+		nextUpdate       = startTime.Add(indexLogFrequency)
+		numBlocksChecked = 0
+		numTxsIndexed    = 0
+	)
+
+	for blockIterator.Next() {
+		blk, _, err := parseStoredBlock(blockIterator.Value())
+		if err != nil {
+			return fmt.Errorf("failed to parse block: %w", err)
+		}
+
+		// The txs of a proposal block are written when its child is accepted.
+		height := blk.Height()
+		switch blk.(type) {
+		case *block.BanffProposalBlock, *block.ApricotProposalBlock:
+			height++
+		}
+
+		for _, tx := range blk.Txs() {
+			txID := tx.ID()
+			has, err := s.txHeightDB.Has(txID[:])
+			if err != nil {
+				return fmt.Errorf("failed to check height of tx %s: %w", txID, err)
+			}
+			if has {
+				continue
+			}
+			if err := database.PutUInt64(s.txHeightDB, txID[:], height); err != nil {
+				return fmt.Errorf("failed to add height of tx %s: %w", txID, err)
+			}
+			numTxsIndexed++
+		}
+
+		numBlocksChecked++
+
+		blkID := blk.ID()
+		now := time.Now()
+		if now.After(nextUpdate) {
+			nextUpdate = now.Add(indexLogFrequency)
+
+			progress := timer.ProgressFromHash(blkID[:])
+			eta := timer.EstimateETA(
+				startTime,
+				progress,
+				math.MaxUint64,
+			)
+
+			log.Info("indexing tx heights",
+				zap.Int("numTxsIndexed", numTxsIndexed),
+				zap.Int("numBlocksChecked", numBlocksChecked),
+				zap.Duration("eta", eta),
+			)
+		}
+
+		if numBlocksChecked%indexIterationLimit == 0 {
+			// We must hold the lock during committing to make sure we don't
+			// attempt to commit to disk while a block is concurrently being
+			// accepted.
+			lock.Lock()
+			err := utils.Err(
+				s.Commit(),
+				blockIterator.Error(),
+			)
+			lock.Unlock()
+			if err != nil {
+				return err
+			}
+
+			// We release the iterator here to allow the underlying database to
+			// clean up deleted state.
+			blockIterator.Release()
+
+			// We take the minimum here because it's possible that the node is
+			// currently bootstrapping. This would mean that grabbing the lock
+			// could take an extremely long period of time; which we should not
+			// delay processing for.
+			indexDuration := now.Sub(lastCommit)
+			sleepDuration := min(
+				indexIterationSleepMultiplier*indexDuration,
+				indexIterationSleepCap,
+			)
+			time.Sleep(sleepDuration)
+
+			// Make sure not to include the sleep duration into the next index
+			// duration.
+			lastCommit = time.Now()
+
+			blockIterator = s.blockDB.NewIteratorWithStart(blkID[:])
+		}
+	}
+
+	// Ensure we fully iterated over all blocks before writing that indexing has
+	// finished.
+	//
+	// Note: This is needed because a transient read error could cause the
+	// iterator to stop early.
+	if err := blockIterator.Error(); err != nil {
+		return fmt.Errorf("failed to iterate over historical blocks: %w", err)
+	}
+
+	if err := s.singletonDB.Put(TxHeightsIndexedKey, nil); err != nil {
+		return fmt.Errorf("failed to mark tx heights as indexed: %w", err)
+	}
+
+	// We must hold the lock during committing to make sure we don't attempt to
+	// commit to disk while a block is concurrently being accepted.
+	lock.Lock()
+	defer lock.Unlock()
+
+	log.Info("finished tx height indexing",
+		zap.Int("numTxsIndexed", numTxsIndexed),
+		zap.Int("numBlocksChecked", numBlocksChecked),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+
+	return s.Commit()
+}
+
 func (s *state) forceWriteMetadata() error {
 	if err := database.PutTimestamp(s.singletonDB, TimestampKey, s.timestamp); err != nil {
 		return fmt.Errorf("failed to force write timestamp: %w", err)
@@ -1367,6 +1367,50 @@ func TestReindexBlocks(t *testing.T) {
 	require.True(reindexed)
 }
 
+func TestIndexTxHeights(t *testing.T) {
+	var (
+		require = require.New(t)
+		s       = newInitializedState(require).(*state)
+		blks    = makeBlocks(require)
+	)
+
+	// Populate the blocks without indexing their txs.
+	for _, blk := range blks {
+		blkID := blk.ID()
+		require.NoError(s.blockDB.Put(blkID[:], blk.Bytes()))
+	}
+
+	var (
+		proposalTx = blks[3].Txs()[0]
+		standardTx = blks[4].Txs()[0]
+		genesisTx  = ids.GenerateTestID()
+	)
+	_, err := s.GetTxHeight(standardTx.ID())
+	require.ErrorIs(err, database.ErrNotFound)
+	_, err = s.GetTxHeight(genesisTx)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	require.NoError(s.IndexTxHeights(&sync.Mutex{}, logging.NoLog{}))
+
+	// The txs of a proposal block are accepted with its child.
+	height, err := s.GetTxHeight(proposalTx.ID())
+	require.NoError(err)
+	require.Equal(blks[3].Height()+1, height)
+
+	height, err = s.GetTxHeight(standardTx.ID())
+	require.NoError(err)
+	require.Equal(blks[4].Height(), height)
+
+	// Once every block is indexed, only the genesis txs are left.
+	height, err = s.GetTxHeight(genesisTx)
+	require.NoError(err)
+	require.Zero(height)
+
+	indexed, err := s.singletonDB.Has(TxHeightsIndexedKey)
+	require.NoError(err)
+	require.True(indexed)
+}
+
 func TestStateSupernetOwner(t *testing.T) {
 	require := require.New(t)
 
@@ -4,9 +4,12 @@
 package mempool
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"math/bits"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -14,7 +17,10 @@ import (
 	"github.com/Juneo-io/juneogo/ids"
 	"github.com/Juneo-io/juneogo/snow/engine/common"
 	"github.com/Juneo-io/juneogo/utils"
+	"github.com/Juneo-io/juneogo/utils/heap"
 	"github.com/Juneo-io/juneogo/utils/linked"
+	"github.com/Juneo-io/juneogo/utils/math"
+	"github.com/Juneo-io/juneogo/utils/set"
 	"github.com/Juneo-io/juneogo/utils/setmap"
 	"github.com/Juneo-io/juneogo/utils/units"
 	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
@@ -28,8 +34,13 @@ const (
 	// droppedTxIDsCacheSize is the maximum number of dropped txIDs to cache
 	droppedTxIDsCacheSize = 64
 
-	// maxMempoolSize is the maximum number of bytes allowed in the mempool
-	maxMempoolSize = 64 * units.MiB
+	// addedTimesCacheSize is the maximum number of txs to remember the time
+	// they were added to the mempool for
+	addedTimesCacheSize = 4096
+
+	// DefaultMaxSize is the default maximum number of bytes allowed in the
+	// mempool
+	DefaultMaxSize = 64 * units.MiB
 )
 
 var (
@@ -39,11 +50,15 @@ var (
 	ErrTxTooLarge                 = errors.New("tx too large")
 	ErrMempoolFull                = errors.New("mempool is full")
 	ErrConflictsWithOtherTx       = errors.New("tx conflicts with other tx")
+	ErrReplaced                   = errors.New("tx replaced by a tx paying a higher fee")
 	ErrCantIssueAdvanceTimeTx     = errors.New("can not issue an advance time tx")
 	ErrCantIssueRewardValidatorTx = errors.New("can not issue a reward validator tx")
 )
 
 type Mempool interface {
+	// Add adds [tx] to the mempool. If [tx] conflicts with txs in the
+	// mempool, it replaces them if it pays a strictly higher fee than all of
+	// them combined. Otherwise, [ErrConflictsWithOtherTx] is returned.
 	Add(tx *txs.Tx) error
 	Get(txID ids.ID) (*txs.Tx, bool)
 	// Remove [txs] and any conflicts of [txs] from the mempool.
@@ -68,6 +83,12 @@ type Mempool interface {
 	MarkDropped(txID ids.ID, reason error)
 	GetDropReason(txID ids.ID) error
 
+	// GetAddedTime returns when [txID] was first added to the mempool. The
+	// time is remembered after the tx is removed from the mempool, so that
+	// the time it takes for the tx to be included into an accepted block can
+	// be measured.
+	GetAddedTime(txID ids.ID) (time.Time, bool)
+
 	// Len returns the number of txs in the mempool.
 	Len() int
 }
@@ -78,41 +99,67 @@ type mempool struct {
 	lock           sync.RWMutex
 	unissuedTxs    *linked.Hashmap[ids.ID, *txs.Tx]
 	consumedUTXOs  *setmap.SetMap[ids.ID, ids.ID] // TxID -> Consumed UTXOs
+	maxSize        int
 	bytesAvailable int
-	droppedTxIDs   *cache.LRU[ids.ID, error] // TxID -> verification error
+	droppedTxIDs   *cache.LRU[ids.ID, error]     // TxID -> verification error
+	addedTimes     *cache.LRU[ids.ID, time.Time] // TxID -> time first added
+
+	// feeAssetID is the asset txs burn to pay their fees. It is used to pick
+	// the txs to evict when the mempool is full.
+	feeAssetID ids.ID
+	// byFeeRate orders the unissued txs by the fee they pay per byte, lowest
+	// first, so that the txs to evict are at the top.
+	byFeeRate  heap.Map[ids.ID, feeRate]
+	currentAge int
 
 	toEngine chan<- common.Message
 
 	numTxs               prometheus.Gauge
+	bytesMetric          prometheus.Gauge
 	bytesAvailableMetric prometheus.Gauge
 }
 
+// New returns a mempool that holds at most [maxSize] bytes of txs. When it is
+// full, txs paying lower fees per byte in [feeAssetID] are evicted to make room
+// for new ones.
 func New(
 	namespace string,
 	registerer prometheus.Registerer,
 	toEngine chan<- common.Message,
+	maxSize int,
+	feeAssetID ids.ID,
 ) (Mempool, error) {
 	m := &mempool{
 		unissuedTxs:    linked.NewHashmap[ids.ID, *txs.Tx](),
 		consumedUTXOs:  setmap.New[ids.ID, ids.ID](),
-		bytesAvailable: maxMempoolSize,
+		maxSize:        maxSize,
+		bytesAvailable: maxSize,
 		droppedTxIDs:   &cache.LRU[ids.ID, error]{Size: droppedTxIDsCacheSize},
+		addedTimes:     &cache.LRU[ids.ID, time.Time]{Size: addedTimesCacheSize},
+		feeAssetID:     feeAssetID,
+		byFeeRate:      heap.NewMap[ids.ID, feeRate](lessFeeRate),
 		toEngine:       toEngine,
 		numTxs: prometheus.NewGauge(prometheus.GaugeOpts{
 			Namespace: namespace,
 			Name:      "txs",
 			Help:      "Number of decision/staker transactions in the mempool",
 		}),
+		bytesMetric: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "bytes",
+			Help:      "Number of bytes used by the transactions in the mempool",
+		}),
 		bytesAvailableMetric: prometheus.NewGauge(prometheus.GaugeOpts{
 			Namespace: namespace,
 			Name:      "bytes_available",
 			Help:      "Number of bytes of space currently available in the mempool",
 		}),
 	}
-	m.bytesAvailableMetric.Set(maxMempoolSize)
+	m.bytesAvailableMetric.Set(float64(maxSize))
 
 	err := utils.Err(
 		registerer.Register(m.numTxs),
+		registerer.Register(m.bytesMetric),
 		registerer.Register(m.bytesAvailableMetric),
 	)
 	return m, err
@@ -145,24 +192,48 @@ func (m *mempool) Add(tx *txs.Tx) error {
 			MaxTxSize,
 		)
 	}
-	if txSize > m.bytesAvailable {
-		return fmt.Errorf("%w: %s size (%d) > available space (%d)",
-			ErrMempoolFull,
-			txID,
-			txSize,
-			m.bytesAvailable,
-		)
-	}
 
+	rate := feeRate{
+		fee:  tx.Unsigned.ConsumedValue(m.feeAssetID),
+		size: uint64(txSize),
+		age:  m.currentAge,
+	}
 	inputs := tx.Unsigned.InputIDs()
-	if m.consumedUTXOs.HasOverlap(inputs) {
-		return fmt.Errorf("%w: %s", ErrConflictsWithOtherTx, txID)
+	replacedTxIDs, err := m.replacedTxIDs(txID, inputs, rate.fee)
+	if err != nil {
+		return err
+	}
+
+	// The replaced txs are taken out of the heap so that they aren't
+	// evicted, but they are only removed once [tx] is known to fit.
+	bytesAvailable := m.bytesAvailable
+	replacedRates := make(map[ids.ID]feeRate, len(replacedTxIDs))
+	for replacedTxID := range replacedTxIDs {
+		replacedRate, _ := m.byFeeRate.Remove(replacedTxID)
+		replacedRates[replacedTxID] = replacedRate
+		bytesAvailable += int(replacedRate.size)
+	}
+	if txSize > bytesAvailable {
+		if err := m.evict(txID, rate, bytesAvailable); err != nil {
+			for replacedTxID, replacedRate := range replacedRates {
+				m.byFeeRate.Push(replacedTxID, replacedRate)
+			}
+			return err
+		}
+	}
+
+	for replacedTxID, replacedRate := range replacedRates {
+		m.unissuedTxs.Delete(replacedTxID)
+		m.consumedUTXOs.DeleteKey(replacedTxID)
+		m.bytesAvailable += int(replacedRate.size)
+		m.droppedTxIDs.Put(replacedTxID, fmt.Errorf("%w: %s", ErrReplaced, txID))
 	}
 
 	m.unissuedTxs.Put(txID, tx)
-	m.numTxs.Inc()
+	m.byFeeRate.Push(txID, rate)
+	m.currentAge++
 	m.bytesAvailable -= txSize
-	m.bytesAvailableMetric.Set(float64(m.bytesAvailable))
+	m.updateMetrics()
 
 	// Mark these UTXOs as consumed in the mempool
 	m.consumedUTXOs.Put(txID, inputs)
@@ -170,6 +241,12 @@ func (m *mempool) Add(tx *txs.Tx) error {
 	// An explicitly added tx must not be marked as dropped.
 	m.droppedTxIDs.Evict(txID)
 
+	// Txs can be re-added after being removed, for example if the block
+	// including them was rejected. Only the first time is recorded.
+	if _, ok := m.addedTimes.Get(txID); !ok {
+		m.addedTimes.Put(txID, time.Now())
+	}
+
 	return nil
 }
 
@@ -189,6 +266,7 @@ func (m *mempool) Remove(txs ...*txs.Tx) {
 		// If the transaction is in the mempool, remove it.
 		if _, ok := m.consumedUTXOs.DeleteKey(txID); ok {
 			m.unissuedTxs.Delete(txID)
+			m.byFeeRate.Remove(txID)
 			m.bytesAvailable += len(tx.Bytes())
 			continue
 		}
@@ -198,11 +276,102 @@ func (m *mempool) Remove(txs ...*txs.Tx) {
 		for _, removed := range m.consumedUTXOs.DeleteOverlapping(inputs) {
 			tx, _ := m.unissuedTxs.Get(removed.Key)
 			m.unissuedTxs.Delete(removed.Key)
+			m.byFeeRate.Remove(removed.Key)
 			m.bytesAvailable += len(tx.Bytes())
 		}
 	}
-	m.bytesAvailableMetric.Set(float64(m.bytesAvailable))
+	m.updateMetrics()
+}
+
+// replacedTxIDs returns the txs in the mempool that conflict with the tx
+// [txID] consuming [inputs] and paying [fee]. The tx can only replace them if
+// [fee] is strictly higher than their combined fee, otherwise
+// [ErrConflictsWithOtherTx] is returned.
+//
+// Assumes the lock is held.
+func (m *mempool) replacedTxIDs(txID ids.ID, inputs set.Set[ids.ID], fee uint64) (set.Set[ids.ID], error) {
+	var (
+		replacedTxIDs set.Set[ids.ID]
+		replacedFee   uint64
+	)
+	for utxoID := range inputs {
+		conflictTxID, ok := m.consumedUTXOs.GetKey(utxoID)
+		if !ok || replacedTxIDs.Contains(conflictTxID) {
+			continue
+		}
+
+		conflictRate, _ := m.byFeeRate.Get(conflictTxID)
+		newReplacedFee, err := math.Add64(replacedFee, conflictRate.fee)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrConflictsWithOtherTx, txID)
+		}
+		replacedTxIDs.Add(conflictTxID)
+		replacedFee = newReplacedFee
+	}
+	if replacedTxIDs.Len() > 0 && fee <= replacedFee {
+		return nil, fmt.Errorf("%w: %s pays %d but the txs it conflicts with pay %d",
+			ErrConflictsWithOtherTx,
+			txID,
+			fee,
+			replacedFee,
+		)
+	}
+	return replacedTxIDs, nil
+}
+
+// evict removes the txs paying the lowest fee per byte, oldest first, until
+// enough bytes are available for the tx [txID] paying [rate]. Only txs paying a
+// lower fee per byte than [txID] are evicted. [bytesAvailable] is the space
+// available before any tx is evicted. If not enough space can be made, nothing
+// is evicted and [ErrMempoolFull] is returned.
+//
+// Assumes the lock is held.
+func (m *mempool) evict(txID ids.ID, rate feeRate, bytesAvailable int) error {
+	type candidate struct {
+		txID ids.ID
+		rate feeRate
+	}
+
+	var candidates []candidate
+	for uint64(bytesAvailable) < rate.size {
+		candidateTxID, candidateRate, ok := m.byFeeRate.Peek()
+		if !ok || compareFeeRate(candidateRate, rate) >= 0 {
+			break
+		}
+
+		m.byFeeRate.Pop()
+		candidates = append(candidates, candidate{
+			txID: candidateTxID,
+			rate: candidateRate,
+		})
+		bytesAvailable += int(candidateRate.size)
+	}
+	if uint64(bytesAvailable) < rate.size {
+		for _, c := range candidates {
+			m.byFeeRate.Push(c.txID, c.rate)
+		}
+		return fmt.Errorf("%w: %s size (%d) > available space (%d)",
+			ErrMempoolFull,
+			txID,
+			rate.size,
+			bytesAvailable,
+		)
+	}
+
+	for _, evicted := range candidates {
+		m.unissuedTxs.Delete(evicted.txID)
+		m.consumedUTXOs.DeleteKey(evicted.txID)
+		m.bytesAvailable += int(evicted.rate.size)
+		m.droppedTxIDs.Put(evicted.txID, fmt.Errorf("%w: evicted by %s", ErrMempoolFull, txID))
+	}
+	return nil
+}
+
+// Assumes the lock is held.
+func (m *mempool) updateMetrics() {
 	m.numTxs.Set(float64(m.unissuedTxs.Len()))
+	m.bytesMetric.Set(float64(m.maxSize - m.bytesAvailable))
+	m.bytesAvailableMetric.Set(float64(m.bytesAvailable))
 }
 
 func (m *mempool) Peek() (*txs.Tx, bool) {
@@ -245,6 +414,10 @@ func (m *mempool) GetDropReason(txID ids.ID) error {
 	return err
 }
 
+func (m *mempool) GetAddedTime(txID ids.ID) (time.Time, bool) {
+	return m.addedTimes.Get(txID)
+}
+
 func (m *mempool) RequestBuildBlock(emptyBlockPermitted bool) {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
@@ -265,3 +438,31 @@ func (m *mempool) Len() int {
 
 	return m.unissuedTxs.Len()
 }
+
+// feeRate is the fee a tx pays per byte, expressed as the fee and the size of
+// the tx so that it can be compared without rounding.
+type feeRate struct {
+	fee  uint64
+	size uint64
+	// age is the order in which the tx was added to the mempool.
+	age int
+}
+
+// lessFeeRate orders txs by the fee they pay per byte, lowest first. Txs paying
+// the same fee per byte are ordered by age, oldest first.
+func lessFeeRate(a, b feeRate) bool {
+	if c := compareFeeRate(a, b); c != 0 {
+		return c < 0
+	}
+	return a.age < b.age
+}
+
+// compareFeeRate compares a.fee/a.size with b.fee/b.size.
+func compareFeeRate(a, b feeRate) int {
+	aHi, aLo := bits.Mul64(a.fee, b.size)
+	bHi, bLo := bits.Mul64(b.fee, a.size)
+	if c := cmp.Compare(aHi, bHi); c != 0 {
+		return c
+	}
+	return cmp.Compare(aLo, bLo)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Juneo-io/juneogo/ids"
@@ -19,7 +20,10 @@ import (
 	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
 )
 
-var preFundedKeys = secp256k1.TestKeys()
+var (
+	preFundedKeys = secp256k1.TestKeys()
+	testAssetID   = ids.ID{'a', 's', 's', 'e', 'r', 't'}
+)
 
 // shows that valid tx is not added to mempool if this would exceed its maximum
 // size
@@ -27,7 +31,7 @@ func TestBlockBuilderMaxMempoolSizeHandling(t *testing.T) {
 	require := require.New(t)
 
 	registerer := prometheus.NewRegistry()
-	mpool, err := New("mempool", registerer, nil)
+	mpool, err := New("mempool", registerer, nil, DefaultMaxSize, testAssetID)
 	require.NoError(err)
 
 	decisionTxs, err := createTestDecisionTxs(1)
@@ -52,11 +56,142 @@ func TestBlockBuilderMaxMempoolSizeHandling(t *testing.T) {
 	require.NoError(err, "should have added tx to mempool")
 }
 
+// shows that txs paying lower fees are evicted when the mempool is full
+func TestMempoolEviction(t *testing.T) {
+	require := require.New(t)
+
+	decisionTxs, err := createTestDecisionTxs(3)
+	require.NoError(err)
+	setDecisionTxFee(require, decisionTxs[0], 10)
+	setDecisionTxFee(require, decisionTxs[1], 5)
+
+	// All the test txs have the same size
+	txSize := len(decisionTxs[0].Bytes())
+
+	registerer := prometheus.NewRegistry()
+	mpool, err := New("mempool", registerer, nil, 2*txSize, testAssetID)
+	require.NoError(err)
+
+	require.NoError(mpool.Add(decisionTxs[0]))
+	require.NoError(mpool.Add(decisionTxs[1]))
+	require.Equal(float64(2*txSize), testutil.ToFloat64(mpool.(*mempool).bytesMetric))
+
+	// Txs paying the same fee aren't evicted
+	newTx := decisionTxs[2]
+	setDecisionTxFee(require, newTx, 5)
+	err = mpool.Add(newTx)
+	require.ErrorIs(err, ErrMempoolFull)
+	require.Equal(2, mpool.Len())
+
+	// The tx paying the lowest fee is evicted
+	setDecisionTxFee(require, newTx, 20)
+	require.NoError(mpool.Add(newTx))
+	require.Equal(2, mpool.Len())
+
+	_, ok := mpool.Get(decisionTxs[0].ID())
+	require.True(ok)
+	_, ok = mpool.Get(decisionTxs[1].ID())
+	require.False(ok)
+	_, ok = mpool.Get(newTx.ID())
+	require.True(ok)
+	require.ErrorIs(mpool.GetDropReason(decisionTxs[1].ID()), ErrMempoolFull)
+	require.Equal(float64(2*txSize), testutil.ToFloat64(mpool.(*mempool).bytesMetric))
+	require.Zero(testutil.ToFloat64(mpool.(*mempool).bytesAvailableMetric))
+
+	// The UTXOs consumed by the evicted tx are released
+	mpool.Remove(newTx)
+	require.NoError(mpool.Add(decisionTxs[1]))
+}
+
+// shows that txs are evicted by the fee they pay per byte, not by their fee
+func TestMempoolEvictionByFeeRate(t *testing.T) {
+	require := require.New(t)
+
+	decisionTxs, err := createTestDecisionTxs(3)
+	require.NoError(err)
+	setDecisionTxFee(require, decisionTxs[0], 10)
+	setDecisionTxFee(require, decisionTxs[1], 10)
+	txSize := len(decisionTxs[0].Bytes())
+
+	// The new tx is about twice as large as the others
+	newTx := decisionTxs[2]
+	newTx.Unsigned.(*txs.CreateChainTx).GenesisData = make([]byte, txSize)
+	setDecisionTxFee(require, newTx, 15)
+	require.LessOrEqual(len(newTx.Bytes()), 2*txSize)
+
+	registerer := prometheus.NewRegistry()
+	mpool, err := New("mempool", registerer, nil, 2*txSize, testAssetID)
+	require.NoError(err)
+
+	require.NoError(mpool.Add(decisionTxs[0]))
+	require.NoError(mpool.Add(decisionTxs[1]))
+
+	// A higher fee doesn't evict txs paying more per byte
+	err = mpool.Add(newTx)
+	require.ErrorIs(err, ErrMempoolFull)
+	require.Equal(2, mpool.Len())
+	require.Equal(float64(2*txSize), testutil.ToFloat64(mpool.(*mempool).bytesMetric))
+
+	setDecisionTxFee(require, newTx, 30)
+	require.NoError(mpool.Add(newTx))
+	require.Equal(1, mpool.Len())
+	require.ErrorIs(mpool.GetDropReason(decisionTxs[0].ID()), ErrMempoolFull)
+	require.ErrorIs(mpool.GetDropReason(decisionTxs[1].ID()), ErrMempoolFull)
+}
+
+// shows that a conflicting tx replaces the txs it conflicts with only if it
+// pays a strictly higher fee than all of them combined
+func TestMempoolReplacement(t *testing.T) {
+	require := require.New(t)
+
+	decisionTxs, err := createTestDecisionTxs(3)
+	require.NoError(err)
+	setDecisionTxFee(require, decisionTxs[0], 10)
+	setDecisionTxFee(require, decisionTxs[1], 10)
+
+	// The replacing tx consumes the inputs of both other txs
+	replacingTx := decisionTxs[2]
+	replacingUTX := replacingTx.Unsigned.(*txs.CreateChainTx)
+	secondIn := *decisionTxs[1].Unsigned.(*txs.CreateChainTx).Ins[0]
+	secondIn.In = &secp256k1fx.TransferInput{
+		Input: secp256k1fx.Input{SigIndices: []uint32{1}},
+	}
+	replacingUTX.Ins[0].UTXOID = decisionTxs[0].Unsigned.(*txs.CreateChainTx).Ins[0].UTXOID
+	replacingUTX.Ins = append(replacingUTX.Ins, &secondIn)
+
+	registerer := prometheus.NewRegistry()
+	mpool, err := New("mempool", registerer, nil, DefaultMaxSize, testAssetID)
+	require.NoError(err)
+
+	require.NoError(mpool.Add(decisionTxs[0]))
+	require.NoError(mpool.Add(decisionTxs[1]))
+
+	// The replacing tx pays more than each tx but not more than both
+	setDecisionTxFee(require, replacingTx, 20)
+	err = mpool.Add(replacingTx)
+	require.ErrorIs(err, ErrConflictsWithOtherTx)
+	require.Equal(2, mpool.Len())
+
+	setDecisionTxFee(require, replacingTx, 21)
+	require.NoError(mpool.Add(replacingTx))
+	require.Equal(1, mpool.Len())
+	_, ok := mpool.Get(replacingTx.ID())
+	require.True(ok)
+	require.ErrorIs(mpool.GetDropReason(decisionTxs[0].ID()), ErrReplaced)
+	require.ErrorIs(mpool.GetDropReason(decisionTxs[1].ID()), ErrReplaced)
+	require.Equal(float64(len(replacingTx.Bytes())), testutil.ToFloat64(mpool.(*mempool).bytesMetric))
+
+	// The replaced txs can't replace the tx paying a higher fee
+	err = mpool.Add(decisionTxs[0])
+	require.ErrorIs(err, ErrConflictsWithOtherTx)
+	require.Equal(1, mpool.Len())
+}
+
 func TestDecisionTxsInMempool(t *testing.T) {
 	require := require.New(t)
 
 	registerer := prometheus.NewRegistry()
-	mpool, err := New("mempool", registerer, nil)
+	mpool, err := New("mempool", registerer, nil, DefaultMaxSize, testAssetID)
 	require.NoError(err)
 
 	decisionTxs, err := createTestDecisionTxs(2)
@@ -90,7 +225,7 @@ func TestProposalTxsInMempool(t *testing.T) {
 	require := require.New(t)
 
 	registerer := prometheus.NewRegistry()
-	mpool, err := New("mempool", registerer, nil)
+	mpool, err := New("mempool", registerer, nil, DefaultMaxSize, testAssetID)
 	require.NoError(err)
 
 	// The proposal txs are ordered by decreasing start time. This means after
@@ -134,14 +269,14 @@ func createTestDecisionTxs(count int) ([]*txs.Tx, error) {
 						TxID:        ids.ID{'t', 'x', 'I', 'D'},
 						OutputIndex: i,
 					},
-					Asset: avax.Asset{ID: ids.ID{'a', 's', 's', 'e', 'r', 't'}},
+					Asset: avax.Asset{ID: testAssetID},
 					In: &secp256k1fx.TransferInput{
 						Amt:   uint64(5678),
 						Input: secp256k1fx.Input{SigIndices: []uint32{i}},
 					},
 				}},
 				Outs: []*avax.TransferableOutput{{
-					Asset: avax.Asset{ID: ids.ID{'a', 's', 's', 'e', 'r', 't'}},
+					Asset: avax.Asset{ID: testAssetID},
 					Out: &secp256k1fx.TransferOutput{
 						Amt: uint64(1234),
 						OutputOwners: secp256k1fx.OutputOwners{
@@ -168,6 +303,13 @@ func createTestDecisionTxs(count int) ([]*txs.Tx, error) {
 	return decisionTxs, nil
 }
 
+// setDecisionTxFee makes [tx], created by [createTestDecisionTxs], pay [fee].
+func setDecisionTxFee(require *require.Assertions, tx *txs.Tx, fee uint64) {
+	utx := tx.Unsigned.(*txs.CreateChainTx)
+	utx.Ins[0].In.(*secp256k1fx.TransferInput).Amt = utx.Outs[0].Out.Amount() + fee
+	require.NoError(tx.Initialize(txs.Codec))
+}
+
 // Proposal txs are sorted by decreasing start time
 func createTestProposalTxs(count int) ([]*txs.Tx, error) {
 	now := time.Now()
@@ -206,7 +348,7 @@ func TestPeekTxs(t *testing.T) {
 
 	registerer := prometheus.NewRegistry()
 	toEngine := make(chan common.Message, 100)
-	mempool, err := New("mempool", registerer, toEngine)
+	mempool, err := New("mempool", registerer, toEngine, DefaultMaxSize, testAssetID)
 	require.NoError(err)
 
 	testDecisionTxs, err := createTestDecisionTxs(1)
@@ -245,7 +387,7 @@ func TestRemoveConflicts(t *testing.T) {
 
 	registerer := prometheus.NewRegistry()
 	toEngine := make(chan common.Message, 100)
-	mempool, err := New("mempool", registerer, toEngine)
+	mempool, err := New("mempool", registerer, toEngine, DefaultMaxSize, testAssetID)
 	require.NoError(err)
 
 	txs, err := createTestDecisionTxs(1)
@@ -270,7 +412,7 @@ func TestIterate(t *testing.T) {
 
 	registerer := prometheus.NewRegistry()
 	toEngine := make(chan common.Message, 100)
-	mempool, err := New("mempool", registerer, toEngine)
+	mempool, err := New("mempool", registerer, toEngine, DefaultMaxSize, testAssetID)
 	require.NoError(err)
 
 	testDecisionTxs, err := createTestDecisionTxs(1)
@@ -11,6 +11,7 @@ package mempool
 
 import (
 	reflect "reflect"
+	time "time"
 
 	ids "github.com/Juneo-io/juneogo/ids"
 	txs "github.com/Juneo-io/juneogo/vms/platformvm/txs"
@@ -69,6 +70,21 @@ func (mr *MockMempoolMockRecorder) Get(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMempool)(nil).Get), arg0)
 }
 
+// GetAddedTime mocks base method.
+func (m *MockMempool) GetAddedTime(arg0 ids.ID) (time.Time, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddedTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetAddedTime indicates an expected call of GetAddedTime.
+func (mr *MockMempoolMockRecorder) GetAddedTime(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddedTime", reflect.TypeOf((*MockMempool)(nil).GetAddedTime), arg0)
+}
+
 // GetDropReason mocks base method.
 func (m *MockMempool) GetDropReason(arg0 ids.ID) error {
 	m.ctrl.T.Helper()
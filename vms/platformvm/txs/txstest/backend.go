@@ -79,3 +79,11 @@ func (b *Backend) GetUTXO(_ context.Context, chainID, utxoID ids.ID) (*avax.UTXO
 func (b *Backend) GetSupernetOwner(_ context.Context, supernetID ids.ID) (fx.Owner, error) {
 	return b.state.GetSupernetOwner(supernetID)
 }
+
+func (b *Backend) GetSupernetStakingAsset(_ context.Context, supernetID ids.ID) (ids.ID, error) {
+	tx, err := b.state.GetSupernetTransformation(supernetID)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return tx.Unsigned.(*txs.TransformSupernetTx).AssetID, nil
+}
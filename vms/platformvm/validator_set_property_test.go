@@ -177,6 +177,17 @@ func TestGetValidatorsSetProperty(t *testing.T) {
 				// within [snapShotHeight] and [nextSnapShotHeight], the validator set
 				// does not change and must be equal to snapshot at [snapShotHeight]
 				for height := snapShotHeight; height < nextSnapShotHeight; height++ {
+					// Generate all the validator sets at once before they are
+					// cached by GetValidatorSet
+					expectedValidatorSets := validatorSetByHeightAndSupernet[snapShotHeight]
+					res, err := vm.GetValidatorSets(context.Background(), height, maps.Keys(expectedValidatorSets))
+					if err != nil {
+						return fmt.Sprintf("failed GetValidatorSets at height %v: %v", height, err)
+					}
+					if !reflect.DeepEqual(expectedValidatorSets, res) {
+						return "failed validators sets comparison"
+					}
+
 					for supernetID, validatorsSet := range validatorSetByHeightAndSupernet[snapShotHeight] {
 						res, err := vm.GetValidatorSet(context.Background(), height, supernetID)
 						if err != nil {
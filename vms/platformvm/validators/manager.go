@@ -14,6 +14,7 @@ import (
 	"github.com/Juneo-io/juneogo/snow/validators"
 	"github.com/Juneo-io/juneogo/utils/constants"
 	"github.com/Juneo-io/juneogo/utils/logging"
+	"github.com/Juneo-io/juneogo/utils/set"
 	"github.com/Juneo-io/juneogo/utils/timer/mockable"
 	"github.com/Juneo-io/juneogo/utils/window"
 	"github.com/Juneo-io/juneogo/vms/platformvm/block"
@@ -24,7 +25,6 @@ import (
 )
 
 const (
-	validatorSetsCacheSize        = 64
 	maxRecentlyAcceptedWindowSize = 64
 	minRecentlyAcceptedWindowSize = 16
 	recentlyAcceptedWindowTTL     = 2 * time.Minute
@@ -41,6 +41,14 @@ var (
 type Manager interface {
 	validators.State
 
+	// GetValidatorSets returns the validator sets of [supernetIDs] at
+	// [targetHeight], keyed by supernet ID.
+	GetValidatorSets(
+		ctx context.Context,
+		targetHeight uint64,
+		supernetIDs []ids.ID,
+	) (map[ids.ID]map[ids.NodeID]*validators.GetValidatorOutput, error)
+
 	// OnAcceptedBlockID registers the ID of the latest accepted block.
 	// It is used to update the [recentlyAccepted] sliding window.
 	OnAcceptedBlockID(blkID ids.ID)
@@ -88,20 +96,24 @@ type State interface {
 	) error
 }
 
+// NewManager returns a validators.State that caches up to
+// [validatorSetsCacheSize] validator sets per tracked supernet.
 func NewManager(
 	log logging.Logger,
 	cfg config.Config,
 	state State,
 	metrics metrics.Metrics,
 	clk *mockable.Clock,
+	validatorSetsCacheSize int,
 ) Manager {
 	return &manager{
-		log:     log,
-		cfg:     cfg,
-		state:   state,
-		metrics: metrics,
-		clk:     clk,
-		caches:  make(map[ids.ID]cache.Cacher[uint64, map[ids.NodeID]*validators.GetValidatorOutput]),
+		log:                    log,
+		cfg:                    cfg,
+		state:                  state,
+		metrics:                metrics,
+		clk:                    clk,
+		validatorSetsCacheSize: validatorSetsCacheSize,
+		caches:                 make(map[ids.ID]cache.Cacher[uint64, map[ids.NodeID]*validators.GetValidatorOutput]),
 		recentlyAccepted: window.New[ids.ID](
 			window.Config{
 				Clock:   clk,
@@ -122,9 +134,15 @@ type manager struct {
 	metrics metrics.Metrics
 	clk     *mockable.Clock
 
+	// Max number of validator sets cached for each tracked supernet
+	validatorSetsCacheSize int
+
 	// Maps caches for each supernet that is currently tracked.
 	// Key: Supernet ID
 	// Value: cache mapping height -> validator set map
+	//
+	// Validator sets are only generated for accepted heights, which can't be
+	// reverted, so cached validator sets never need to be invalidated.
 	caches map[ids.ID]cache.Cacher[uint64, map[ids.NodeID]*validators.GetValidatorOutput]
 
 	// sliding window of blocks that were recently accepted
@@ -224,6 +242,115 @@ func (m *manager) GetValidatorSet(
 	return validatorSet, nil
 }
 
+// GetValidatorSets generates the validator sets of [supernetIDs] that aren't
+// cached together. The weight diffs are indexed by supernet, so they are
+// applied to each validator set separately, but the public key diffs are
+// shared by every supernet and are only iterated once.
+func (m *manager) GetValidatorSets(
+	ctx context.Context,
+	targetHeight uint64,
+	supernetIDs []ids.ID,
+) (map[ids.ID]map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	var (
+		validatorSets      = make(map[ids.ID]map[ids.NodeID]*validators.GetValidatorOutput, len(supernetIDs))
+		missingSupernetIDs = set.NewSet[ids.ID](len(supernetIDs))
+	)
+	for _, supernetID := range supernetIDs {
+		if validatorSet, ok := m.getValidatorSetCache(supernetID).Get(targetHeight); ok {
+			m.metrics.IncValidatorSetsCached()
+			validatorSets[supernetID] = validatorSet
+			continue
+		}
+		missingSupernetIDs.Add(supernetID)
+	}
+	if missingSupernetIDs.Len() == 0 {
+		return validatorSets, nil
+	}
+
+	// get the start time to track metrics
+	startTime := m.clk.Time()
+
+	currentHeight, err := m.getCurrentHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if currentHeight < targetHeight {
+		return nil, fmt.Errorf("%w: current P-chain height (%d) < requested P-Chain height (%d)",
+			errUnfinalizedHeight,
+			currentHeight,
+			targetHeight,
+		)
+	}
+
+	// Rebuild the validator sets at [targetHeight]
+	//
+	// Note: Since we are attempting to generate the validator sets at
+	// [targetHeight], we want to apply the diffs from
+	// (targetHeight, currentHeight]. Because the state interface is implemented
+	// to be inclusive, we apply diffs in [targetHeight + 1, currentHeight].
+	var (
+		lastDiffHeight      = targetHeight + 1
+		primaryValidatorSet = m.cfg.Validators.GetMap(constants.PrimaryNetworkID)
+		publicKeys          = make(map[ids.NodeID]*validators.GetValidatorOutput)
+	)
+	for supernetID := range missingSupernetIDs {
+		validatorSet := m.cfg.Validators.GetMap(supernetID)
+		err := m.state.ApplyValidatorWeightDiffs(
+			ctx,
+			validatorSet,
+			currentHeight,
+			lastDiffHeight,
+			supernetID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		validatorSets[supernetID] = validatorSet
+
+		// Track the public keys at [currentHeight] of every validator. If the
+		// validator is not currently a primary network validator, it doesn't
+		// have a key at [currentHeight].
+		for nodeID := range validatorSet {
+			if _, ok := publicKeys[nodeID]; ok {
+				continue
+			}
+			publicKey := &validators.GetValidatorOutput{
+				NodeID: nodeID,
+			}
+			if primaryVdr, ok := primaryValidatorSet[nodeID]; ok {
+				publicKey.PublicKey = primaryVdr.PublicKey
+			}
+			publicKeys[nodeID] = publicKey
+		}
+	}
+
+	err = m.state.ApplyValidatorPublicKeyDiffs(
+		ctx,
+		publicKeys,
+		currentHeight,
+		lastDiffHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for supernetID := range missingSupernetIDs {
+		validatorSet := validatorSets[supernetID]
+		for nodeID, vdr := range validatorSet {
+			vdr.PublicKey = publicKeys[nodeID].PublicKey
+		}
+
+		// cache the validator set
+		m.getValidatorSetCache(supernetID).Put(targetHeight, validatorSet)
+		m.metrics.IncValidatorSetsCreated()
+		m.metrics.AddValidatorSetsHeightDiff(currentHeight - targetHeight)
+	}
+
+	duration := m.clk.Time().Sub(startTime)
+	m.metrics.AddValidatorSetsDuration(duration)
+	return validatorSets, nil
+}
+
 func (m *manager) getValidatorSetCache(supernetID ids.ID) cache.Cacher[uint64, map[ids.NodeID]*validators.GetValidatorOutput] {
 	// Only cache tracked supernets
 	if supernetID != constants.PrimaryNetworkID && !m.cfg.TrackedSupernets.Contains(supernetID) {
@@ -236,7 +363,7 @@ func (m *manager) getValidatorSetCache(supernetID ids.ID) cache.Cacher[uint64, m
 	}
 
 	validatorSetsCache = &cache.LRU[uint64, map[ids.NodeID]*validators.GetValidatorOutput]{
-		Size: validatorSetsCacheSize,
+		Size: m.validatorSetsCacheSize,
 	}
 	m.caches[supernetID] = validatorSetsCache
 	return validatorSetsCache
@@ -141,6 +141,7 @@ func BenchmarkGetValidatorSet(b *testing.B) {
 		s,
 		metrics,
 		new(mockable.Clock),
+		config.DefaultExecutionConfig.ValidatorSetsCacheSize,
 	)
 
 	var (
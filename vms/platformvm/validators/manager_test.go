@@ -0,0 +1,46 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/cache"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/snow/validators"
+	"github.com/Juneo-io/juneogo/utils/constants"
+	"github.com/Juneo-io/juneogo/utils/logging"
+	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/utils/timer/mockable"
+	"github.com/Juneo-io/juneogo/vms/platformvm/config"
+)
+
+func TestManagerValidatorSetsCacheSize(t *testing.T) {
+	require := require.New(t)
+
+	const validatorSetsCacheSize = 10
+	trackedSupernetID := ids.GenerateTestID()
+	m := NewManager(
+		logging.NoLog{},
+		config.Config{
+			TrackedSupernets: set.Of(trackedSupernetID),
+		},
+		nil,
+		nil,
+		&mockable.Clock{},
+		validatorSetsCacheSize,
+	).(*manager)
+
+	for _, supernetID := range []ids.ID{constants.PrimaryNetworkID, trackedSupernetID} {
+		validatorSetCache := m.getValidatorSetCache(supernetID)
+		require.IsType(&cache.LRU[uint64, map[ids.NodeID]*validators.GetValidatorOutput]{}, validatorSetCache)
+		lru := validatorSetCache.(*cache.LRU[uint64, map[ids.NodeID]*validators.GetValidatorOutput])
+		require.Equal(validatorSetsCacheSize, lru.Size)
+	}
+
+	// Untracked supernets aren't cached
+	require.IsType(&cache.Empty[uint64, map[ids.NodeID]*validators.GetValidatorOutput]{}, m.getValidatorSetCache(ids.GenerateTestID()))
+}
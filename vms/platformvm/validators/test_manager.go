@@ -30,4 +30,8 @@ func (testManager) GetValidatorSet(context.Context, uint64, ids.ID) (map[ids.Nod
 	return nil, nil
 }
 
+func (testManager) GetValidatorSets(context.Context, uint64, []ids.ID) (map[ids.ID]map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	return nil, nil
+}
+
 func (testManager) OnAcceptedBlockID(ids.ID) {}
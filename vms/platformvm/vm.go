@@ -69,7 +69,8 @@ type VM struct {
 	// Used to get time. Useful for faking time during tests.
 	clock mockable.Clock
 
-	uptimeManager uptime.Manager
+	uptimeManager    uptime.Manager
+	validatorManager pvalidators.Manager
 
 	// The context of this vm
 	ctx *snow.Context
@@ -149,8 +150,9 @@ func (vm *VM) Initialize(
 		return err
 	}
 
-	validatorManager := pvalidators.NewManager(chainCtx.Log, vm.Config, vm.state, vm.metrics, &vm.clock)
+	validatorManager := pvalidators.NewManager(chainCtx.Log, vm.Config, vm.state, vm.metrics, &vm.clock, execConfig.ValidatorSetsCacheSize)
 	vm.State = validatorManager
+	vm.validatorManager = validatorManager
 	utxoVerifier := utxo.NewVerifier(vm.ctx, &vm.clock, vm.fx)
 	vm.uptimeManager = uptime.NewManager(vm.state, &vm.clock)
 	vm.UptimeLockedCalculator.SetCalculator(&vm.bootstrapped, &chainCtx.Lock, vm.uptimeManager)
@@ -166,7 +168,11 @@ func (vm *VM) Initialize(
 		Bootstrapped: &vm.bootstrapped,
 	}
 
-	mempool, err := mempool.New("mempool", registerer, toEngine)
+	mempoolMaxSize := vm.MempoolMaxSize
+	if mempoolMaxSize == 0 {
+		mempoolMaxSize = mempool.DefaultMaxSize
+	}
+	mempool, err := mempool.New("mempool", registerer, toEngine, mempoolMaxSize, vm.ctx.JUNEAssetID)
 	if err != nil {
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
@@ -240,6 +246,15 @@ func (vm *VM) Initialize(
 		}
 	}()
 
+	go func() {
+		err := vm.state.IndexTxHeights(&vm.ctx.Lock, vm.ctx.Log)
+		if err != nil {
+			vm.ctx.Log.Warn("indexing tx heights failed",
+				zap.Error(err),
+			)
+		}
+	}()
+
 	return nil
 }
 
@@ -458,17 +473,40 @@ func (vm *VM) CreateHandlers(context.Context) (map[string]http.Handler, error) {
 	server.RegisterCodec(json.NewCodec(), "application/json;charset=UTF-8")
 	server.RegisterInterceptFunc(vm.metrics.InterceptRequest)
 	server.RegisterAfterFunc(vm.metrics.AfterRequest)
+	addrManager := avax.NewAddressManager(vm.ctx)
+	balanceCache := newBalanceCache(vm.state)
 	service := &Service{
 		vm:          vm,
-		addrManager: avax.NewAddressManager(vm.ctx),
+		addrManager: addrManager,
 		stakerAttributesCache: &cache.LRU[ids.ID, *stakerAttributes]{
 			Size: stakerAttributesCacheSize,
 		},
+		balanceCache: balanceCache,
 	}
-	err := server.RegisterService(service, "platform")
-	return map[string]http.Handler{
+	if err := server.RegisterService(service, "platform"); err != nil {
+		return nil, err
+	}
+
+	handlers := map[string]http.Handler{
 		"": server,
-	}, err
+	}
+	if !vm.AdminAPIEnabled {
+		return handlers, nil
+	}
+
+	adminServer := rpc.NewServer()
+	adminServer.RegisterCodec(json.NewCodec(), "application/json")
+	adminServer.RegisterCodec(json.NewCodec(), "application/json;charset=UTF-8")
+	adminServer.RegisterInterceptFunc(vm.metrics.InterceptRequest)
+	adminServer.RegisterAfterFunc(vm.metrics.AfterRequest)
+	adminService := &AdminService{
+		vm:           vm,
+		addrManager:  addrManager,
+		balanceCache: balanceCache,
+	}
+	err := adminServer.RegisterService(adminService, "admin")
+	handlers["/admin"] = adminServer
+	return handlers, err
 }
 
 func (vm *VM) Connected(_ context.Context, nodeID ids.NodeID, _ *version.Application) error {
@@ -502,6 +540,17 @@ func (vm *VM) GetBlockIDAtHeight(_ context.Context, height uint64) (ids.ID, erro
 	return vm.state.GetBlockIDAtHeight(height)
 }
 
+// GetValidatorSets returns the validator sets of [supernetIDs] at [height],
+// keyed by supernet ID. It is equivalent to calling GetValidatorSet for each
+// supernet, but amortizes the cost of walking back the diffs from the last
+// accepted height.
+//
+// Invariant: [vm.ctx.Lock] is held, as when calling GetValidatorSet. Callers
+// that don't hold it should wrap the VM with [validators.NewLockedSetsState].
+func (vm *VM) GetValidatorSets(ctx context.Context, height uint64, supernetIDs []ids.ID) (map[ids.ID]map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	return vm.validatorManager.GetValidatorSets(ctx, height, supernetIDs)
+}
+
 func (vm *VM) issueTxFromRPC(tx *txs.Tx) error {
 	err := vm.Network.IssueTxFromRPC(tx)
 	if err != nil && !errors.Is(err, mempool.ErrDuplicateTx) {
@@ -2428,6 +2428,22 @@ func TestValidatorSetRaceCondition(t *testing.T) {
 		})
 	}
 
+	// keep a worker fetching several validator sets at once
+	validatorSets := validators.NewLockedSetsState(&vm.ctx.Lock, vm)
+	eg.Go(func() error {
+		for ctx.Err() == nil {
+			_, err := validatorSets.GetValidatorSets(
+				context.Background(),
+				0,
+				[]ids.ID{constants.PrimaryNetworkID},
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
 	// If the validator set lock isn't held, the race detector should fail here.
 	for i := uint64(0); i < 1000; i++ {
 		blk, err := block.NewBanffStandardBlock(
@@ -151,7 +151,7 @@ type mutableSharedMemory struct {
 // Returns:
 // 1) The genesis state
 // 2) The byte representation of the default genesis for tests
-func defaultGenesis(t *testing.T, juneAssetID ids.ID) (*api.BuildGenesisArgs, []byte) {
+func defaultGenesis(t testing.TB, juneAssetID ids.ID) (*api.BuildGenesisArgs, []byte) {
 	require := require.New(t)
 
 	genesisUTXOs := make([]api.UTXO, len(keys))
@@ -208,7 +208,18 @@ func defaultGenesis(t *testing.T, juneAssetID ids.ID) (*api.BuildGenesisArgs, []
 	return &buildGenesisArgs, genesisBytes
 }
 
+// defaultVM returns a VM initialized with the default genesis on which
+// [testSupernet1] has been created.
 func defaultVM(t *testing.T, f fork) (*VM, *txstest.Builder, database.Database, *mutableSharedMemory) {
+	vm, builder, db, msm := defaultFastVM(t, f)
+	createTestSupernet1(t, vm, builder)
+	return vm, builder, db, msm
+}
+
+// defaultFastVM returns a VM initialized with the default genesis, skipping the
+// creation of [testSupernet1]. Tests that need [testSupernet1] can create it
+// lazily with [createTestSupernet1].
+func defaultFastVM(t testing.TB, f fork) (*VM, *txstest.Builder, database.Database, *mutableSharedMemory) {
 	require := require.New(t)
 	var (
 		apricotPhase3Time = mockable.MaxTime
@@ -314,6 +325,24 @@ func defaultVM(t *testing.T, f fork) (*VM, *txstest.Builder, database.Database,
 		vm.state,
 	)
 
+	t.Cleanup(func() {
+		vm.ctx.Lock.Lock()
+		defer vm.ctx.Lock.Unlock()
+
+		require.NoError(vm.Shutdown(context.Background()))
+	})
+
+	return vm, builder, db, msm
+}
+
+// createTestSupernet1 creates a supernet on [vm] and stores it in
+// [testSupernet1].
+func createTestSupernet1(t testing.TB, vm *VM, builder *txstest.Builder) {
+	require := require.New(t)
+
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
 	// Create a supernet and store it in testSupernet1
 	// Note: following Banff activation, block acceptance will move
 	// chain time ahead
@@ -342,15 +371,20 @@ func defaultVM(t *testing.T, f fork) (*VM, *txstest.Builder, database.Database,
 	require.NoError(blk.Verify(context.Background()))
 	require.NoError(blk.Accept(context.Background()))
 	require.NoError(vm.SetPreference(context.Background(), vm.manager.LastAccepted()))
+}
 
-	t.Cleanup(func() {
-		vm.ctx.Lock.Lock()
-		defer vm.ctx.Lock.Unlock()
-
-		require.NoError(vm.Shutdown(context.Background()))
+func BenchmarkDefaultVM(b *testing.B) {
+	b.Run("default", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			vm, builder, _, _ := defaultFastVM(b, latestFork)
+			createTestSupernet1(b, vm, builder)
+		}
+	})
+	b.Run("fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			defaultFastVM(b, latestFork)
+		}
 	})
-
-	return vm, builder, db, msm
 }
 
 // Ensure genesis state is parsed from bytes and stored correctly
@@ -858,6 +892,83 @@ func TestRewardValidatorReject(t *testing.T) {
 	require.ErrorIs(err, database.ErrNotFound)
 }
 
+func TestSimulateStakeCycle(t *testing.T) {
+	tests := []struct {
+		name           string
+		uptime         float64
+		expectedReward bool
+	}{
+		{
+			name:           "sufficient uptime",
+			uptime:         1,
+			expectedReward: true,
+		},
+		{
+			name:           "insufficient uptime",
+			uptime:         .5,
+			expectedReward: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			vm, txBuilder, _, _ := defaultVM(t, latestFork)
+			vm.ctx.Lock.Lock()
+			defer vm.ctx.Lock.Unlock()
+
+			vm.UptimePercentage = .8
+
+			var (
+				chainTime     = vm.state.GetTimestamp()
+				endTime       = chainTime.Add(defaultMinStakingDuration)
+				nodeID        = ids.GenerateTestNodeID()
+				rewardAddress = ids.GenerateTestShortID()
+			)
+
+			sk, err := bls.NewSecretKey()
+			require.NoError(err)
+
+			tx, err := txBuilder.NewAddPermissionlessValidatorTx(
+				&txs.SupernetValidator{
+					Validator: txs.Validator{
+						NodeID: nodeID,
+						End:    uint64(endTime.Unix()),
+						Wght:   vm.MinValidatorStake,
+					},
+					Supernet: constants.PrimaryNetworkID,
+				},
+				signer.NewProofOfPossession(sk),
+				vm.ctx.JUNEAssetID,
+				&secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{rewardAddress},
+				},
+				&secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{rewardAddress},
+				},
+				vm.MinDelegationFee,
+				[]*secp256k1.PrivateKey{keys[0]},
+			)
+			require.NoError(err)
+
+			var expectedReward uint64
+			if test.expectedReward {
+				expectedReward = reward.NewCalculator(vm.RewardConfig).Calculate(
+					endTime.Sub(chainTime),
+					chainTime,
+					vm.MinValidatorStake,
+				)
+				require.Positive(expectedReward)
+			}
+
+			rewarded, err := SimulateStakeCycle(vm, tx, test.uptime)
+			require.NoError(err)
+			require.Equal(expectedReward, rewarded)
+		})
+	}
+}
+
 // Ensure BuildBlock errors when there is no block to build
 func TestUnneededBuildBlock(t *testing.T) {
 	require := require.New(t)
@@ -4,6 +4,7 @@
 package secp256k1fx
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"github.com/Juneo-io/juneogo/utils/crypto/secp256k1"
 	"github.com/Juneo-io/juneogo/utils/formatting"
 	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/utils/wrappers"
 	"github.com/Juneo-io/juneogo/vms/components/verify"
 )
 
@@ -49,6 +51,24 @@ func NewKeychain(keys ...*secp256k1.PrivateKey) *Keychain {
 	return kc
 }
 
+// NewKeychainFromSeed returns a new keychain containing [numKeys] keys that
+// are deterministically derived from [seed]. The i-th key is derived from
+// [seed] followed by the big-endian encoding of i.
+func NewKeychainFromSeed(seed []byte, numKeys int) (*Keychain, error) {
+	kc := NewKeychain()
+	keySeed := make([]byte, len(seed)+wrappers.LongLen)
+	copy(keySeed, seed)
+	for i := 0; i < numKeys; i++ {
+		binary.BigEndian.PutUint64(keySeed[len(seed):], uint64(i))
+		sk, err := secp256k1.NewPrivateKeyFromSeed(keySeed)
+		if err != nil {
+			return nil, err
+		}
+		kc.Add(sk)
+	}
+	return kc, nil
+}
+
 // Add a new key to the key chain
 func (kc *Keychain) Add(key *secp256k1.PrivateKey) {
 	pk := key.PublicKey()
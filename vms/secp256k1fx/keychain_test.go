@@ -30,6 +30,25 @@ func TestNewKeychain(t *testing.T) {
 	require.NotNil(t, NewKeychain())
 }
 
+func TestNewKeychainFromSeed(t *testing.T) {
+	require := require.New(t)
+
+	kc0, err := NewKeychainFromSeed([]byte("seed"), 3)
+	require.NoError(err)
+	require.Len(kc0.Keys, 3)
+	require.Equal(3, kc0.Addrs.Len())
+
+	kc1, err := NewKeychainFromSeed([]byte("seed"), 3)
+	require.NoError(err)
+	require.Equal(kc0.Keys, kc1.Keys)
+
+	kc2, err := NewKeychainFromSeed([]byte("other seed"), 3)
+	require.NoError(err)
+	for _, addr := range kc0.Addrs.List() {
+		require.False(kc2.Addrs.Contains(addr))
+	}
+}
+
 func TestKeychainGetUnknownAddr(t *testing.T) {
 	require := require.New(t)
 	kc := NewKeychain()
@@ -5,6 +5,7 @@ package c
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 	"time"
 
@@ -167,15 +168,23 @@ func (w *wallet) IssueAtomicTx(
 		return w.Backend.AcceptAtomicTx(ctx, tx)
 	}
 
+	confirmCtx, cancel := ops.ConfirmContext()
+	defer cancel()
+
 	pollFrequency := ops.PollFrequency()
 	ticker := time.NewTicker(pollFrequency)
 	defer ticker.Stop()
 
+	lastStatus := evm.Unknown
 	for {
-		status, err := w.avaxClient.GetAtomicTxStatus(ctx, txID)
+		status, err := w.avaxClient.GetAtomicTxStatus(confirmCtx, txID)
+		if ops.ConfirmDeadlineExceeded(err) {
+			return fmt.Errorf("%w: last status %s", common.ErrConfirmDeadlineExceeded, lastStatus)
+		}
 		if err != nil {
 			return err
 		}
+		lastStatus = status
 
 		switch status {
 		case evm.Accepted:
@@ -188,8 +197,11 @@ func (w *wallet) IssueAtomicTx(
 
 		select {
 		case <-ticker.C:
-		case <-ctx.Done():
-			return ctx.Err()
+		case <-confirmCtx.Done():
+			if err := confirmCtx.Err(); ops.ConfirmDeadlineExceeded(err) {
+				return fmt.Errorf("%w: last status %s", common.ErrConfirmDeadlineExceeded, lastStatus)
+			}
+			return confirmCtx.Err()
 		}
 	}
 }
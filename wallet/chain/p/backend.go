@@ -26,6 +26,8 @@ type Backend interface {
 	builder.Backend
 	signer.Backend
 
+	AddUTXO(ctx context.Context, destinationChainID ids.ID, utxo *avax.UTXO) error
+	RemoveUTXO(ctx context.Context, sourceChainID, utxoID ids.ID) error
 	AcceptTx(ctx context.Context, tx *txs.Tx) error
 }
 
@@ -36,6 +38,9 @@ type backend struct {
 
 	supernetOwnerLock sync.RWMutex
 	supernetOwner     map[ids.ID]fx.Owner // supernetID -> owner
+
+	supernetStakingAssetLock sync.RWMutex
+	supernetStakingAsset     map[ids.ID]ids.ID // supernetID -> staked assetID
 }
 
 func NewBackend(context *builder.Context, utxos common.ChainUTXOs, supernetTxs map[ids.ID]*txs.Tx) Backend {
@@ -54,10 +59,19 @@ func NewBackend(context *builder.Context, utxos common.ChainUTXOs, supernetTxs m
 		}
 		supernetOwner[transferSupernetOwnershipTx.Supernet] = transferSupernetOwnershipTx.Owner
 	}
+	supernetStakingAsset := make(map[ids.ID]ids.ID)
+	for _, tx := range supernetTxs {
+		transformSupernetTx, ok := tx.Unsigned.(*txs.TransformSupernetTx)
+		if !ok {
+			continue
+		}
+		supernetStakingAsset[transformSupernetTx.Supernet] = transformSupernetTx.AssetID
+	}
 	return &backend{
 		ChainUTXOs:  utxos,
 		context:     context,
 		supernetOwner: supernetOwner,
+		supernetStakingAsset: supernetStakingAsset,
 	}
 }
 
@@ -111,3 +125,21 @@ func (b *backend) setSupernetOwner(supernetID ids.ID, owner fx.Owner) {
 
 	b.supernetOwner[supernetID] = owner
 }
+
+func (b *backend) GetSupernetStakingAsset(_ context.Context, supernetID ids.ID) (ids.ID, error) {
+	b.supernetStakingAssetLock.RLock()
+	defer b.supernetStakingAssetLock.RUnlock()
+
+	assetID, exists := b.supernetStakingAsset[supernetID]
+	if !exists {
+		return ids.Empty, database.ErrNotFound
+	}
+	return assetID, nil
+}
+
+func (b *backend) setSupernetStakingAsset(supernetID ids.ID, assetID ids.ID) {
+	b.supernetStakingAssetLock.Lock()
+	defer b.supernetStakingAssetLock.Unlock()
+
+	b.supernetStakingAsset[supernetID] = assetID
+}
@@ -108,6 +108,10 @@ func (b *backendVisitor) ExportTx(tx *txs.ExportTx) error {
 }
 
 func (b *backendVisitor) TransformSupernetTx(tx *txs.TransformSupernetTx) error {
+	b.b.setSupernetStakingAsset(
+		tx.Supernet,
+		tx.AssetID,
+	)
 	return b.baseTx(&tx.BaseTx)
 }
 
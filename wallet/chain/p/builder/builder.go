@@ -7,8 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"time"
 
+	"github.com/Juneo-io/juneogo/database"
 	"github.com/Juneo-io/juneogo/ids"
 	"github.com/Juneo-io/juneogo/utils"
 	"github.com/Juneo-io/juneogo/utils/constants"
@@ -24,11 +26,12 @@ import (
 )
 
 var (
-	ErrNoChangeAddress           = errors.New("no possible change address")
+	ErrNoChangeAddress           = common.ErrNoChangeAddress
 	ErrUnknownOutputType         = errors.New("unknown output type")
 	ErrUnknownOwnerType          = errors.New("unknown owner type")
 	ErrInsufficientAuthorization = errors.New("insufficient authorization")
-	ErrInsufficientFunds         = errors.New("insufficient funds")
+	ErrInsufficientFunds         = common.ErrInsufficientFunds
+	ErrInvalidStakingAsset       = errors.New("invalid staking asset")
 
 	_ Builder = (*builder)(nil)
 )
@@ -59,6 +62,8 @@ type Builder interface {
 	//
 	// - [outputs] specifies all the recipients and amounts that should be sent
 	//   from this transaction.
+	//
+	// The fee can be raised above the base tx fee with [common.WithFee].
 	NewBaseTx(
 		outputs []*avax.TransferableOutput,
 		options ...common.Option,
@@ -154,6 +159,10 @@ type Builder interface {
 	//
 	// - [chainID] specifies the chain to be importing funds from.
 	// - [to] specifies where to send the imported funds to.
+	//
+	// The fee is paid out of the imported JUNE when possible. If
+	// [common.WithFeeFromImportedFunds] is provided, the imported JUNE must
+	// exceed the fee so that no funds of the wallet are spent.
 	NewImportTx(
 		chainID ids.ID,
 		to *secp256k1fx.OutputOwners,
@@ -165,6 +174,8 @@ type Builder interface {
 	//
 	// - [chainID] specifies the chain to be exporting the funds to.
 	// - [outputs] specifies the outputs to send to the [chainID].
+	//
+	// The fee can be raised above the base tx fee with [common.WithFee].
 	NewExportTx(
 		chainID ids.ID,
 		outputs []*avax.TransferableOutput,
@@ -277,6 +288,10 @@ type Builder interface {
 type Backend interface {
 	UTXOs(ctx context.Context, sourceChainID ids.ID) ([]*avax.UTXO, error)
 	GetSupernetOwner(ctx context.Context, supernetID ids.ID) (fx.Owner, error)
+	// GetSupernetStakingAsset returns the asset staked on [supernetID], as set
+	// by its TransformSupernetTx. If the transformation of [supernetID] isn't
+	// known, [database.ErrNotFound] is returned.
+	GetSupernetStakingAsset(ctx context.Context, supernetID ids.ID) (ids.ID, error)
 }
 
 type builder struct {
@@ -326,8 +341,14 @@ func (b *builder) NewBaseTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.BaseTx, error) {
+	ops := common.NewOptions(options)
+	fee, err := ops.Fee(b.context.BaseTxFee)
+	if err != nil {
+		return nil, err
+	}
+
 	toBurn := map[ids.ID]uint64{
-		b.context.JUNEAssetID: b.context.BaseTxFee,
+		b.context.JUNEAssetID: fee,
 	}
 	for _, out := range outputs {
 		assetID := out.AssetID()
@@ -339,7 +360,6 @@ func (b *builder) NewBaseTx(
 	}
 	toStake := map[ids.ID]uint64{}
 
-	ops := common.NewOptions(options)
 	inputs, changeOutputs, _, err := b.spend(toBurn, toStake, ops)
 	if err != nil {
 		return nil, err
@@ -668,6 +688,14 @@ func (b *builder) NewImportTx(
 		outputs      = make([]*avax.TransferableOutput, 0, len(importedAmounts))
 		importedAVAX = importedAmounts[juneAssetID]
 	)
+	if ops.FeeFromImportedFunds() && importedAVAX <= txFee {
+		return nil, fmt.Errorf(
+			"%w: imported %d JUNE which doesn't exceed the import fee of %d",
+			ErrInsufficientFunds,
+			importedAVAX,
+			txFee,
+		)
+	}
 	if importedAVAX > txFee {
 		importedAmounts[juneAssetID] -= txFee
 	} else {
@@ -715,8 +743,14 @@ func (b *builder) NewExportTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.ExportTx, error) {
+	ops := common.NewOptions(options)
+	fee, err := ops.Fee(b.context.BaseTxFee)
+	if err != nil {
+		return nil, err
+	}
+
 	toBurn := map[ids.ID]uint64{
-		b.context.JUNEAssetID: b.context.BaseTxFee,
+		b.context.JUNEAssetID: fee,
 	}
 	for _, out := range outputs {
 		assetID := out.AssetID()
@@ -728,7 +762,6 @@ func (b *builder) NewExportTx(
 	}
 
 	toStake := map[ids.ID]uint64{}
-	ops := common.NewOptions(options)
 	inputs, changeOutputs, _, err := b.spend(toBurn, toStake, ops)
 	if err != nil {
 		return nil, err
@@ -828,6 +861,11 @@ func (b *builder) NewAddPermissionlessValidatorTx(
 	shares uint32,
 	options ...common.Option,
 ) (*txs.AddPermissionlessValidatorTx, error) {
+	ops := common.NewOptions(options)
+	if err := b.verifyStakingAsset(ops.Context(), vdr.Supernet, assetID); err != nil {
+		return nil, err
+	}
+
 	juneAssetID := b.context.JUNEAssetID
 	toBurn := map[ids.ID]uint64{}
 	if vdr.Supernet == constants.PrimaryNetworkID {
@@ -838,7 +876,6 @@ func (b *builder) NewAddPermissionlessValidatorTx(
 	toStake := map[ids.ID]uint64{
 		assetID: vdr.Wght,
 	}
-	ops := common.NewOptions(options)
 	inputs, baseOutputs, stakeOutputs, err := b.spend(toBurn, toStake, ops)
 	if err != nil {
 		return nil, err
@@ -871,6 +908,11 @@ func (b *builder) NewAddPermissionlessDelegatorTx(
 	rewardsOwner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.AddPermissionlessDelegatorTx, error) {
+	ops := common.NewOptions(options)
+	if err := b.verifyStakingAsset(ops.Context(), vdr.Supernet, assetID); err != nil {
+		return nil, err
+	}
+
 	juneAssetID := b.context.JUNEAssetID
 	toBurn := map[ids.ID]uint64{}
 	if vdr.Supernet == constants.PrimaryNetworkID {
@@ -881,7 +923,6 @@ func (b *builder) NewAddPermissionlessDelegatorTx(
 	toStake := map[ids.ID]uint64{
 		assetID: vdr.Wght,
 	}
-	ops := common.NewOptions(options)
 	inputs, baseOutputs, stakeOutputs, err := b.spend(toBurn, toStake, ops)
 	if err != nil {
 		return nil, err
@@ -952,6 +993,45 @@ func (b *builder) getBalance(
 	return balance, nil
 }
 
+// verifyStakingAsset ensures that [assetID] can be staked on [supernetID]. The
+// primary network is staked with JUNE, while permissionless supernets are
+// staked with the asset of their TransformSupernetTx. In both cases the fee is
+// paid in JUNE.
+//
+// If the transformation of [supernetID] isn't known by the backend, any asset is
+// accepted and the node is left to verify it.
+func (b *builder) verifyStakingAsset(ctx context.Context, supernetID ids.ID, assetID ids.ID) error {
+	if supernetID == constants.PrimaryNetworkID {
+		if assetID != b.context.JUNEAssetID {
+			return fmt.Errorf(
+				"%w: the primary network must be staked with %q but got %q",
+				ErrInvalidStakingAsset,
+				b.context.JUNEAssetID,
+				assetID,
+			)
+		}
+		return nil
+	}
+
+	stakingAssetID, err := b.backend.GetSupernetStakingAsset(ctx, supernetID)
+	switch {
+	case err == database.ErrNotFound:
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get staking asset of supernet %q: %w", supernetID, err)
+	case assetID != stakingAssetID:
+		return fmt.Errorf(
+			"%w: supernet %q must be staked with %q but got %q",
+			ErrInvalidStakingAsset,
+			supernetID,
+			stakingAssetID,
+			assetID,
+		)
+	default:
+		return nil
+	}
+}
+
 // spend takes in the requested burn amounts and the requested stake amounts.
 //
 //   - [amountsToBurn] maps assetID to the amount of the asset to spend without
@@ -985,6 +1065,11 @@ func (b *builder) spend(
 	if !ok {
 		return nil, nil, nil, ErrNoChangeAddress
 	}
+
+	// The amounts are decreased as UTXOs are consumed, so the requested
+	// amounts are kept to report any shortfall.
+	requestedToBurn := maps.Clone(amountsToBurn)
+	requestedToStake := maps.Clone(amountsToStake)
 	changeOwner := options.ChangeOwner(&secp256k1fx.OutputOwners{
 		Threshold: 1,
 		Addrs:     []ids.ShortID{addr},
@@ -1080,6 +1165,34 @@ func (b *builder) spend(
 		}
 	}
 
+	if options.UTXOStrategy() == common.MinimalChange {
+		amountsToSpend := make(map[ids.ID]uint64, len(amountsToBurn)+len(amountsToStake))
+		for assetID, amount := range amountsToBurn {
+			amountsToSpend[assetID] = amount
+		}
+		for assetID, amount := range amountsToStake {
+			amountsToSpend[assetID], err = math.Add64(amountsToSpend[assetID], amount)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+		}
+		utxos = common.PrioritizeUTXOs(utxos, amountsToSpend, func(utxo *avax.UTXO) (uint64, bool) {
+			outIntf := utxo.Out
+			if lockedOut, ok := outIntf.(*stakeable.LockOut); ok {
+				if lockedOut.Locktime > minIssuanceTime {
+					return 0, false
+				}
+				outIntf = lockedOut.TransferableOut
+			}
+			out, ok := outIntf.(*secp256k1fx.TransferOutput)
+			if !ok {
+				return 0, false
+			}
+			_, ok = common.MatchOwners(&out.OutputOwners, addrs, minIssuanceTime)
+			return out.Amt, ok
+		})
+	}
+
 	// Iterate over the unlocked UTXOs
 	for _, utxo := range utxos {
 		assetID := utxo.AssetID()
@@ -1162,22 +1275,20 @@ func (b *builder) spend(
 
 	for assetID, amount := range amountsToStake {
 		if amount != 0 {
-			return nil, nil, nil, fmt.Errorf(
-				"%w: provided UTXOs need %d more units of asset %q to stake",
-				ErrInsufficientFunds,
-				amount,
-				assetID,
-			)
+			return nil, nil, nil, fmt.Errorf("%w to stake", &common.InsufficientFundsError{
+				AssetID: assetID,
+				Need:    requestedToStake[assetID],
+				Have:    requestedToStake[assetID] - amount,
+			})
 		}
 	}
 	for assetID, amount := range amountsToBurn {
 		if amount != 0 {
-			return nil, nil, nil, fmt.Errorf(
-				"%w: provided UTXOs need %d more units of asset %q",
-				ErrInsufficientFunds,
-				amount,
-				assetID,
-			)
+			return nil, nil, nil, fmt.Errorf("%w to burn", &common.InsufficientFundsError{
+				AssetID: assetID,
+				Need:    requestedToBurn[assetID],
+				Have:    requestedToBurn[assetID] - amount,
+			})
 		}
 	}
 
@@ -1202,6 +1313,9 @@ func (b *builder) authorizeSupernet(supernetID ids.ID, options *common.Options)
 	}
 
 	addrs := options.Addresses(b.addrs)
+	if kc := options.SupernetAuthKeys(); kc != nil {
+		addrs = kc.Addresses()
+	}
 	minIssuanceTime := options.MinIssuanceTime()
 	inputSigIndices, ok := common.MatchOwners(owner, addrs, minIssuanceTime)
 	if !ok {
@@ -4,6 +4,7 @@
 package p
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -22,7 +23,10 @@ import (
 	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
 	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
 	"github.com/Juneo-io/juneogo/wallet/chain/p/builder"
+	"github.com/Juneo-io/juneogo/wallet/chain/x"
 	"github.com/Juneo-io/juneogo/wallet/supernet/primary/common"
+
+	xbuilder "github.com/Juneo-io/juneogo/wallet/chain/x/builder"
 )
 
 var (
@@ -94,6 +98,177 @@ func TestBaseTx(t *testing.T) {
 	require.Equal(outputsToMove[0], outs[1])
 }
 
+func TestBaseTxMinimalChange(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey  = testKeys[1]
+		utxosAddr = utxosKey.Address()
+		utxos     = make([]*avax.UTXO, 0, 5)
+	)
+	for i, amount := range []uint64{
+		20 * units.Avax,
+		5 * units.Avax,
+		3 * units.Avax,
+		units.Avax,
+		500 * units.MilliAvax,
+	} {
+		utxos = append(utxos, &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        ids.Empty.Prefix(uint64(i)),
+				OutputIndex: uint32(i),
+			},
+			Asset: avax.Asset{ID: juneAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: amount,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxosAddr},
+				},
+			},
+		})
+	}
+
+	var (
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: utxos,
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// builder
+		builder = builder.New(set.Of(utxosAddr), testContext, backend)
+
+		// data to build the transaction
+		outputsToMove = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: juneAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 8 * units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxosAddr},
+				},
+			},
+		}}
+	)
+
+	utx, err := builder.NewBaseTx(
+		outputsToMove,
+		common.WithUTXOStrategy(common.MinimalChange),
+	)
+	require.NoError(err)
+
+	// The 5, 3 and 0.5 JUNE UTXOs are the closest to the 8 JUNE moved plus
+	// the fee.
+	ins := utx.Ins
+	outs := utx.Outs
+	require.Len(ins, 3)
+	require.Len(outs, 2)
+
+	var consumed uint64
+	for _, in := range ins {
+		consumed += in.In.Amount()
+	}
+	require.Equal(8500*units.MilliAvax, consumed)
+
+	change := outs[0].Out.Amount()
+	require.Less(change, units.Avax)
+	require.Equal(consumed-testContext.BaseTxFee-outputsToMove[0].Out.Amount(), change)
+	require.Equal(outputsToMove[0], outs[1])
+}
+
+func TestBaseTxInsufficientFunds(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		utxos      = makeTestUTXOs(utxosKey)
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: utxos,
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// builder
+		utxoAddr = utxosKey.Address()
+		builder  = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		outputsToMove = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: juneAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1_000_000 * units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxoAddr},
+				},
+			},
+		}}
+	)
+
+	_, err := builder.NewBaseTx(outputsToMove)
+	require.ErrorIs(err, common.ErrInsufficientFunds)
+	require.ErrorContains(err, "to burn")
+
+	var insufficientFundsErr *common.InsufficientFundsError
+	require.ErrorAs(err, &insufficientFundsErr)
+	require.Equal(juneAssetID, insufficientFundsErr.AssetID)
+	require.Equal(testContext.BaseTxFee+outputsToMove[0].Out.Amount(), insufficientFundsErr.Need)
+	require.Less(insufficientFundsErr.Have, insufficientFundsErr.Need)
+}
+
+func TestBaseTxCustomFee(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		utxos      = makeTestUTXOs(utxosKey)
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: utxos,
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// builder
+		utxoAddr = utxosKey.Address()
+		builder  = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		outputsToMove = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: juneAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 7 * units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxoAddr},
+				},
+			},
+		}}
+	)
+
+	_, err := builder.NewBaseTx(
+		outputsToMove,
+		common.WithFee(testContext.BaseTxFee-1),
+	)
+	require.ErrorIs(err, common.ErrFeeTooLow)
+
+	fee := 2 * testContext.BaseTxFee
+	utx, err := builder.NewBaseTx(
+		outputsToMove,
+		common.WithFee(fee),
+	)
+	require.NoError(err)
+
+	var consumed uint64
+	for _, in := range utx.Ins {
+		consumed += in.In.Amount()
+	}
+	for _, out := range utx.Outs {
+		consumed -= out.Out.Amount()
+	}
+	require.Equal(fee, consumed)
+}
+
 func TestAddSupernetValidatorTx(t *testing.T) {
 	var (
 		require = require.New(t)
@@ -412,6 +587,63 @@ func TestImportTx(t *testing.T) {
 	require.Equal(expectedConsumed, consumed)
 }
 
+func TestImportTxFeeFromImportedFunds(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey      = testKeys[1]
+		utxos         = makeTestUTXOs(utxosKey)
+		sourceChainID = ids.GenerateTestID()
+		importedUTXOs = utxos[:1]
+		chainUTXOs    = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			// the wallet doesn't hold any funds on the P-chain
+			sourceChainID: importedUTXOs,
+		})
+
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// builder
+		utxoAddr  = utxosKey.Address()
+		txBuilder = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		importTo = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				utxoAddr,
+			},
+		}
+	)
+
+	// build the transaction
+	utx, err := txBuilder.NewImportTx(
+		sourceChainID,
+		importTo,
+		common.WithFeeFromImportedFunds(),
+	)
+	require.NoError(err)
+
+	// check that the fee is taken from the imported funds
+	require.Empty(utx.Ins)
+	require.Len(utx.ImportedInputs, 1)
+	require.Len(utx.Outs, 1)
+
+	importedAmount := importedUTXOs[0].Out.(*secp256k1fx.TransferOutput).Amt
+	require.Equal(importedAmount-testContext.BaseTxFee, utx.Outs[0].Out.Amount())
+
+	// the imported funds must exceed the fee
+	smallContext := *testContext
+	smallContext.BaseTxFee = importedAmount
+	txBuilder = builder.New(set.Of(utxoAddr), &smallContext, NewBackend(&smallContext, chainUTXOs, nil))
+	_, err = txBuilder.NewImportTx(
+		sourceChainID,
+		importTo,
+		common.WithFeeFromImportedFunds(),
+	)
+	require.ErrorIs(err, builder.ErrInsufficientFunds)
+}
+
 func TestExportTx(t *testing.T) {
 	var (
 		require = require.New(t)
@@ -461,6 +693,102 @@ func TestExportTx(t *testing.T) {
 	require.Equal(utx.ExportedOutputs, exportedOutputs)
 }
 
+func TestExportTxMultipleAssets(t *testing.T) {
+	var (
+		require = require.New(t)
+		ctx     = context.Background()
+
+		// backends sharing the same UTXOs, so that the UTXOs exported from the
+		// P-chain can be imported on the X-chain
+		utxosKey = testKeys[1]
+		utxos    = common.NewUTXOs()
+		xChainID = ids.GenerateTestID()
+		xContext = &xbuilder.Context{
+			NetworkID:    testContext.NetworkID,
+			BlockchainID: xChainID,
+			JUNEAssetID:  juneAssetID,
+			BaseTxFee:    units.MicroAvax,
+		}
+		pBackend = NewBackend(testContext, common.NewChainUTXOs(constants.PlatformChainID, utxos), nil)
+		xBackend = x.NewBackend(xContext, common.NewChainUTXOs(xChainID, utxos))
+
+		// builders
+		utxoAddr  = utxosKey.Address()
+		pBuilder  = builder.New(set.Of(utxoAddr), testContext, pBackend)
+		xBuilder  = xbuilder.New(set.Of(utxoAddr), xContext, xBackend)
+		utxoOwner = secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{utxoAddr},
+		}
+
+		// data to build the transactions
+		juneAmount      = 7 * units.Avax
+		supernetAmount  = 3 * units.Avax
+		exportedOutputs = []*avax.TransferableOutput{
+			{
+				Asset: avax.Asset{ID: supernetAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          supernetAmount,
+					OutputOwners: utxoOwner,
+				},
+			},
+			{
+				Asset: avax.Asset{ID: juneAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          juneAmount,
+					OutputOwners: utxoOwner,
+				},
+			},
+		}
+	)
+	for _, utxo := range makeTestUTXOs(utxosKey) {
+		require.NoError(utxos.AddUTXO(ctx, constants.PlatformChainID, constants.PlatformChainID, utxo))
+	}
+
+	// export both assets at once
+	exportUTx, err := pBuilder.NewExportTx(
+		xChainID,
+		exportedOutputs,
+	)
+	require.NoError(err)
+	require.Len(exportUTx.ExportedOutputs, 2)
+	require.True(avax.IsSortedTransferableOutputs(exportUTx.ExportedOutputs, txs.Codec))
+	require.True(avax.IsSortedTransferableOutputs(exportUTx.Outs, txs.Codec))
+
+	exportTx := &txs.Tx{Unsigned: exportUTx}
+	require.NoError(exportTx.Initialize(txs.Codec))
+	require.NoError(pBackend.AcceptTx(ctx, exportTx))
+
+	// import both assets on the X-chain
+	importUTx, err := xBuilder.NewImportTx(
+		constants.PlatformChainID,
+		&utxoOwner,
+	)
+	require.NoError(err)
+	require.Len(importUTx.ImportedIns, 2)
+	require.Empty(importUTx.Ins)
+	require.True(avax.IsSortedTransferableOutputs(importUTx.Outs, xbuilder.Parser.Codec()))
+
+	imported := make(map[ids.ID]uint64)
+	for _, in := range importUTx.ImportedIns {
+		require.Equal(exportTx.ID(), in.TxID)
+		imported[in.AssetID()] += in.In.Amount()
+	}
+	require.Equal(map[ids.ID]uint64{
+		juneAssetID:     juneAmount,
+		supernetAssetID: supernetAmount,
+	}, imported)
+
+	produced := make(map[ids.ID]uint64)
+	for _, out := range importUTx.Outs {
+		produced[out.AssetID()] += out.Out.Amount()
+	}
+	require.Equal(map[ids.ID]uint64{
+		juneAssetID:     juneAmount - xContext.BaseTxFee,
+		supernetAssetID: supernetAmount,
+	}, produced)
+}
+
 func TestTransformSupernetTx(t *testing.T) {
 	var (
 		require = require.New(t)
@@ -605,6 +933,137 @@ func TestAddPermissionlessValidatorTx(t *testing.T) {
 	require.Equal(expectedConsumed, consumed)
 }
 
+func TestAddPermissionlessSupernetValidatorTx(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		utxos      = makeTestUTXOs(utxosKey)
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: utxos,
+		})
+		supernetID  = ids.GenerateTestID()
+		supernetTxs = map[ids.ID]*txs.Tx{
+			ids.GenerateTestID(): {
+				Unsigned: &txs.TransformSupernetTx{
+					Supernet: supernetID,
+					AssetID:  supernetAssetID,
+				},
+			},
+		}
+		backend = NewBackend(testContext, chainUTXOs, supernetTxs)
+
+		// builder
+		utxoAddr   = utxosKey.Address()
+		rewardKey  = testKeys[0]
+		rewardAddr = rewardKey.Address()
+		txBuilder  = builder.New(set.Of(utxoAddr, rewardAddr), testContext, backend)
+
+		// data to build the transaction
+		rewardsOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				rewardAddr,
+			},
+		}
+		newSupernetValidator = func(weight uint64) *txs.SupernetValidator {
+			return &txs.SupernetValidator{
+				Validator: txs.Validator{
+					NodeID: ids.GenerateTestNodeID(),
+					End:    uint64(time.Now().Add(time.Hour).Unix()),
+					Wght:   weight,
+				},
+				Supernet: supernetID,
+			}
+		}
+	)
+
+	// build the transaction
+	utx, err := txBuilder.NewAddPermissionlessValidatorTx(
+		newSupernetValidator(2*units.MegaAvax),
+		&signer.Empty{},
+		supernetAssetID,
+		rewardsOwner,
+		rewardsOwner,
+		reward.PercentDenominator,
+	)
+	require.NoError(err)
+
+	// check that the stake is funded by the supernet asset while the fee is
+	// funded by JUNE
+	ins := utx.Ins
+	staked := utx.StakeOuts
+	outs := utx.Outs
+	require.Len(ins, 3)
+	require.Len(staked, 1)
+	require.Len(outs, 2)
+
+	consumedByAsset := make(map[ids.ID]uint64)
+	for _, in := range ins {
+		consumedByAsset[in.AssetID()] += in.In.Amount()
+	}
+	for _, out := range outs {
+		consumedByAsset[out.AssetID()] -= out.Out.Amount()
+	}
+	require.Equal(
+		map[ids.ID]uint64{
+			juneAssetID:     testContext.AddSupernetValidatorFee,
+			supernetAssetID: utx.Validator.Weight(),
+		},
+		consumedByAsset,
+	)
+	require.Equal(supernetAssetID, staked[0].AssetID())
+	require.Equal(utx.Validator.Weight(), staked[0].Out.Amount())
+
+	// only the asset of the TransformSupernetTx can be staked on the supernet
+	_, err = txBuilder.NewAddPermissionlessValidatorTx(
+		newSupernetValidator(2*units.Avax),
+		&signer.Empty{},
+		ids.GenerateTestID(),
+		rewardsOwner,
+		rewardsOwner,
+		reward.PercentDenominator,
+	)
+	require.ErrorIs(err, builder.ErrInvalidStakingAsset)
+
+	// JUNE can't be staked on a transformed supernet
+	_, err = txBuilder.NewAddPermissionlessValidatorTx(
+		newSupernetValidator(2*units.Avax),
+		&signer.Empty{},
+		juneAssetID,
+		rewardsOwner,
+		rewardsOwner,
+		reward.PercentDenominator,
+	)
+	require.ErrorIs(err, builder.ErrInvalidStakingAsset)
+
+	// the staked asset isn't checked if the transformation of the supernet
+	// isn't known by the backend
+	unknownSupernetValidator := newSupernetValidator(2 * units.Avax)
+	unknownSupernetValidator.Supernet = ids.GenerateTestID()
+	_, err = txBuilder.NewAddPermissionlessValidatorTx(
+		unknownSupernetValidator,
+		&signer.Empty{},
+		juneAssetID,
+		rewardsOwner,
+		rewardsOwner,
+		reward.PercentDenominator,
+	)
+	require.NoError(err)
+
+	// the supernet asset pool is short
+	_, err = txBuilder.NewAddPermissionlessValidatorTx(
+		newSupernetValidator(100*units.MegaAvax),
+		&signer.Empty{},
+		supernetAssetID,
+		rewardsOwner,
+		rewardsOwner,
+		reward.PercentDenominator,
+	)
+	require.ErrorIs(err, builder.ErrInsufficientFunds)
+}
+
 func TestAddPermissionlessDelegatorTx(t *testing.T) {
 	var (
 		require = require.New(t)
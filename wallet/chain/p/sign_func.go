@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/crypto/keychain"
+	"github.com/Juneo-io/juneogo/utils/crypto/secp256k1"
+	"github.com/Juneo-io/juneogo/utils/hashing"
+	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
+
+	walletsigner "github.com/Juneo-io/juneogo/wallet/chain/p/signer"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	_ keychain.Keychain = (*funcKeychain)(nil)
+	_ keychain.Signer   = (*funcSigner)(nil)
+)
+
+// SignFunc signs [hashes] on behalf of [addrs].
+//
+// The i-th returned signature must be the signature of hashes[i] by the key
+// controlling addrs[i], or nil if that key isn't available, in which case the
+// signature is left empty.
+type SignFunc func(addrs []ids.ShortID, hashes [][]byte) ([][]byte, error)
+
+// signWithFunc signs [utx] by requesting all the signatures it needs from
+// [signFn] in a single call.
+func signWithFunc(
+	ctx context.Context,
+	backend walletsigner.Backend,
+	utx txs.UnsignedTx,
+	signFn SignFunc,
+) (*txs.Tx, error) {
+	// The first pass only records the signatures needed by the tx.
+	requests := &funcKeychain{
+		record: true,
+	}
+	if _, err := walletsigner.SignUnsigned(ctx, walletsigner.New(requests, backend), utx); err != nil {
+		return nil, err
+	}
+
+	sigs, err := signFn(requests.addrs, requests.hashes)
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) != len(requests.addrs) {
+		return nil, fmt.Errorf("%w: expected %d but got %d",
+			keychain.ErrInvalidNumSignatures,
+			len(requests.addrs),
+			len(sigs),
+		)
+	}
+
+	signatures := &funcKeychain{
+		sigs: make(map[ids.ShortID][]byte, len(sigs)),
+	}
+	for i, sig := range sigs {
+		if sig == nil {
+			continue
+		}
+
+		addr := requests.addrs[i]
+		pk, err := secp256k1.RecoverPublicKeyFromHash(requests.hashes[i], sig)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %w", ErrInvalidSignature, addr, err)
+		}
+		if pk.Address() != addr {
+			return nil, fmt.Errorf("%w for %s: signed by %s", ErrInvalidSignature, addr, pk.Address())
+		}
+		signatures.sigs[addr] = sig
+	}
+	return walletsigner.SignUnsigned(ctx, walletsigner.New(signatures, backend), utx)
+}
+
+// funcKeychain is a keychain that either records the signatures requested from
+// it, if [record] is set, or provides the signatures in [sigs].
+type funcKeychain struct {
+	record bool
+	addrs  []ids.ShortID
+	hashes [][]byte
+
+	sigs map[ids.ShortID][]byte
+}
+
+func (kc *funcKeychain) Get(addr ids.ShortID) (keychain.Signer, bool) {
+	if _, ok := kc.sigs[addr]; !ok && !kc.record {
+		return nil, false
+	}
+	return &funcSigner{
+		kc:   kc,
+		addr: addr,
+	}, true
+}
+
+func (kc *funcKeychain) Addresses() set.Set[ids.ShortID] {
+	if kc.record {
+		return set.Of(kc.addrs...)
+	}
+
+	addrs := set.NewSet[ids.ShortID](len(kc.sigs))
+	for addr := range kc.sigs {
+		addrs.Add(addr)
+	}
+	return addrs
+}
+
+type funcSigner struct {
+	kc   *funcKeychain
+	addr ids.ShortID
+}
+
+func (s *funcSigner) SignHash(hash []byte) ([]byte, error) {
+	if !s.kc.record {
+		return s.kc.sigs[s.addr], nil
+	}
+
+	// Leave the signature empty while recording the request.
+	s.kc.addrs = append(s.kc.addrs, s.addr)
+	s.kc.hashes = append(s.kc.hashes, hash)
+	return nil, nil
+}
+
+func (s *funcSigner) Sign(msg []byte) ([]byte, error) {
+	return s.SignHash(hashing.ComputeHash256(msg))
+}
+
+func (s *funcSigner) Address() ids.ShortID {
+	return s.addr
+}
@@ -4,15 +4,27 @@
 package p
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sync"
 	"time"
 
+	"golang.org/x/exp/maps"
+
+	"github.com/Juneo-io/juneogo/database"
 	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/constants"
+	"github.com/Juneo-io/juneogo/utils/math"
+	"github.com/Juneo-io/juneogo/utils/rpc"
+	"github.com/Juneo-io/juneogo/utils/set"
 	"github.com/Juneo-io/juneogo/vms/components/avax"
 	"github.com/Juneo-io/juneogo/vms/platformvm"
+	"github.com/Juneo-io/juneogo/vms/platformvm/stakeable"
 	"github.com/Juneo-io/juneogo/vms/platformvm/status"
 	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
+	"github.com/Juneo-io/juneogo/vms/platformvm/txs/mempool"
 	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
 	"github.com/Juneo-io/juneogo/wallet/chain/p/builder"
 	"github.com/Juneo-io/juneogo/wallet/supernet/primary/common"
@@ -22,7 +34,24 @@ import (
 )
 
 var (
-	ErrNotCommitted = errors.New("not committed")
+	ErrNotCommitted               = errors.New("not committed")
+	ErrNotPrepared                = errors.New("tx not prepared or reservation expired")
+	ErrSupernetAlreadyTransformed = errors.New("supernet already transformed")
+	ErrWrongStakingAsset          = errors.New("wrong staking asset")
+	ErrInsufficientCapacity       = errors.New("insufficient validator capacity")
+	ErrUnknownStakingAsset        = errors.New("unknown staking asset")
+	ErrFeeTooLow                  = common.ErrFeeTooLow
+	ErrNoChangeOutput             = errors.New("no change output large enough to pay the fee increase")
+	ErrUnknownInput               = errors.New("unknown input")
+	ErrZeroAmount                 = errors.New("amount must be non-zero")
+	ErrLocktimeNotInFuture        = errors.New("locktime must be in the future")
+	ErrNotValidator               = errors.New("node is not a validator of supernet")
+	ErrInvalidDelegationFee       = errors.New("invalid delegation fee")
+	ErrInvalidSigner              = errors.New("invalid signer")
+	ErrChainNotTracked            = errors.New("node doesn't track the supernet of the chain")
+	ErrChainNotValidated          = errors.New("node doesn't validate the supernet of the chain")
+
+	errUnsupportedTxType = errors.New("unsupported tx type")
 
 	_ Wallet = (*wallet)(nil)
 )
@@ -43,6 +72,25 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// IssueLockedTransferTx creates, signs, and issues a new value transfer
+	// whose output can't be spent before [locktime], but can be staked.
+	//
+	// - [assetID] specifies the asset to transfer.
+	// - [amount] specifies the amount of [assetID] to transfer.
+	// - [locktime] specifies the unix time until which the output is locked.
+	// - [owner] specifies the owner of the locked output.
+	//
+	// If [amount] is zero, [ErrZeroAmount] is returned. If [locktime] isn't
+	// after the current P-chain timestamp, [ErrLocktimeNotInFuture] is
+	// returned. In both cases, the transaction isn't built.
+	IssueLockedTransferTx(
+		assetID ids.ID,
+		amount uint64,
+		locktime uint64,
+		owner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
 	// IssueAddValidatorTx creates, signs, and issues a new validator of the
 	// primary network.
 	//
@@ -74,6 +122,14 @@ type Wallet interface {
 	// removes a validator of a supernet.
 	//
 	// - [nodeID] is the validator being removed from [supernetID].
+	//
+	// The control keys of a multisig supernet can be provided with
+	// [common.WithSupernetAuthKeys]. They must satisfy the threshold of the
+	// supernet.
+	//
+	// If [common.WithValidatorCheck] is provided, [ErrNotValidator] is
+	// returned without building the tx if [nodeID] isn't a current or pending
+	// validator of [supernetID].
 	IssueRemoveSupernetValidatorTx(
 		nodeID ids.NodeID,
 		supernetID ids.ID,
@@ -113,6 +169,28 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// IssueCreateChainAndAwait creates, signs, and issues a new chain in the
+	// named supernet and waits until the node reports the chain as running.
+	// Returns the ID of the new chain.
+	//
+	// The chain is considered running once the node reports it as
+	// [status.Validating]. The status is polled at the configured poll
+	// frequency until the provided context is done. If the chain still isn't
+	// running by then, [ErrChainNotTracked] is returned when the node doesn't
+	// run the chain and [ErrChainNotValidated] when the node runs the chain
+	// without validating its supernet.
+	//
+	// See [IssueCreateChainTx] for a description of the parameters.
+	IssueCreateChainAndAwait(
+		supernetID ids.ID,
+		genesis []byte,
+		vmID ids.ID,
+		fxIDs []ids.ID,
+		chainName string,
+		chainAssetID ids.ID,
+		options ...common.Option,
+	) (ids.ID, error)
+
 	// IssueCreateSupernetTx creates, signs, and issues a new supernet with the
 	// specified owner.
 	//
@@ -140,6 +218,10 @@ type Wallet interface {
 	//
 	// - [chainID] specifies the chain to be importing funds from.
 	// - [to] specifies where to send the imported funds to.
+	//
+	// The fee is paid out of the imported JUNE when possible. If
+	// [common.WithFeeFromImportedFunds] is provided, the imported JUNE must
+	// exceed the fee so that no funds of the wallet are spent.
 	IssueImportTx(
 		chainID ids.ID,
 		to *secp256k1fx.OutputOwners,
@@ -196,6 +278,10 @@ type Wallet interface {
 	//   disables delegation.
 	// - [uptimeRequirement] is the minimum percentage a validator must be
 	//   online and responsive to receive a reward.
+	//
+	// If [common.WithSupernetCheck] is provided, [ErrSupernetAlreadyTransformed]
+	// is returned without building the transaction if [supernetID] was already
+	// transformed.
 	IssueTransformSupernetTx(
 		supernetID ids.ID,
 		assetID ids.ID,
@@ -234,6 +320,12 @@ type Wallet interface {
 	// - [shares] specifies the fraction (out of 1,000,000) that this validator
 	//   will take from delegation rewards. If 1,000,000 is provided, 100% of
 	//   the delegation reward will be sent to the validator's [rewardsOwner].
+	//
+	// Before building the tx, [ErrInvalidSigner] is returned if [signer]
+	// isn't a valid proof of possession for the primary network or isn't
+	// empty for any other supernet. If [common.WithDelegationFeeCheck] is
+	// provided, [ErrInvalidDelegationFee] is also returned if [shares] is
+	// outside of the delegation fees allowed by the supernet.
 	IssueAddPermissionlessValidatorTx(
 		vdr *txs.SupernetValidator,
 		signer vmsigner.Signer,
@@ -249,9 +341,19 @@ type Wallet interface {
 	//
 	// - [vdr] specifies all the details of the delegation period such as the
 	//   supernetID, startTime, endTime, stake weight, and nodeID.
-	// - [assetID] specifies the asset to stake.
+	// - [assetID] specifies the asset to stake. If empty, the staking asset of
+	//   the supernet is used. It is JUNE for the primary network and, for
+	//   other supernets, it is looked up on the node if
+	//   [common.WithDelegationCheck] is provided, or in the wallet otherwise.
+	//   If it isn't known, [ErrUnknownStakingAsset] is returned.
 	// - [rewardsOwner] specifies the owner of all the rewards this delegator
 	//   earns during its delegation period.
+	//
+	// If [common.WithDelegationCheck] is provided, the node is queried before
+	// building the transaction. If [assetID] isn't the staking asset of the
+	// supernet, [ErrWrongStakingAsset] is returned. If the stake weight
+	// exceeds the weight that can still be delegated to the validator,
+	// [ErrInsufficientCapacity] is returned.
 	IssueAddPermissionlessDelegatorTx(
 		vdr *txs.SupernetValidator,
 		assetID ids.ID,
@@ -259,6 +361,42 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// BuildAddSupernetValidatorTx creates a new validator of a supernet
+	// without signing or issuing it.
+	//
+	// See [IssueAddSupernetValidatorTx] for a description of the parameters.
+	BuildAddSupernetValidatorTx(
+		vdr *txs.SupernetValidator,
+		options ...common.Option,
+	) (*BuiltTx, error)
+
+	// BuildExportTx creates an export transaction without signing or issuing
+	// it.
+	//
+	// See [IssueExportTx] for a description of the parameters.
+	BuildExportTx(
+		chainID ids.ID,
+		outputs []*avax.TransferableOutput,
+		options ...common.Option,
+	) (*BuiltTx, error)
+
+	// MinBalanceForStake returns, per asset, the minimum balance required to
+	// issue a new permissionless validator staking [assetID]: the stake weight
+	// of [vdr] in [assetID] plus the fee of adding a validator to the supernet
+	// of [vdr] in JUNE.
+	MinBalanceForStake(vdr *txs.SupernetValidator, assetID ids.ID) (map[ids.ID]uint64, error)
+
+	// VerifyReceived returns true if the tx [txID] is committed and contains
+	// an output of exactly [expectedAmount] of [assetID] that only [toAddr]
+	// can spend, without any locktime.
+	VerifyReceived(
+		txID ids.ID,
+		expectedAmount uint64,
+		assetID ids.ID,
+		toAddr ids.ShortID,
+		options ...common.Option,
+	) (bool, error)
+
 	// IssueUnsignedTx signs and issues the unsigned tx.
 	IssueUnsignedTx(
 		utx txs.UnsignedTx,
@@ -266,10 +404,102 @@ type Wallet interface {
 	) (*txs.Tx, error)
 
 	// IssueTx issues the signed tx.
+	//
+	// A [common.TxTooLargeError] is returned, without contacting the node, if
+	// the tx is larger than the mempool accepts.
 	IssueTx(
 		tx *txs.Tx,
 		options ...common.Option,
 	) error
+
+	// IssueWithSigner builds a tx, signs it with [signFn] and issues it.
+	//
+	// This allows keys to be held outside of the wallet, such as in an HSM or
+	// a remote signer. All the signatures required by the tx are requested
+	// from [signFn] in a single call.
+	//
+	// - [buildFn] builds the unsigned tx using the provided builder.
+	// - [signFn] provides the signatures of the tx.
+	IssueWithSigner(
+		buildFn func(builder.Builder) (txs.UnsignedTx, error),
+		signFn SignFunc,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// PrepareSignedTx builds and signs a tx without issuing it.
+	//
+	// The P-chain UTXOs consumed by the tx are reserved so that they aren't
+	// used by other transactions built by this wallet until the tx is issued
+	// with [ReleasePrepared] or the reservation times out.
+	//
+	// - [buildFn] builds the unsigned tx using the provided builder.
+	PrepareSignedTx(
+		buildFn func(builder.Builder) (txs.UnsignedTx, error),
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// ReleasePrepared issues a tx previously returned by [PrepareSignedTx].
+	//
+	// If the tx is rejected before being sent, such as because it is too
+	// large, it stays prepared. Otherwise its reservation is released, and its
+	// inputs aren't made spendable again even if the issuance fails, as the tx
+	// may still be accepted.
+	ReleasePrepared(
+		tx *txs.Tx,
+		options ...common.Option,
+	) error
+
+	// RebroadcastWithBumpedFee rebuilds [tx] to burn [newFee] JUNE, signs it
+	// and issues it. The returned tx replaces [tx], which can't be accepted
+	// along with it as they consume the same UTXOs. Nodes replace [tx] in their
+	// mempool with the returned tx as it pays a higher fee.
+	//
+	// If [tx] was issued with [common.WithAssumeDecided], the UTXOs it
+	// consumes are restored from the txs that produced them, and the UTXOs it
+	// was assumed to produce are removed. If a consumed UTXO wasn't produced
+	// as an output of a tx, for example a reward UTXO, [ErrUnknownInput] is
+	// returned.
+	//
+	// The fee increase is taken from a JUNE change output of [tx]. The change
+	// output is the one owned by the owner provided with
+	// [common.WithChangeOwner] or, if none is provided, by the owner of one of
+	// the UTXOs consumed by [tx]. If no such output is larger than the fee
+	// increase, [ErrNoChangeOutput] is returned.
+	//
+	// If [newFee] is below the fee currently required to issue [tx], or isn't
+	// higher than the fee paid by [tx], [ErrFeeTooLow] is returned.
+	RebroadcastWithBumpedFee(
+		tx *txs.Tx,
+		newFee uint64,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// PruneSpent removes the UTXOs consumed by the provided confirmed txs from
+	// the wallet's local UTXO set.
+	//
+	// Any reservations held by txs returned from [PrepareSignedTx] are
+	// released without making their inputs spendable again. Txs that weren't
+	// prepared by this wallet are fetched from the P-chain to determine their
+	// inputs.
+	//
+	// - [confirmedTxIDs] specifies the txs that have been accepted.
+	PruneSpent(
+		confirmedTxIDs []ids.ID,
+		options ...common.Option,
+	) error
+}
+
+// BuiltTx is a transaction built by the wallet without being signed or issued.
+// It can be signed elsewhere, such as by an offline signer, and issued later
+// with [Wallet.IssueTx].
+type BuiltTx struct {
+	// Tx is the unsigned transaction, which is identical to the transaction
+	// the matching Issue method would have signed.
+	Tx txs.UnsignedTx
+	// Fee is the amount of JUNE burned by [Tx].
+	Fee uint64
+	// Inputs are the IDs of the UTXOs consumed by [Tx].
+	Inputs set.Set[ids.ID]
 }
 
 func NewWallet(
@@ -279,10 +509,11 @@ func NewWallet(
 	backend Backend,
 ) Wallet {
 	return &wallet{
-		Backend: backend,
-		builder: builder,
-		signer:  signer,
-		client:  client,
+		Backend:  backend,
+		builder:  builder,
+		signer:   signer,
+		client:   client,
+		prepared: make(map[ids.ID]*preparedTx),
 	}
 }
 
@@ -291,6 +522,19 @@ type wallet struct {
 	builder builder.Builder
 	signer  walletsigner.Signer
 	client  platformvm.Client
+
+	// backendLock serializes the updates made to [Backend] by the wallet,
+	// including the ones made by the reservation timers.
+	backendLock sync.Mutex
+
+	preparedLock sync.Mutex
+	prepared     map[ids.ID]*preparedTx // txID -> reservation
+}
+
+// preparedTx tracks the P-chain UTXOs reserved for a prepared tx.
+type preparedTx struct {
+	utxos []*avax.UTXO
+	timer *time.Timer
 }
 
 func (w *wallet) Builder() builder.Builder {
@@ -312,6 +556,45 @@ func (w *wallet) IssueBaseTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueLockedTransferTx(
+	assetID ids.ID,
+	amount uint64,
+	locktime uint64,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	if amount == 0 {
+		return nil, ErrZeroAmount
+	}
+
+	ops := common.NewOptions(options)
+	chainTime, err := w.client.GetTimestamp(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	if now := uint64(chainTime.Unix()); locktime <= now {
+		return nil, fmt.Errorf("%w: locktime %d but chain time is %d",
+			ErrLocktimeNotInFuture,
+			locktime,
+			now,
+		)
+	}
+
+	return w.IssueBaseTx(
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: assetID},
+			Out: &stakeable.LockOut{
+				Locktime: locktime,
+				TransferableOut: &secp256k1fx.TransferOutput{
+					Amt:          amount,
+					OutputOwners: *owner,
+				},
+			},
+		}},
+		options...,
+	)
+}
+
 func (w *wallet) IssueAddValidatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -341,6 +624,13 @@ func (w *wallet) IssueRemoveSupernetValidatorTx(
 	supernetID ids.ID,
 	options ...common.Option,
 ) (*txs.Tx, error) {
+	ops := common.NewOptions(options)
+	if ops.CheckValidator() {
+		if err := w.verifyValidator(ops.Context(), nodeID, supernetID); err != nil {
+			return nil, err
+		}
+	}
+
 	utx, err := w.builder.NewRemoveSupernetValidatorTx(nodeID, supernetID, options...)
 	if err != nil {
 		return nil, err
@@ -348,6 +638,28 @@ func (w *wallet) IssueRemoveSupernetValidatorTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+// verifyValidator returns [ErrNotValidator] if [nodeID] is neither a current
+// nor a pending validator of [supernetID].
+func (w *wallet) verifyValidator(ctx context.Context, nodeID ids.NodeID, supernetID ids.ID) error {
+	nodeIDs := []ids.NodeID{nodeID}
+	currentValidators, err := w.client.GetCurrentValidators(ctx, supernetID, nodeIDs)
+	if err != nil {
+		return err
+	}
+	if len(currentValidators) != 0 {
+		return nil
+	}
+
+	pendingValidators, _, err := w.client.GetPendingValidators(ctx, supernetID, nodeIDs)
+	if err != nil {
+		return err
+	}
+	if len(pendingValidators) != 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s isn't validating %s", ErrNotValidator, nodeID, supernetID)
+}
+
 func (w *wallet) IssueAddDelegatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -376,6 +688,52 @@ func (w *wallet) IssueCreateChainTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueCreateChainAndAwait(
+	supernetID ids.ID,
+	genesis []byte,
+	vmID ids.ID,
+	fxIDs []ids.ID,
+	chainName string,
+	chainAssetID ids.ID,
+	options ...common.Option,
+) (ids.ID, error) {
+	tx, err := w.IssueCreateChainTx(supernetID, genesis, vmID, fxIDs, chainName, chainAssetID, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+
+	ops := common.NewOptions(options)
+	ctx := ops.Context()
+	chainID := tx.ID()
+
+	ticker := time.NewTicker(rpc.PollFrequency(ops.PollFrequency()))
+	defer ticker.Stop()
+
+	for {
+		chainStatus, err := w.client.GetBlockchainStatus(ctx, chainID.String())
+		if err != nil {
+			return ids.Empty, err
+		}
+		if chainStatus == status.Validating {
+			return chainID, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			switch chainStatus {
+			case status.Created:
+				// The chain is only created by nodes tracking its supernet.
+				return ids.Empty, fmt.Errorf("%w %s: %w", ErrChainNotTracked, supernetID, ctx.Err())
+			case status.Syncing:
+				return ids.Empty, fmt.Errorf("%w %s: %w", ErrChainNotValidated, supernetID, ctx.Err())
+			default:
+				return ids.Empty, ctx.Err()
+			}
+		}
+	}
+}
+
 func (w *wallet) IssueCreateSupernetTx(
 	owner *secp256k1fx.OutputOwners,
 	options ...common.Option,
@@ -445,6 +803,13 @@ func (w *wallet) IssueTransformSupernetTx(
 	uptimeRequirement uint32,
 	options ...common.Option,
 ) (*txs.Tx, error) {
+	ops := common.NewOptions(options)
+	if ops.CheckSupernet() {
+		if err := w.verifyPermissionedSupernet(ops.Context(), supernetID); err != nil {
+			return nil, err
+		}
+	}
+
 	utx, err := w.builder.NewTransformSupernetTx(
 		supernetID,
 		assetID,
@@ -473,6 +838,23 @@ func (w *wallet) IssueTransformSupernetTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+// verifyPermissionedSupernet queries the node to verify that [supernetID]
+// wasn't transformed yet.
+func (w *wallet) verifyPermissionedSupernet(ctx context.Context, supernetID ids.ID) error {
+	supernet, err := w.client.GetSupernet(ctx, supernetID)
+	if err != nil {
+		return err
+	}
+	if !supernet.IsPermissioned {
+		return fmt.Errorf("%w: %s was transformed by %s",
+			ErrSupernetAlreadyTransformed,
+			supernetID,
+			supernet.SupernetTransformationTxID,
+		)
+	}
+	return nil
+}
+
 func (w *wallet) IssueAddPermissionlessValidatorTx(
 	vdr *txs.SupernetValidator,
 	signer vmsigner.Signer,
@@ -482,6 +864,17 @@ func (w *wallet) IssueAddPermissionlessValidatorTx(
 	shares uint32,
 	options ...common.Option,
 ) (*txs.Tx, error) {
+	if err := verifySigner(vdr.Supernet, signer); err != nil {
+		return nil, err
+	}
+
+	ops := common.NewOptions(options)
+	if ops.CheckDelegationFee() {
+		if err := w.verifyDelegationFee(ops.Context(), vdr.Supernet, shares); err != nil {
+			return nil, err
+		}
+	}
+
 	utx, err := w.builder.NewAddPermissionlessValidatorTx(
 		vdr,
 		signer,
@@ -497,12 +890,64 @@ func (w *wallet) IssueAddPermissionlessValidatorTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+// verifyDelegationFee queries the node to verify that [shares] is within the
+// delegation fees allowed by [supernetID].
+func (w *wallet) verifyDelegationFee(ctx context.Context, supernetID ids.ID, shares uint32) error {
+	limits, err := w.client.GetStakeLimits(ctx, supernetID)
+	if err != nil {
+		return err
+	}
+	minFee, maxFee := uint32(limits.MinDelegationFee), uint32(limits.MaxDelegationFee)
+	if shares < minFee || shares > maxFee {
+		return fmt.Errorf("%w: %d is outside of [%d, %d] for supernet %s",
+			ErrInvalidDelegationFee,
+			shares,
+			minFee,
+			maxFee,
+			supernetID,
+		)
+	}
+	return nil
+}
+
+// verifySigner returns [ErrInvalidSigner] if [signer] can't be used to validate
+// [supernetID]. Primary network validators must register a valid BLS key,
+// while the validators of other supernets must not register any.
+func verifySigner(supernetID ids.ID, signer vmsigner.Signer) error {
+	if err := signer.Verify(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSigner, err)
+	}
+
+	hasKey := signer.Key() != nil
+	isPrimaryNetwork := supernetID == constants.PrimaryNetworkID
+	if hasKey != isPrimaryNetwork {
+		return fmt.Errorf("%w: hasKey=%v != isPrimaryNetwork=%v",
+			ErrInvalidSigner,
+			hasKey,
+			isPrimaryNetwork,
+		)
+	}
+	return nil
+}
+
 func (w *wallet) IssueAddPermissionlessDelegatorTx(
 	vdr *txs.SupernetValidator,
 	assetID ids.ID,
 	rewardsOwner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.Tx, error) {
+	ops := common.NewOptions(options)
+	ctx := ops.Context()
+	var err error
+	if ops.CheckDelegation() {
+		assetID, err = w.verifyDelegation(ctx, vdr, assetID)
+	} else if assetID == ids.Empty {
+		assetID, err = w.stakingAssetID(ctx, vdr.Supernet)
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	utx, err := w.builder.NewAddPermissionlessDelegatorTx(
 		vdr,
 		assetID,
@@ -515,24 +960,240 @@ func (w *wallet) IssueAddPermissionlessDelegatorTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+// verifyDelegation queries the node to verify that [assetID] is the staking
+// asset of the supernet of [vdr] and that [vdr] doesn't exceed the weight that
+// can still be delegated to the validator. If [assetID] is empty, the staking
+// asset of the supernet is returned.
+func (w *wallet) verifyDelegation(ctx context.Context, vdr *txs.SupernetValidator, assetID ids.ID) (ids.ID, error) {
+	stakingAssetID, err := w.client.GetStakingAssetID(ctx, vdr.Supernet)
+	if err != nil {
+		return ids.Empty, err
+	}
+	switch assetID {
+	case ids.Empty:
+		assetID = stakingAssetID
+	case stakingAssetID:
+	default:
+		return ids.Empty, fmt.Errorf("%w: supernet %s stakes %s but %s was provided",
+			ErrWrongStakingAsset,
+			vdr.Supernet,
+			stakingAssetID,
+			assetID,
+		)
+	}
+
+	terms, err := w.client.GetValidatorDelegationTerms(ctx, vdr.Supernet, vdr.NodeID)
+	if err != nil {
+		return ids.Empty, err
+	}
+	if availableWeight := uint64(terms.AvailableWeight); vdr.Wght > availableWeight {
+		return ids.Empty, fmt.Errorf("%w: delegating %d to %s but only %d is available",
+			ErrInsufficientCapacity,
+			vdr.Wght,
+			vdr.NodeID,
+			availableWeight,
+		)
+	}
+	return assetID, nil
+}
+
+// stakingAssetID returns the staking asset of [supernetID] known by the wallet,
+// without querying the node.
+func (w *wallet) stakingAssetID(ctx context.Context, supernetID ids.ID) (ids.ID, error) {
+	if supernetID == constants.PrimaryNetworkID {
+		return w.builder.Context().JUNEAssetID, nil
+	}
+
+	assetID, err := w.Backend.GetSupernetStakingAsset(ctx, supernetID)
+	if err == database.ErrNotFound {
+		return ids.Empty, fmt.Errorf("%w: supernet %s", ErrUnknownStakingAsset, supernetID)
+	}
+	return assetID, err
+}
+
+func (w *wallet) BuildAddSupernetValidatorTx(
+	vdr *txs.SupernetValidator,
+	options ...common.Option,
+) (*BuiltTx, error) {
+	utx, err := w.builder.NewAddSupernetValidatorTx(vdr, options...)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := w.burnedJUNE(utx.Ins, utx.Outs)
+	if err != nil {
+		return nil, err
+	}
+	return &BuiltTx{
+		Tx:     utx,
+		Fee:    fee,
+		Inputs: utx.InputIDs(),
+	}, nil
+}
+
+func (w *wallet) BuildExportTx(
+	chainID ids.ID,
+	outputs []*avax.TransferableOutput,
+	options ...common.Option,
+) (*BuiltTx, error) {
+	utx, err := w.builder.NewExportTx(chainID, outputs, options...)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := w.burnedJUNE(utx.Ins, utx.Outs, utx.ExportedOutputs)
+	if err != nil {
+		return nil, err
+	}
+	return &BuiltTx{
+		Tx:     utx,
+		Fee:    fee,
+		Inputs: utx.InputIDs(),
+	}, nil
+}
+
+// burnedJUNE returns the amount of JUNE consumed by [ins] that isn't produced
+// by any of [outs].
+func (w *wallet) burnedJUNE(
+	ins []*avax.TransferableInput,
+	outs ...[]*avax.TransferableOutput,
+) (uint64, error) {
+	juneAssetID := w.builder.Context().JUNEAssetID
+
+	var (
+		consumed uint64
+		err      error
+	)
+	for _, in := range ins {
+		if in.AssetID() != juneAssetID {
+			continue
+		}
+		consumed, err = math.Add64(consumed, in.Input().Amount())
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	var produced uint64
+	for _, outs := range outs {
+		for _, out := range outs {
+			if out.AssetID() != juneAssetID {
+				continue
+			}
+			produced, err = math.Add64(produced, out.Output().Amount())
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	return math.Sub(consumed, produced)
+}
+
+func (w *wallet) MinBalanceForStake(vdr *txs.SupernetValidator, assetID ids.ID) (map[ids.ID]uint64, error) {
+	context := w.builder.Context()
+	fee := context.AddSupernetValidatorFee
+	if vdr.Supernet == constants.PrimaryNetworkID {
+		fee = context.AddPrimaryNetworkValidatorFee
+	}
+
+	minBalance := map[ids.ID]uint64{
+		assetID: vdr.Wght,
+	}
+	juneBalance, err := math.Add64(minBalance[context.JUNEAssetID], fee)
+	if err != nil {
+		return nil, err
+	}
+	minBalance[context.JUNEAssetID] = juneBalance
+	return minBalance, nil
+}
+
+func (w *wallet) VerifyReceived(
+	txID ids.ID,
+	expectedAmount uint64,
+	assetID ids.ID,
+	toAddr ids.ShortID,
+	options ...common.Option,
+) (bool, error) {
+	ops := common.NewOptions(options)
+	ctx := ops.Context()
+	txStatus, err := w.client.GetTxStatus(ctx, txID)
+	if err != nil {
+		return false, err
+	}
+	if txStatus.Status != status.Committed {
+		return false, nil
+	}
+
+	txBytes, err := w.client.GetTx(ctx, txID)
+	if err != nil {
+		return false, err
+	}
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return false, err
+	}
+
+	for _, out := range tx.Unsigned.Outputs() {
+		if out.AssetID() != assetID {
+			continue
+		}
+
+		// Locked outputs can't be spent yet, so they aren't considered to be
+		// received.
+		transferOut, ok := out.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			continue
+		}
+		// Outputs shared with other addresses could be spent by them, so they
+		// aren't considered to be received either.
+		owners := transferOut.OutputOwners
+		if transferOut.Amt == expectedAmount &&
+			owners.Locktime == 0 &&
+			owners.Threshold == 1 &&
+			len(owners.Addrs) == 1 &&
+			owners.Addrs[0] == toAddr {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (w *wallet) IssueUnsignedTx(
 	utx txs.UnsignedTx,
 	options ...common.Option,
 ) (*txs.Tx, error) {
 	ops := common.NewOptions(options)
+	tx, err := w.sign(utx, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, w.IssueTx(tx, options...)
+}
+
+// sign signs [utx] with the keys of the wallet along with the supernet control
+// keys provided with [common.WithSupernetAuthKeys].
+func (w *wallet) sign(utx txs.UnsignedTx, ops *common.Options) (*txs.Tx, error) {
 	ctx := ops.Context()
 	tx, err := walletsigner.SignUnsigned(ctx, w.signer, utx)
 	if err != nil {
 		return nil, err
 	}
 
-	return tx, w.IssueTx(tx, options...)
+	if kc := ops.SupernetAuthKeys(); kc != nil {
+		if err := walletsigner.New(kc, w.Backend).Sign(ctx, tx); err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
 }
 
 func (w *wallet) IssueTx(
 	tx *txs.Tx,
 	options ...common.Option,
 ) error {
+	if err := verifyTxSize(tx); err != nil {
+		return err
+	}
+
 	ops := common.NewOptions(options)
 	ctx := ops.Context()
 	txID, err := w.client.IssueTx(ctx, tx.Bytes())
@@ -545,15 +1206,24 @@ func (w *wallet) IssueTx(
 	}
 
 	if ops.AssumeDecided() {
-		return w.Backend.AcceptTx(ctx, tx)
+		return w.acceptTx(ctx, tx)
 	}
 
-	txStatus, err := w.client.AwaitTxDecided(ctx, txID, ops.PollFrequency())
+	confirmCtx, cancel := ops.ConfirmContext()
+	defer cancel()
+
+	txStatus, err := w.client.AwaitTxDecided(confirmCtx, txID, ops.PollFrequency())
+	if ops.ConfirmDeadlineExceeded(err) {
+		if txStatus == nil {
+			return fmt.Errorf("%w: last status %s", common.ErrConfirmDeadlineExceeded, status.Unknown)
+		}
+		return fmt.Errorf("%w: last status %s", common.ErrConfirmDeadlineExceeded, txStatus.Status)
+	}
 	if err != nil {
 		return err
 	}
 
-	if err := w.Backend.AcceptTx(ctx, tx); err != nil {
+	if err := w.acceptTx(ctx, tx); err != nil {
 		return err
 	}
 
@@ -562,3 +1232,404 @@ func (w *wallet) IssueTx(
 	}
 	return nil
 }
+
+func (w *wallet) IssueWithSigner(
+	buildFn func(builder.Builder) (txs.UnsignedTx, error),
+	signFn SignFunc,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	ops := common.NewOptions(options)
+	utx, err := buildFn(w.builder)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := signWithFunc(ops.Context(), w.Backend, utx, signFn)
+	if err != nil {
+		return nil, err
+	}
+	return tx, w.IssueTx(tx, options...)
+}
+
+func (w *wallet) PrepareSignedTx(
+	buildFn func(builder.Builder) (txs.UnsignedTx, error),
+	options ...common.Option,
+) (*txs.Tx, error) {
+	ops := common.NewOptions(options)
+	ctx := ops.Context()
+	utx, err := buildFn(w.builder)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := w.sign(utx, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	w.preparedLock.Lock()
+	defer w.preparedLock.Unlock()
+
+	txID := tx.ID()
+	if _, ok := w.prepared[txID]; ok {
+		return tx, nil
+	}
+
+	w.backendLock.Lock()
+	defer w.backendLock.Unlock()
+
+	// Imported UTXOs aren't held on the P-chain, so only the UTXOs that are
+	// tracked by the backend are reserved.
+	var utxos []*avax.UTXO
+	for utxoID := range tx.Unsigned.InputIDs() {
+		utxo, err := w.Backend.GetUTXO(ctx, constants.PlatformChainID, utxoID)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			w.addUTXOs(utxos)
+			return nil, err
+		}
+		if err := w.Backend.RemoveUTXO(ctx, constants.PlatformChainID, utxoID); err != nil {
+			w.addUTXOs(utxos)
+			return nil, err
+		}
+		utxos = append(utxos, utxo)
+	}
+
+	prepared := &preparedTx{
+		utxos: utxos,
+	}
+	prepared.timer = time.AfterFunc(ops.ReservationTimeout(), func() {
+		w.preparedLock.Lock()
+		defer w.preparedLock.Unlock()
+
+		if w.prepared[txID] != prepared {
+			return
+		}
+		delete(w.prepared, txID)
+		w.restoreUTXOs(prepared.utxos)
+	})
+	w.prepared[txID] = prepared
+	return tx, nil
+}
+
+func (w *wallet) ReleasePrepared(
+	tx *txs.Tx,
+	options ...common.Option,
+) error {
+	if err := verifyTxSize(tx); err != nil {
+		return err
+	}
+
+	// Once the tx is sent, it may be accepted even if the issuance fails, so
+	// its reservation is dropped without restoring its inputs.
+	if !w.pruneReservation(tx.ID()) {
+		return fmt.Errorf("%w: %s", ErrNotPrepared, tx.ID())
+	}
+	return w.IssueTx(tx, options...)
+}
+
+func (w *wallet) RebroadcastWithBumpedFee(
+	tx *txs.Tx,
+	newFee uint64,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	ops := common.NewOptions(options)
+	ctx := ops.Context()
+
+	minFee, err := w.minFee(tx.Unsigned)
+	if err != nil {
+		return nil, err
+	}
+	if newFee < minFee {
+		return nil, fmt.Errorf("%w: %d is below the minimum fee of %d", ErrFeeTooLow, newFee, minFee)
+	}
+
+	// Work on a copy of [tx] so that it isn't modified if the fee can't be
+	// bumped.
+	bumpedTx, err := txs.Parse(txs.Codec, tx.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	utx := bumpedTx.Unsigned
+
+	oldFee := utx.ConsumedValue(w.builder.Context().JUNEAssetID)
+	if newFee <= oldFee {
+		return nil, fmt.Errorf("%w: %d isn't higher than the paid fee of %d", ErrFeeTooLow, newFee, oldFee)
+	}
+
+	missingUTXOs, err := w.missingInputs(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	changeOut, err := w.changeOutput(ctx, utx, missingUTXOs, ops)
+	if err != nil {
+		return nil, err
+	}
+	feeIncrease := newFee - oldFee
+	if changeOut.Amt <= feeIncrease {
+		return nil, fmt.Errorf("%w: change of %d can't pay a fee increase of %d", ErrNoChangeOutput, changeOut.Amt, feeIncrease)
+	}
+	changeOut.Amt -= feeIncrease
+	avax.SortTransferableOutputs(utx.Outputs(), txs.Codec)
+
+	// The backend is only modified once the fee is known to be bumpable.
+	if err := w.restoreInputs(ctx, tx, missingUTXOs); err != nil {
+		return nil, err
+	}
+
+	bumpedTx, err = w.sign(utx, ops)
+	if err != nil {
+		return nil, err
+	}
+	return bumpedTx, w.IssueTx(bumpedTx, options...)
+}
+
+// missingInputs returns the UTXOs consumed by [tx] that are missing from the
+// backend, which happens if [tx] was issued with [common.WithAssumeDecided].
+// They are fetched from the txs that produced them, without modifying the
+// backend.
+func (w *wallet) missingInputs(ctx context.Context, tx *txs.Tx) (map[ids.ID]*avax.UTXO, error) {
+	utx, ok := tx.Unsigned.(interface{ InputUTXOs() []*avax.UTXOID })
+	if !ok {
+		return nil, nil
+	}
+
+	utxos := make(map[ids.ID]*avax.UTXO)
+	for _, utxoID := range utx.InputUTXOs() {
+		inputID := utxoID.InputID()
+		_, err := w.Backend.GetUTXO(ctx, constants.PlatformChainID, inputID)
+		if err == nil {
+			continue
+		}
+		if err != database.ErrNotFound {
+			return nil, err
+		}
+
+		producerBytes, err := w.client.GetTx(ctx, utxoID.TxID)
+		if err != nil {
+			return nil, err
+		}
+		producer, err := txs.Parse(txs.Codec, producerBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		producedUTXOs := producer.UTXOs()
+		if int(utxoID.OutputIndex) >= len(producedUTXOs) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownInput, inputID)
+		}
+		utxos[inputID] = producedUTXOs[utxoID.OutputIndex]
+	}
+	return utxos, nil
+}
+
+// restoreInputs undoes the acceptance of [tx] assumed when it was issued with
+// [common.WithAssumeDecided]. The UTXOs consumed by [tx] are still unspent, so
+// [missingUTXOs] are added back, while the UTXOs [tx] was assumed to produce
+// are removed.
+func (w *wallet) restoreInputs(ctx context.Context, tx *txs.Tx, missingUTXOs map[ids.ID]*avax.UTXO) error {
+	if len(missingUTXOs) == 0 {
+		return nil
+	}
+
+	w.backendLock.Lock()
+	defer w.backendLock.Unlock()
+
+	for _, utxo := range tx.UTXOs() {
+		if err := w.Backend.RemoveUTXO(ctx, constants.PlatformChainID, utxo.InputID()); err != nil {
+			return err
+		}
+	}
+	w.addUTXOs(maps.Values(missingUTXOs))
+	return nil
+}
+
+// minFee returns the fee currently required to issue [utx].
+func (w *wallet) minFee(utx txs.UnsignedTx) (uint64, error) {
+	context := w.builder.Context()
+	switch utx := utx.(type) {
+	case *txs.BaseTx, *txs.ImportTx, *txs.ExportTx, *txs.RemoveSupernetValidatorTx, *txs.TransferSupernetOwnershipTx:
+		return context.BaseTxFee, nil
+	case *txs.AddValidatorTx:
+		return context.AddPrimaryNetworkValidatorFee, nil
+	case *txs.AddDelegatorTx:
+		return context.AddPrimaryNetworkDelegatorFee, nil
+	case *txs.AddSupernetValidatorTx:
+		return context.AddSupernetValidatorFee, nil
+	case *txs.CreateChainTx:
+		return context.CreateBlockchainTxFee, nil
+	case *txs.CreateSupernetTx:
+		return context.CreateSupernetTxFee, nil
+	case *txs.TransformSupernetTx:
+		return context.TransformSupernetTxFee, nil
+	case *txs.AddPermissionlessValidatorTx:
+		if utx.Supernet == constants.PrimaryNetworkID {
+			return context.AddPrimaryNetworkValidatorFee, nil
+		}
+		return context.AddSupernetValidatorFee, nil
+	case *txs.AddPermissionlessDelegatorTx:
+		if utx.Supernet == constants.PrimaryNetworkID {
+			return context.AddPrimaryNetworkDelegatorFee, nil
+		}
+		return context.AddSupernetDelegatorFee, nil
+	default:
+		return 0, fmt.Errorf("%w: %T", errUnsupportedTxType, utx)
+	}
+}
+
+// changeOutput returns the largest JUNE change output of [utx]. The inputs of
+// [utx] are looked up in [missingUTXOs] if they aren't in the backend.
+//
+// See [Wallet.RebroadcastWithBumpedFee] for how change outputs are identified.
+func (w *wallet) changeOutput(
+	ctx context.Context,
+	utx txs.UnsignedTx,
+	missingUTXOs map[ids.ID]*avax.UTXO,
+	ops *common.Options,
+) (*secp256k1fx.TransferOutput, error) {
+	var changeOwners []*secp256k1fx.OutputOwners
+	if changeOwner := ops.ChangeOwner(nil); changeOwner != nil {
+		changeOwners = append(changeOwners, changeOwner)
+	} else {
+		for utxoID := range utx.InputIDs() {
+			utxo, ok := missingUTXOs[utxoID]
+			if !ok {
+				var err error
+				utxo, err = w.Backend.GetUTXO(ctx, constants.PlatformChainID, utxoID)
+				if err == database.ErrNotFound {
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			out := utxo.Out
+			if lockedOut, ok := out.(*stakeable.LockOut); ok {
+				out = lockedOut.TransferableOut
+			}
+			if out, ok := out.(*secp256k1fx.TransferOutput); ok {
+				changeOwners = append(changeOwners, &out.OutputOwners)
+			}
+		}
+	}
+
+	juneAssetID := w.builder.Context().JUNEAssetID
+	var changeOut *secp256k1fx.TransferOutput
+	for _, out := range utx.Outputs() {
+		if out.AssetID() != juneAssetID {
+			continue
+		}
+		transferOut, ok := out.Out.(*secp256k1fx.TransferOutput)
+		if !ok || transferOut.Locktime != 0 {
+			continue
+		}
+		isChange := slices.ContainsFunc(changeOwners, func(owner *secp256k1fx.OutputOwners) bool {
+			return owner.Threshold == transferOut.Threshold && slices.Equal(owner.Addrs, transferOut.Addrs)
+		})
+		if isChange && (changeOut == nil || transferOut.Amt > changeOut.Amt) {
+			changeOut = transferOut
+		}
+	}
+	if changeOut == nil {
+		return nil, ErrNoChangeOutput
+	}
+	return changeOut, nil
+}
+
+func (w *wallet) PruneSpent(
+	confirmedTxIDs []ids.ID,
+	options ...common.Option,
+) error {
+	ops := common.NewOptions(options)
+	ctx := ops.Context()
+	for _, txID := range confirmedTxIDs {
+		if w.pruneReservation(txID) {
+			continue
+		}
+
+		txBytes, err := w.client.GetTx(ctx, txID)
+		if err != nil {
+			return err
+		}
+		tx, err := txs.Parse(txs.Codec, txBytes)
+		if err != nil {
+			return err
+		}
+		if err := w.removeUTXOs(ctx, tx.Unsigned.InputIDs()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneReservation drops the reservation held for [txID], if any, without
+// restoring its reserved UTXOs. Returns true if a reservation was dropped.
+func (w *wallet) pruneReservation(txID ids.ID) bool {
+	w.preparedLock.Lock()
+	defer w.preparedLock.Unlock()
+
+	prepared, ok := w.prepared[txID]
+	if !ok {
+		return false
+	}
+	prepared.timer.Stop()
+	delete(w.prepared, txID)
+	return true
+}
+
+// restoreUTXOs makes [utxos] available to be spent by the wallet again.
+//
+// Invariant: [preparedLock] is held.
+func (w *wallet) restoreUTXOs(utxos []*avax.UTXO) {
+	w.backendLock.Lock()
+	defer w.backendLock.Unlock()
+
+	w.addUTXOs(utxos)
+}
+
+// addUTXOs adds [utxos] to the P-chain UTXOs of the backend.
+//
+// Invariant: [backendLock] is held.
+func (w *wallet) addUTXOs(utxos []*avax.UTXO) {
+	for _, utxo := range utxos {
+		// Adding a UTXO to the in-memory backend can't fail.
+		_ = w.Backend.AddUTXO(context.Background(), constants.PlatformChainID, utxo)
+	}
+}
+
+// removeUTXOs removes [utxoIDs] from the P-chain UTXOs of the backend.
+func (w *wallet) removeUTXOs(ctx context.Context, utxoIDs set.Set[ids.ID]) error {
+	w.backendLock.Lock()
+	defer w.backendLock.Unlock()
+
+	for utxoID := range utxoIDs {
+		if err := w.Backend.RemoveUTXO(ctx, constants.PlatformChainID, utxoID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// acceptTx updates the backend to reflect the acceptance of [tx].
+func (w *wallet) acceptTx(ctx context.Context, tx *txs.Tx) error {
+	w.backendLock.Lock()
+	defer w.backendLock.Unlock()
+
+	return w.Backend.AcceptTx(ctx, tx)
+}
+
+// verifyTxSize returns an error if [tx] is larger than the network accepts.
+func verifyTxSize(tx *txs.Tx) error {
+	if txSize := len(tx.Bytes()); txSize > mempool.MaxTxSize {
+		return &common.TxTooLargeError{
+			TxID:    tx.ID(),
+			Size:    txSize,
+			MaxSize: mempool.MaxTxSize,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1411 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/database"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/constants"
+	"github.com/Juneo-io/juneogo/utils/crypto/bls"
+	"github.com/Juneo-io/juneogo/utils/crypto/keychain"
+	"github.com/Juneo-io/juneogo/utils/crypto/secp256k1"
+	"github.com/Juneo-io/juneogo/utils/hashing"
+	"github.com/Juneo-io/juneogo/utils/json"
+	"github.com/Juneo-io/juneogo/utils/rpc"
+	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/utils/units"
+	"github.com/Juneo-io/juneogo/vms/components/avax"
+	"github.com/Juneo-io/juneogo/vms/platformvm"
+	"github.com/Juneo-io/juneogo/vms/platformvm/reward"
+	"github.com/Juneo-io/juneogo/vms/platformvm/stakeable"
+	"github.com/Juneo-io/juneogo/vms/platformvm/status"
+	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
+	"github.com/Juneo-io/juneogo/vms/platformvm/txs/mempool"
+	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
+	"github.com/Juneo-io/juneogo/wallet/chain/p/builder"
+	"github.com/Juneo-io/juneogo/wallet/chain/p/signer"
+	"github.com/Juneo-io/juneogo/wallet/supernet/primary/common"
+
+	vmsigner "github.com/Juneo-io/juneogo/vms/platformvm/signer"
+)
+
+func TestMinBalanceForStake(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		builder  = builder.New(set.Of(utxoAddr), testContext, backend)
+		wallet   = NewWallet(builder, nil, nil, backend)
+	)
+
+	supernetAssetID := ids.GenerateTestID()
+	tests := []struct {
+		name       string
+		supernetID ids.ID
+		assetID    ids.ID
+		expected   map[ids.ID]uint64
+	}{
+		{
+			name:       "primary network",
+			supernetID: constants.PrimaryNetworkID,
+			assetID:    juneAssetID,
+			expected: map[ids.ID]uint64{
+				juneAssetID: 2*units.Avax + testContext.AddPrimaryNetworkValidatorFee,
+			},
+		},
+		{
+			name:       "supernet",
+			supernetID: ids.GenerateTestID(),
+			assetID:    supernetAssetID,
+			expected: map[ids.ID]uint64{
+				supernetAssetID: 2 * units.Avax,
+				juneAssetID:     testContext.AddSupernetValidatorFee,
+			},
+		},
+	}
+	for _, test := range tests {
+		minBalance, err := wallet.MinBalanceForStake(&txs.SupernetValidator{
+			Validator: txs.Validator{
+				NodeID: ids.GenerateTestNodeID(),
+				End:    uint64(time.Now().Add(time.Hour).Unix()),
+				Wght:   2 * units.Avax,
+			},
+			Supernet: test.supernetID,
+		}, test.assetID)
+		require.NoError(err, test.name)
+		require.Equal(test.expected, minBalance, test.name)
+	}
+}
+
+// neverDecidedClient accepts every issued tx but never reports it as decided.
+type neverDecidedClient struct {
+	platformvm.Client
+}
+
+func (neverDecidedClient) IssueTx(context.Context, []byte, ...rpc.Option) (ids.ID, error) {
+	return ids.GenerateTestID(), nil
+}
+
+func (neverDecidedClient) AwaitTxDecided(ctx context.Context, _ ids.ID, _ time.Duration, _ ...rpc.Option) (*platformvm.AwaitTxDecidedResponse, error) {
+	<-ctx.Done()
+	return &platformvm.AwaitTxDecidedResponse{
+		GetTxStatusResponse: platformvm.GetTxStatusResponse{Status: status.Processing},
+	}, ctx.Err()
+}
+
+func TestIssueTxConfirmDeadline(t *testing.T) {
+	require := require.New(t)
+
+	backend := NewBackend(testContext, common.NewChainUTXOs(constants.PlatformChainID, common.NewUTXOs()), nil)
+	wallet := NewWallet(nil, nil, neverDecidedClient{}, backend)
+
+	tx := &txs.Tx{Unsigned: &txs.BaseTx{}}
+	require.NoError(tx.Initialize(txs.Codec))
+
+	err := wallet.IssueTx(
+		tx,
+		common.WithConfirmDeadline(time.Now().Add(10*time.Millisecond)),
+	)
+	require.ErrorIs(err, common.ErrConfirmDeadlineExceeded)
+	require.ErrorContains(err, status.Processing.String())
+
+	// Cancelling the provided context isn't reported as a missed deadline.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = wallet.IssueTx(
+		tx,
+		common.WithContext(ctx),
+		common.WithConfirmDeadline(time.Now().Add(time.Hour)),
+	)
+	require.ErrorIs(err, context.Canceled)
+}
+
+func TestIssueTxTooLarge(t *testing.T) {
+	require := require.New(t)
+
+	backend := NewBackend(testContext, common.NewChainUTXOs(constants.PlatformChainID, common.NewUTXOs()), nil)
+	wallet := NewWallet(nil, nil, neverDecidedClient{}, backend)
+
+	tx := &txs.Tx{Unsigned: &txs.BaseTx{
+		BaseTx: avax.BaseTx{
+			Memo: make([]byte, mempool.MaxTxSize),
+		},
+	}}
+	require.NoError(tx.Initialize(txs.Codec))
+
+	err := wallet.IssueTx(tx)
+	require.ErrorIs(err, common.ErrTxTooLarge)
+
+	var txTooLargeErr *common.TxTooLargeError
+	require.ErrorAs(err, &txTooLargeErr)
+	require.Equal(tx.ID(), txTooLargeErr.TxID)
+	require.Equal(len(tx.Bytes()), txTooLargeErr.Size)
+	require.Equal(mempool.MaxTxSize, txTooLargeErr.MaxSize)
+}
+
+func TestIssueTxAwaitAcceptance(t *testing.T) {
+	require := require.New(t)
+
+	backend := NewBackend(testContext, common.NewChainUTXOs(constants.PlatformChainID, common.NewUTXOs()), nil)
+	wallet := NewWallet(nil, nil, neverDecidedClient{}, backend)
+
+	tx := &txs.Tx{Unsigned: &txs.BaseTx{}}
+	require.NoError(tx.Initialize(txs.Codec))
+
+	// Awaiting acceptance overrides assuming the tx is decided.
+	err := wallet.IssueTx(
+		tx,
+		common.WithAssumeDecided(),
+		common.WithAwaitAcceptance(10*time.Millisecond),
+	)
+	require.ErrorIs(err, common.ErrConfirmDeadlineExceeded)
+	require.ErrorContains(err, status.Processing.String())
+
+	// The earliest of the timeout and the deadline is used.
+	err = wallet.IssueTx(
+		tx,
+		common.WithAwaitAcceptance(time.Hour),
+		common.WithConfirmDeadline(time.Now().Add(10*time.Millisecond)),
+	)
+	require.ErrorIs(err, common.ErrConfirmDeadlineExceeded)
+}
+
+// committingClient accepts every issued tx and reports it as committed.
+type committingClient struct {
+	platformvm.Client
+
+	issued  []ids.ID
+	txBytes map[ids.ID][]byte
+}
+
+func (c *committingClient) IssueTx(_ context.Context, txBytes []byte, _ ...rpc.Option) (ids.ID, error) {
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return ids.Empty, err
+	}
+	c.issued = append(c.issued, tx.ID())
+	if c.txBytes == nil {
+		c.txBytes = make(map[ids.ID][]byte)
+	}
+	c.txBytes[tx.ID()] = txBytes
+	return tx.ID(), nil
+}
+
+func (c *committingClient) GetTx(_ context.Context, txID ids.ID, _ ...rpc.Option) ([]byte, error) {
+	txBytes, ok := c.txBytes[txID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return txBytes, nil
+}
+
+func (c *committingClient) GetTxStatus(_ context.Context, txID ids.ID, _ ...rpc.Option) (*platformvm.GetTxStatusResponse, error) {
+	if _, ok := c.txBytes[txID]; !ok {
+		return &platformvm.GetTxStatusResponse{Status: status.Unknown}, nil
+	}
+	return &platformvm.GetTxStatusResponse{Status: status.Committed}, nil
+}
+
+func (*committingClient) AwaitTxDecided(context.Context, ids.ID, time.Duration, ...rpc.Option) (*platformvm.AwaitTxDecidedResponse, error) {
+	return &platformvm.AwaitTxDecidedResponse{
+		GetTxStatusResponse: platformvm.GetTxStatusResponse{Status: status.Committed},
+		Confirmations:       1,
+	}, nil
+}
+
+func TestPrepareSignedTx(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey)
+		client   = &committingClient{}
+		wallet   = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+
+		outputs = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: testContext.JUNEAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.MilliAvax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxoAddr},
+				},
+			},
+		}}
+		buildBaseTx = func(b builder.Builder) (txs.UnsignedTx, error) {
+			return b.NewBaseTx(outputs)
+		}
+	)
+
+	tx, err := wallet.PrepareSignedTx(buildBaseTx)
+	require.NoError(err)
+	require.Empty(client.issued)
+
+	// The inputs of the prepared tx are reserved.
+	inputIDs := tx.Unsigned.InputIDs()
+	for utxoID := range inputIDs {
+		_, err := backend.GetUTXO(context.Background(), constants.PlatformChainID, utxoID)
+		require.ErrorIs(err, database.ErrNotFound)
+	}
+
+	// Transactions built in the meantime don't reuse the reserved inputs.
+	utx, err := wallet.Builder().NewBaseTx(outputs)
+	require.NoError(err)
+	require.False(inputIDs.Overlaps(utx.InputIDs()))
+
+	require.NoError(wallet.ReleasePrepared(tx))
+	require.Equal([]ids.ID{tx.ID()}, client.issued)
+
+	// A prepared tx can only be released once.
+	err = wallet.ReleasePrepared(tx)
+	require.ErrorIs(err, ErrNotPrepared)
+}
+
+func TestIssueWithSigner(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet without any keys
+		utxoAddr = utxosKey.Address()
+		client   = &committingClient{}
+		wallet   = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(secp256k1fx.NewKeychain(), backend),
+			client,
+			backend,
+		)
+
+		outputs = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: testContext.JUNEAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.MilliAvax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{testKeys[0].Address()},
+				},
+			},
+		}}
+		buildBaseTx = func(b builder.Builder) (txs.UnsignedTx, error) {
+			return b.NewBaseTx(outputs)
+		}
+		signWith = func(key *secp256k1.PrivateKey) SignFunc {
+			return func(addrs []ids.ShortID, hashes [][]byte) ([][]byte, error) {
+				sigs := make([][]byte, len(hashes))
+				for i, hash := range hashes {
+					require.Equal(utxoAddr, addrs[i])
+					sig, err := key.SignHash(hash)
+					if err != nil {
+						return nil, err
+					}
+					sigs[i] = sig
+				}
+				return sigs, nil
+			}
+		}
+	)
+
+	_, err := wallet.IssueWithSigner(buildBaseTx, signWith(testKeys[0]))
+	require.ErrorIs(err, ErrInvalidSignature)
+
+	_, err = wallet.IssueWithSigner(buildBaseTx, func([]ids.ShortID, [][]byte) ([][]byte, error) {
+		return nil, nil
+	})
+	require.ErrorIs(err, keychain.ErrInvalidNumSignatures)
+	require.Empty(client.issued)
+
+	tx, err := wallet.IssueWithSigner(buildBaseTx, signWith(utxosKey))
+	require.NoError(err)
+	require.Equal([]ids.ID{tx.ID()}, client.issued)
+
+	// Every signature of the tx is provided by the key owning the UTXOs.
+	unsignedHash := hashing.ComputeHash256(tx.Unsigned.Bytes())
+	require.NotEmpty(tx.Creds)
+	for _, credIntf := range tx.Creds {
+		cred := credIntf.(*secp256k1fx.Credential)
+		for _, sig := range cred.Sigs {
+			pk, err := secp256k1.RecoverPublicKeyFromHash(unsignedHash, sig[:])
+			require.NoError(err)
+			require.Equal(utxoAddr, pk.Address())
+		}
+	}
+}
+
+func TestPrepareSignedTxReservationTimeout(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey)
+		client   = &committingClient{}
+		wallet   = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+	)
+
+	tx, err := wallet.PrepareSignedTx(
+		func(b builder.Builder) (txs.UnsignedTx, error) {
+			return b.NewBaseTx(nil)
+		},
+		common.WithReservationTimeout(time.Millisecond),
+	)
+	require.NoError(err)
+
+	// Once the reservation expires, the inputs can be spent again.
+	require.Eventually(func() bool {
+		for utxoID := range tx.Unsigned.InputIDs() {
+			if _, err := backend.GetUTXO(context.Background(), constants.PlatformChainID, utxoID); err != nil {
+				return false
+			}
+		}
+		return true
+	}, time.Second, time.Millisecond)
+
+	err = wallet.ReleasePrepared(tx)
+	require.ErrorIs(err, ErrNotPrepared)
+	require.Empty(client.issued)
+}
+
+func TestReleasePreparedIssuanceFailure(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey)
+		wallet   = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			neverDecidedClient{},
+			backend,
+		)
+	)
+
+	tx, err := wallet.PrepareSignedTx(
+		func(b builder.Builder) (txs.UnsignedTx, error) {
+			return b.NewBaseTx(nil)
+		},
+		common.WithReservationTimeout(10*time.Millisecond),
+	)
+	require.NoError(err)
+
+	err = wallet.ReleasePrepared(
+		tx,
+		common.WithConfirmDeadline(time.Now().Add(10*time.Millisecond)),
+	)
+	require.ErrorIs(err, common.ErrConfirmDeadlineExceeded)
+
+	// The tx was sent, so its reservation is released without restoring its
+	// inputs, which may already be spent.
+	err = wallet.ReleasePrepared(tx)
+	require.ErrorIs(err, ErrNotPrepared)
+
+	time.Sleep(20 * time.Millisecond)
+	for utxoID := range tx.Unsigned.InputIDs() {
+		_, err := backend.GetUTXO(context.Background(), constants.PlatformChainID, utxoID)
+		require.ErrorIs(err, database.ErrNotFound)
+	}
+}
+
+func TestPruneSpent(t *testing.T) {
+	var (
+		require = require.New(t)
+		ctx     = context.Background()
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey)
+		client   = &committingClient{}
+		wallet   = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+	)
+
+	// Prepare a tx whose reservation will be released by pruning.
+	preparedTx, err := wallet.PrepareSignedTx(
+		func(b builder.Builder) (txs.UnsignedTx, error) {
+			return b.NewBaseTx(nil)
+		},
+		common.WithReservationTimeout(10*time.Millisecond),
+	)
+	require.NoError(err)
+
+	// Issue a tx without going through the wallet, so the local UTXO set
+	// isn't updated.
+	utx, err := wallet.Builder().NewBaseTx(nil)
+	require.NoError(err)
+	tx, err := signer.SignUnsigned(ctx, wallet.Signer(), utx)
+	require.NoError(err)
+	txID, err := client.IssueTx(ctx, tx.Bytes())
+	require.NoError(err)
+
+	inputIDs := tx.Unsigned.InputIDs()
+	for utxoID := range inputIDs {
+		_, err := backend.GetUTXO(ctx, constants.PlatformChainID, utxoID)
+		require.NoError(err)
+	}
+	require.False(inputIDs.Overlaps(preparedTx.Unsigned.InputIDs()))
+
+	require.NoError(wallet.PruneSpent([]ids.ID{txID, preparedTx.ID()}))
+
+	// The spent UTXOs are no longer in the local set.
+	for utxoID := range inputIDs {
+		_, err := backend.GetUTXO(ctx, constants.PlatformChainID, utxoID)
+		require.ErrorIs(err, database.ErrNotFound)
+	}
+
+	// The reservation was released without restoring the prepared inputs.
+	err = wallet.ReleasePrepared(preparedTx)
+	require.ErrorIs(err, ErrNotPrepared)
+
+	time.Sleep(20 * time.Millisecond)
+	for utxoID := range preparedTx.Unsigned.InputIDs() {
+		_, err := backend.GetUTXO(ctx, constants.PlatformChainID, utxoID)
+		require.ErrorIs(err, database.ErrNotFound)
+	}
+
+	// Unknown txs can't be pruned.
+	err = wallet.PruneSpent([]ids.ID{ids.GenerateTestID()})
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestBuildTx(t *testing.T) {
+	var (
+		require = require.New(t)
+		ctx     = context.Background()
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+
+		supernetID      = ids.GenerateTestID()
+		supernetAuthKey = testKeys[0]
+		supernets       = map[ids.ID]*txs.Tx{
+			supernetID: {
+				Unsigned: &txs.CreateSupernetTx{
+					Owner: &secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{supernetAuthKey.Address()},
+					},
+				},
+			},
+		}
+		backend = NewBackend(testContext, chainUTXOs, supernets)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey, supernetAuthKey)
+		client   = &committingClient{}
+		wallet   = NewWallet(
+			builder.New(set.Of(utxoAddr, supernetAuthKey.Address()), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+
+		// The change owner is fixed so that building and issuing pick the same
+		// one out of the wallet's addresses.
+		changeOwner = common.WithChangeOwner(&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{utxoAddr},
+		})
+		destinationChainID = ids.GenerateTestID()
+		exportedOutputs    = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: testContext.JUNEAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 7 * units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxoAddr},
+				},
+			},
+		}}
+	)
+
+	builtVdrTx, err := wallet.BuildAddSupernetValidatorTx(&txs.SupernetValidator{
+		Validator: txs.Validator{
+			NodeID: ids.GenerateTestNodeID(),
+			End:    uint64(time.Now().Add(time.Hour).Unix()),
+		},
+		Supernet: supernetID,
+	})
+	require.NoError(err)
+	require.IsType(&txs.AddSupernetValidatorTx{}, builtVdrTx.Tx)
+	require.Equal(testContext.AddSupernetValidatorFee, builtVdrTx.Fee)
+	require.Equal(builtVdrTx.Tx.InputIDs(), builtVdrTx.Inputs)
+
+	builtExportTx, err := wallet.BuildExportTx(destinationChainID, exportedOutputs, changeOwner)
+	require.NoError(err)
+	require.Equal(testContext.BaseTxFee, builtExportTx.Fee)
+	require.NotEmpty(builtExportTx.Inputs)
+
+	// Building a tx doesn't consume its inputs nor issue it.
+	require.Empty(client.issued)
+	for utxoID := range builtExportTx.Inputs {
+		_, err := backend.GetUTXO(ctx, constants.PlatformChainID, utxoID)
+		require.NoError(err)
+	}
+
+	// Issuing the same tx produces the same unsigned tx.
+	issuedExportTx, err := wallet.IssueExportTx(destinationChainID, exportedOutputs, changeOwner)
+	require.NoError(err)
+
+	builtBytes, err := txs.Codec.Marshal(txs.CodecVersion, &builtExportTx.Tx)
+	require.NoError(err)
+	issuedBytes, err := txs.Codec.Marshal(txs.CodecVersion, &issuedExportTx.Unsigned)
+	require.NoError(err)
+	require.Equal(builtBytes, issuedBytes)
+}
+
+func TestRebroadcastWithBumpedFee(t *testing.T) {
+	var (
+		require = require.New(t)
+		ctx     = context.Background()
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey)
+		client   = &committingClient{}
+		wallet   = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+
+		outputs = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: testContext.JUNEAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 7 * units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{testKeys[0].Address()},
+				},
+			},
+		}}
+		feeIncrease = units.MilliAvax
+		newFee      = testContext.BaseTxFee + feeIncrease
+	)
+
+	// Build and sign a tx that is never accepted.
+	utx, err := wallet.Builder().NewBaseTx(outputs)
+	require.NoError(err)
+	tx, err := signer.SignUnsigned(ctx, wallet.Signer(), utx)
+	require.NoError(err)
+
+	_, err = wallet.RebroadcastWithBumpedFee(tx, testContext.BaseTxFee-1)
+	require.ErrorIs(err, ErrFeeTooLow)
+	_, err = wallet.RebroadcastWithBumpedFee(tx, testContext.BaseTxFee)
+	require.ErrorIs(err, ErrFeeTooLow)
+
+	bumpedTx, err := wallet.RebroadcastWithBumpedFee(tx, newFee)
+	require.NoError(err)
+	require.Equal([]ids.ID{bumpedTx.ID()}, client.issued)
+	require.Equal(tx.Unsigned.InputIDs(), bumpedTx.Unsigned.InputIDs())
+
+	// The recipient's output is unchanged while the change is reduced by the
+	// fee increase.
+	amountOwnedBy := func(outs []*avax.TransferableOutput, addr ids.ShortID) uint64 {
+		for _, out := range outs {
+			owners := out.Out.(*secp256k1fx.TransferOutput).OutputOwners
+			if owners.Addrs[0] == addr {
+				return out.Out.Amount()
+			}
+		}
+		return 0
+	}
+	bumpedUTX := bumpedTx.Unsigned.(*txs.BaseTx)
+	recipientAddr := testKeys[0].Address()
+	require.Equal(amountOwnedBy(utx.Outs, recipientAddr), amountOwnedBy(bumpedUTX.Outs, recipientAddr))
+	require.Equal(amountOwnedBy(utx.Outs, utxoAddr)-feeIncrease, amountOwnedBy(bumpedUTX.Outs, utxoAddr))
+	require.Equal(newFee, bumpedUTX.ConsumedValue(testContext.JUNEAssetID))
+}
+
+// mempoolClient adds every issued tx to a real mempool before accepting it.
+type mempoolClient struct {
+	committingClient
+
+	mempool mempool.Mempool
+}
+
+func (c *mempoolClient) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (ids.ID, error) {
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return ids.Empty, err
+	}
+	if err := c.mempool.Add(tx); err != nil {
+		return ids.Empty, err
+	}
+	return c.committingClient.IssueTx(ctx, txBytes, options...)
+}
+
+func TestRebroadcastWithBumpedFeeAfterAssumeDecided(t *testing.T) {
+	var (
+		require = require.New(t)
+		ctx     = context.Background()
+
+		utxosKey  = testKeys[1]
+		utxoAddr  = utxosKey.Address()
+		fundingTx = &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    testContext.NetworkID,
+			BlockchainID: constants.PlatformChainID,
+			Outs: []*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: testContext.JUNEAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 10 * units.Avax,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{utxoAddr},
+					},
+				},
+			}},
+		}}}
+	)
+	require.NoError(fundingTx.Initialize(txs.Codec))
+
+	var (
+		// backend
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: fundingTx.UTXOs(),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet
+		kc     = secp256k1fx.NewKeychain(utxosKey)
+		client = &mempoolClient{
+			committingClient: committingClient{
+				txBytes: map[ids.ID][]byte{
+					fundingTx.ID(): fundingTx.Bytes(),
+				},
+			},
+		}
+		wallet = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+
+		outputs = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: testContext.JUNEAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{testKeys[0].Address()},
+				},
+			},
+		}}
+	)
+
+	var err error
+	client.mempool, err = mempool.New("mempool", prometheus.NewRegistry(), nil, mempool.DefaultMaxSize, testContext.JUNEAssetID)
+	require.NoError(err)
+
+	// The tx is issued but never accepted, while the wallet assumes it is.
+	tx, err := wallet.IssueBaseTx(outputs, common.WithAssumeDecided())
+	require.NoError(err)
+	fundingUTXOID := fundingTx.UTXOs()[0].InputID()
+	_, err = backend.GetUTXO(ctx, constants.PlatformChainID, fundingUTXOID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// A fee increase the change can't pay leaves the backend untouched.
+	assumedUTXOs, err := backend.UTXOs(ctx, constants.PlatformChainID)
+	require.NoError(err)
+	_, err = wallet.RebroadcastWithBumpedFee(tx, 10*units.Avax)
+	require.ErrorIs(err, ErrNoChangeOutput)
+	utxos, err := backend.UTXOs(ctx, constants.PlatformChainID)
+	require.NoError(err)
+	require.ElementsMatch(assumedUTXOs, utxos)
+
+	bumpedTx, err := wallet.RebroadcastWithBumpedFee(tx, testContext.BaseTxFee+units.MilliAvax)
+	require.NoError(err)
+	require.Equal(tx.Unsigned.InputIDs(), bumpedTx.Unsigned.InputIDs())
+
+	// The bumped tx replaced the stuck tx in the mempool.
+	require.Equal(1, client.mempool.Len())
+	_, ok := client.mempool.Get(bumpedTx.ID())
+	require.True(ok)
+	require.ErrorIs(client.mempool.GetDropReason(tx.ID()), mempool.ErrReplaced)
+
+	// The backend only holds the UTXOs produced by the bumped tx.
+	utxos, err = backend.UTXOs(ctx, constants.PlatformChainID)
+	require.NoError(err)
+	require.Len(utxos, len(bumpedTx.UTXOs()))
+	for _, utxo := range bumpedTx.UTXOs() {
+		_, err := backend.GetUTXO(ctx, constants.PlatformChainID, utxo.InputID())
+		require.NoError(err)
+	}
+}
+
+// transformedSupernetClient reports every supernet as already transformed.
+type transformedSupernetClient struct {
+	platformvm.Client
+
+	transformationTxID ids.ID
+}
+
+func (c transformedSupernetClient) GetSupernet(context.Context, ids.ID, ...rpc.Option) (platformvm.GetSupernetClientResponse, error) {
+	return platformvm.GetSupernetClientResponse{
+		IsPermissioned:             false,
+		SupernetTransformationTxID: c.transformationTxID,
+	}, nil
+}
+
+func TestIssueTransformSupernetTxAlreadyTransformed(t *testing.T) {
+	require := require.New(t)
+
+	client := transformedSupernetClient{
+		transformationTxID: ids.GenerateTestID(),
+	}
+	wallet := NewWallet(nil, nil, client, nil)
+
+	supernetID := ids.GenerateTestID()
+	_, err := wallet.IssueTransformSupernetTx(
+		supernetID,
+		ids.GenerateTestID(),
+		units.MegaAvax,                // initial reward pool supply
+		1_0000,                        // start reward share
+		0,                             // start reward time
+		8000,                          // diminishing reward share
+		0,                             // diminishing reward time
+		6000,                          // target reward share
+		0,                             // target reward time
+		1,                             // min validator stake
+		100*units.MegaAvax,            // max validator stake
+		time.Second,                   // min stake duration
+		365*24*time.Hour,              // max stake duration
+		2_0000,                        // stake period reward share
+		0,                             // min delegation fee
+		0,                             // max delegation fee
+		1,                             // min delegator stake
+		5,                             // max validator weight factor
+		.80*reward.PercentDenominator, // uptime requirement
+		common.WithSupernetCheck(),
+	)
+	require.ErrorIs(err, ErrSupernetAlreadyTransformed)
+	require.ErrorContains(err, client.transformationTxID.String())
+}
+
+// delegationTermsClient reports a fixed staking asset and available delegation
+// weight for every validator.
+type delegationTermsClient struct {
+	committingClient
+
+	stakingAssetID  ids.ID
+	availableWeight uint64
+}
+
+func (c *delegationTermsClient) GetStakingAssetID(context.Context, ids.ID, ...rpc.Option) (ids.ID, error) {
+	return c.stakingAssetID, nil
+}
+
+func (c *delegationTermsClient) GetValidatorDelegationTerms(context.Context, ids.ID, ids.NodeID, ...rpc.Option) (*platformvm.GetValidatorDelegationTermsReply, error) {
+	return &platformvm.GetValidatorDelegationTermsReply{
+		AvailableWeight: json.Uint64(c.availableWeight),
+	}, nil
+}
+
+func TestIssueAddPermissionlessDelegatorTx(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey)
+		client   = &delegationTermsClient{
+			stakingAssetID:  juneAssetID,
+			availableWeight: units.Avax,
+		}
+		wallet = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+
+		vdr = &txs.SupernetValidator{
+			Validator: txs.Validator{
+				NodeID: ids.GenerateTestNodeID(),
+				End:    uint64(time.Now().Add(time.Hour).Unix()),
+				Wght:   2 * units.Avax,
+			},
+			Supernet: constants.PrimaryNetworkID,
+		}
+		rewardsOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{utxoAddr},
+		}
+	)
+
+	_, err := wallet.IssueAddPermissionlessDelegatorTx(
+		vdr,
+		supernetAssetID,
+		rewardsOwner,
+		common.WithDelegationCheck(),
+	)
+	require.ErrorIs(err, ErrWrongStakingAsset)
+
+	_, err = wallet.IssueAddPermissionlessDelegatorTx(
+		vdr,
+		juneAssetID,
+		rewardsOwner,
+		common.WithDelegationCheck(),
+	)
+	require.ErrorIs(err, ErrInsufficientCapacity)
+	require.Empty(client.issued)
+
+	// The staking asset of the supernet is used when none is provided.
+	client.availableWeight = vdr.Wght
+	tx, err := wallet.IssueAddPermissionlessDelegatorTx(
+		vdr,
+		ids.Empty,
+		rewardsOwner,
+		common.WithDelegationCheck(),
+	)
+	require.NoError(err)
+	require.Equal([]ids.ID{tx.ID()}, client.issued)
+
+	utx := tx.Unsigned.(*txs.AddPermissionlessDelegatorTx)
+	for _, out := range utx.StakeOuts {
+		require.Equal(juneAssetID, out.AssetID())
+	}
+}
+
+func TestIssueAddPermissionlessDelegatorTxWithoutDelegationCheck(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey)
+		client   = &delegationTermsClient{
+			stakingAssetID: supernetAssetID,
+		}
+		wallet = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+
+		vdr = &txs.SupernetValidator{
+			Validator: txs.Validator{
+				NodeID: ids.GenerateTestNodeID(),
+				End:    uint64(time.Now().Add(time.Hour).Unix()),
+				Wght:   2 * units.Avax,
+			},
+			Supernet: constants.PrimaryNetworkID,
+		}
+		rewardsOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{utxoAddr},
+		}
+	)
+
+	// The node isn't queried, so neither the staking asset nor the capacity
+	// it reports is checked and JUNE is staked on the primary network.
+	tx, err := wallet.IssueAddPermissionlessDelegatorTx(vdr, ids.Empty, rewardsOwner)
+	require.NoError(err)
+	require.Equal([]ids.ID{tx.ID()}, client.issued)
+
+	utx := tx.Unsigned.(*txs.AddPermissionlessDelegatorTx)
+	for _, out := range utx.StakeOuts {
+		require.Equal(juneAssetID, out.AssetID())
+	}
+
+	// The staking asset of a supernet isn't known by the wallet until the
+	// transformation of the supernet is synced.
+	vdr.Supernet = ids.GenerateTestID()
+	_, err = wallet.IssueAddPermissionlessDelegatorTx(vdr, ids.Empty, rewardsOwner)
+	require.ErrorIs(err, ErrUnknownStakingAsset)
+}
+
+// stakeLimitsClient reports the same delegation fee bounds for every supernet.
+type stakeLimitsClient struct {
+	committingClient
+
+	minDelegationFee uint32
+	maxDelegationFee uint32
+}
+
+func (c *stakeLimitsClient) GetStakeLimits(context.Context, ids.ID, ...rpc.Option) (*platformvm.GetMinStakeReply, error) {
+	return &platformvm.GetMinStakeReply{
+		MinDelegationFee: json.Uint32(c.minDelegationFee),
+		MaxDelegationFee: json.Uint32(c.maxDelegationFee),
+	}, nil
+}
+
+func TestIssueAddPermissionlessValidatorTx(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey)
+		client   = &stakeLimitsClient{
+			minDelegationFee: 20_000,
+			maxDelegationFee: reward.PercentDenominator,
+		}
+		wallet = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+
+		vdr = &txs.SupernetValidator{
+			Validator: txs.Validator{
+				NodeID: ids.GenerateTestNodeID(),
+				End:    uint64(time.Now().Add(time.Hour).Unix()),
+				Wght:   2 * units.Avax,
+			},
+			Supernet: constants.PrimaryNetworkID,
+		}
+		rewardsOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{utxoAddr},
+		}
+	)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+	pop := vmsigner.NewProofOfPossession(sk)
+
+	// Primary network validators must register a BLS key.
+	_, err = wallet.IssueAddPermissionlessValidatorTx(vdr, &vmsigner.Empty{}, juneAssetID, rewardsOwner, rewardsOwner, client.minDelegationFee)
+	require.ErrorIs(err, ErrInvalidSigner)
+
+	invalidPoP := *pop
+	invalidPoP.ProofOfPossession = [bls.SignatureLen]byte{}
+	_, err = wallet.IssueAddPermissionlessValidatorTx(vdr, &invalidPoP, juneAssetID, rewardsOwner, rewardsOwner, client.minDelegationFee)
+	require.ErrorIs(err, ErrInvalidSigner)
+
+	_, err = wallet.IssueAddPermissionlessValidatorTx(
+		vdr,
+		pop,
+		juneAssetID,
+		rewardsOwner,
+		rewardsOwner,
+		client.minDelegationFee-1,
+		common.WithDelegationFeeCheck(),
+	)
+	require.ErrorIs(err, ErrInvalidDelegationFee)
+	require.Empty(client.issued)
+
+	// The delegation fee bounds are only checked by the node if requested.
+	tx, err := wallet.IssueAddPermissionlessValidatorTx(vdr, pop, juneAssetID, rewardsOwner, rewardsOwner, client.minDelegationFee-1)
+	require.NoError(err)
+	require.Equal([]ids.ID{tx.ID()}, client.issued)
+
+	utx := tx.Unsigned.(*txs.AddPermissionlessValidatorTx)
+	require.Equal(client.minDelegationFee-1, utx.DelegationShares)
+}
+
+func TestIssueRemoveSupernetValidatorTxMultisig(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+
+		supernetID       = ids.GenerateTestID()
+		supernetAuthKeys = []*secp256k1.PrivateKey{testKeys[2], testKeys[3], testKeys[4]}
+		supernetOwner    = &secp256k1fx.OutputOwners{
+			Threshold: 2,
+			Addrs: []ids.ShortID{
+				supernetAuthKeys[0].Address(),
+				supernetAuthKeys[1].Address(),
+				supernetAuthKeys[2].Address(),
+			},
+		}
+		supernets = map[ids.ID]*txs.Tx{
+			supernetID: {
+				Unsigned: &txs.CreateSupernetTx{
+					Owner: supernetOwner,
+				},
+			},
+		}
+
+		backend = NewBackend(testContext, chainUTXOs, supernets)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey)
+		client   = &committingClient{}
+		wallet   = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+
+		nodeID = ids.GenerateTestNodeID()
+	)
+
+	// A single control key doesn't satisfy the threshold of the supernet.
+	_, err := wallet.IssueRemoveSupernetValidatorTx(
+		nodeID,
+		supernetID,
+		common.WithSupernetAuthKeys(secp256k1fx.NewKeychain(supernetAuthKeys[0])),
+	)
+	require.ErrorIs(err, builder.ErrInsufficientAuthorization)
+	require.Empty(client.issued)
+
+	tx, err := wallet.IssueRemoveSupernetValidatorTx(
+		nodeID,
+		supernetID,
+		common.WithSupernetAuthKeys(secp256k1fx.NewKeychain(supernetAuthKeys[0], supernetAuthKeys[2])),
+	)
+	require.NoError(err)
+	require.Equal([]ids.ID{tx.ID()}, client.issued)
+
+	// The supernet authorization is signed by both of the provided keys.
+	unsignedBytes, err := txs.Codec.Marshal(txs.CodecVersion, &tx.Unsigned)
+	require.NoError(err)
+
+	utx := tx.Unsigned.(*txs.RemoveSupernetValidatorTx)
+	supernetAuth := utx.SupernetAuth.(*secp256k1fx.Input)
+	supernetCred := tx.Creds[len(tx.Creds)-1].(*secp256k1fx.Credential)
+	require.Len(supernetCred.Sigs, len(supernetAuth.SigIndices))
+
+	signers := set.NewSet[ids.ShortID](len(supernetCred.Sigs))
+	for _, sig := range supernetCred.Sigs {
+		pk, err := secp256k1.RecoverPublicKey(unsignedBytes, sig[:])
+		require.NoError(err)
+		signers.Add(pk.Address())
+	}
+	require.Equal(set.Of(supernetAuthKeys[0].Address(), supernetAuthKeys[2].Address()), signers)
+}
+
+// timestampClient reports a fixed chain timestamp.
+type timestampClient struct {
+	committingClient
+
+	timestamp time.Time
+}
+
+func (c *timestampClient) GetTimestamp(context.Context, ...rpc.Option) (time.Time, error) {
+	return c.timestamp, nil
+}
+
+func TestIssueLockedTransferTx(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey)
+		client   = &timestampClient{
+			timestamp: time.Unix(1_000_000, 0),
+		}
+		wallet = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+
+		owner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{testKeys[0].Address()},
+		}
+		amount   = 2 * units.Avax
+		locktime = uint64(client.timestamp.Add(time.Hour).Unix())
+	)
+
+	_, err := wallet.IssueLockedTransferTx(juneAssetID, 0, locktime, owner)
+	require.ErrorIs(err, ErrZeroAmount)
+
+	_, err = wallet.IssueLockedTransferTx(juneAssetID, amount, uint64(client.timestamp.Unix()), owner)
+	require.ErrorIs(err, ErrLocktimeNotInFuture)
+	require.Empty(client.issued)
+
+	tx, err := wallet.IssueLockedTransferTx(juneAssetID, amount, locktime, owner)
+	require.NoError(err)
+	require.Equal([]ids.ID{tx.ID()}, client.issued)
+
+	utx, ok := tx.Unsigned.(*txs.BaseTx)
+	require.True(ok)
+	var numLockedOuts int
+	for _, out := range utx.Outs {
+		lockedOut, ok := out.Out.(*stakeable.LockOut)
+		if !ok {
+			continue
+		}
+		transferOut, ok := lockedOut.TransferableOut.(*secp256k1fx.TransferOutput)
+		require.True(ok)
+		require.Equal(juneAssetID, out.AssetID())
+		require.Equal(locktime, lockedOut.Locktime)
+		require.Equal(amount, transferOut.Amt)
+		require.Equal(owner.Addrs, transferOut.Addrs)
+		numLockedOuts++
+	}
+	require.Equal(1, numLockedOuts)
+}
+
+func TestVerifyReceived(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey)
+		client   = &committingClient{}
+		wallet   = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+
+		merchantAddr = testKeys[0].Address()
+		amount       = 3 * units.Avax
+	)
+
+	tx, err := wallet.IssueBaseTx([]*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: juneAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: amount,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{merchantAddr},
+			},
+		},
+	}})
+	require.NoError(err)
+
+	received, err := wallet.VerifyReceived(tx.ID(), amount, juneAssetID, merchantAddr)
+	require.NoError(err)
+	require.True(received)
+
+	received, err = wallet.VerifyReceived(tx.ID(), amount-1, juneAssetID, merchantAddr)
+	require.NoError(err)
+	require.False(received)
+
+	received, err = wallet.VerifyReceived(tx.ID(), amount, supernetAssetID, merchantAddr)
+	require.NoError(err)
+	require.False(received)
+
+	received, err = wallet.VerifyReceived(tx.ID(), amount, juneAssetID, utxoAddr)
+	require.NoError(err)
+	require.False(received)
+
+	// Txs that aren't committed aren't considered to be received.
+	received, err = wallet.VerifyReceived(ids.GenerateTestID(), amount, juneAssetID, merchantAddr)
+	require.NoError(err)
+	require.False(received)
+
+	// An output the payer can also spend isn't considered to be received.
+	sharedTx, err := wallet.IssueBaseTx([]*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: juneAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: amount,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{merchantAddr, utxoAddr},
+			},
+		},
+	}})
+	require.NoError(err)
+
+	received, err = wallet.VerifyReceived(sharedTx.ID(), amount, juneAssetID, merchantAddr)
+	require.NoError(err)
+	require.False(received)
+}
+
+// validatorsClient reports [nodeID] as a current validator of [supernetID].
+type validatorsClient struct {
+	committingClient
+
+	supernetID ids.ID
+	nodeID     ids.NodeID
+}
+
+func (c *validatorsClient) GetCurrentValidators(_ context.Context, supernetID ids.ID, nodeIDs []ids.NodeID, _ ...rpc.Option) ([]platformvm.ClientPermissionlessValidator, error) {
+	if supernetID != c.supernetID || !slices.Contains(nodeIDs, c.nodeID) {
+		return nil, nil
+	}
+	return []platformvm.ClientPermissionlessValidator{{
+		ClientStaker: platformvm.ClientStaker{
+			NodeID: c.nodeID,
+		},
+	}}, nil
+}
+
+func (*validatorsClient) GetPendingValidators(context.Context, ids.ID, []ids.NodeID, ...rpc.Option) ([]interface{}, []interface{}, error) {
+	return nil, nil, nil
+}
+
+func TestIssueRemoveSupernetValidatorTxCheckValidator(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: makeTestUTXOs(utxosKey),
+		})
+
+		supernetID       = ids.GenerateTestID()
+		supernetAuthKey  = testKeys[0]
+		supernetAuthAddr = supernetAuthKey.Address()
+		supernets        = map[ids.ID]*txs.Tx{
+			supernetID: {
+				Unsigned: &txs.CreateSupernetTx{
+					Owner: &secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{supernetAuthAddr},
+					},
+				},
+			},
+		}
+		backend = NewBackend(testContext, chainUTXOs, supernets)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey, supernetAuthKey)
+		client   = &validatorsClient{
+			supernetID: supernetID,
+			nodeID:     ids.GenerateTestNodeID(),
+		}
+		wallet = NewWallet(
+			builder.New(set.Of(utxoAddr, supernetAuthAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+	)
+
+	_, err := wallet.IssueRemoveSupernetValidatorTx(
+		ids.GenerateTestNodeID(),
+		supernetID,
+		common.WithValidatorCheck(),
+	)
+	require.ErrorIs(err, ErrNotValidator)
+	require.Empty(client.issued)
+
+	tx, err := wallet.IssueRemoveSupernetValidatorTx(
+		client.nodeID,
+		supernetID,
+		common.WithValidatorCheck(),
+	)
+	require.NoError(err)
+	require.Equal([]ids.ID{tx.ID()}, client.issued)
+
+	utx, ok := tx.Unsigned.(*txs.RemoveSupernetValidatorTx)
+	require.True(ok)
+	require.Equal(client.nodeID, utx.NodeID)
+	require.Equal(supernetID, utx.Supernet)
+}
@@ -55,6 +55,22 @@ func (w *walletWithOptions) IssueBaseTx(
 	)
 }
 
+func (w *walletWithOptions) IssueLockedTransferTx(
+	assetID ids.ID,
+	amount uint64,
+	locktime uint64,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueLockedTransferTx(
+		assetID,
+		amount,
+		locktime,
+		owner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *walletWithOptions) IssueAddValidatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -123,6 +139,26 @@ func (w *walletWithOptions) IssueCreateChainTx(
 	)
 }
 
+func (w *walletWithOptions) IssueCreateChainAndAwait(
+	supernetID ids.ID,
+	genesis []byte,
+	vmID ids.ID,
+	fxIDs []ids.ID,
+	chainName string,
+	chainAssetID ids.ID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.wallet.IssueCreateChainAndAwait(
+		supernetID,
+		genesis,
+		vmID,
+		fxIDs,
+		chainName,
+		chainAssetID,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *walletWithOptions) IssueCreateSupernetTx(
 	owner *secp256k1fx.OutputOwners,
 	options ...common.Option,
@@ -249,6 +285,48 @@ func (w *walletWithOptions) IssueAddPermissionlessDelegatorTx(
 	)
 }
 
+func (w *walletWithOptions) BuildAddSupernetValidatorTx(
+	vdr *txs.SupernetValidator,
+	options ...common.Option,
+) (*BuiltTx, error) {
+	return w.wallet.BuildAddSupernetValidatorTx(
+		vdr,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) BuildExportTx(
+	chainID ids.ID,
+	outputs []*avax.TransferableOutput,
+	options ...common.Option,
+) (*BuiltTx, error) {
+	return w.wallet.BuildExportTx(
+		chainID,
+		outputs,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) MinBalanceForStake(vdr *txs.SupernetValidator, assetID ids.ID) (map[ids.ID]uint64, error) {
+	return w.wallet.MinBalanceForStake(vdr, assetID)
+}
+
+func (w *walletWithOptions) VerifyReceived(
+	txID ids.ID,
+	expectedAmount uint64,
+	assetID ids.ID,
+	toAddr ids.ShortID,
+	options ...common.Option,
+) (bool, error) {
+	return w.wallet.VerifyReceived(
+		txID,
+		expectedAmount,
+		assetID,
+		toAddr,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *walletWithOptions) IssueUnsignedTx(
 	utx txs.UnsignedTx,
 	options ...common.Option,
@@ -268,3 +346,61 @@ func (w *walletWithOptions) IssueTx(
 		common.UnionOptions(w.options, options)...,
 	)
 }
+
+func (w *walletWithOptions) IssueWithSigner(
+	buildFn func(builder.Builder) (txs.UnsignedTx, error),
+	signFn SignFunc,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueWithSigner(
+		func(b builder.Builder) (txs.UnsignedTx, error) {
+			return buildFn(builder.NewWithOptions(b, w.options...))
+		},
+		signFn,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) PrepareSignedTx(
+	buildFn func(builder.Builder) (txs.UnsignedTx, error),
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.PrepareSignedTx(
+		func(b builder.Builder) (txs.UnsignedTx, error) {
+			return buildFn(builder.NewWithOptions(b, w.options...))
+		},
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) ReleasePrepared(
+	tx *txs.Tx,
+	options ...common.Option,
+) error {
+	return w.wallet.ReleasePrepared(
+		tx,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) RebroadcastWithBumpedFee(
+	tx *txs.Tx,
+	newFee uint64,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.RebroadcastWithBumpedFee(
+		tx,
+		newFee,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) PruneSpent(
+	confirmedTxIDs []ids.ID,
+	options ...common.Option,
+) error {
+	return w.wallet.PruneSpent(
+		confirmedTxIDs,
+		common.UnionOptions(w.options, options)...,
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 
 	"github.com/Juneo-io/juneogo/ids"
 	"github.com/Juneo-io/juneogo/utils"
@@ -22,8 +23,11 @@ import (
 )
 
 var (
-	errNoChangeAddress   = errors.New("no possible change address")
-	errInsufficientFunds = errors.New("insufficient funds")
+	ErrUnknownUTXO     = errors.New("unknown UTXO")
+	ErrUnspendableUTXO = errors.New("UTXO can't be spent by the provided addresses")
+
+	ErrNoChangeAddress   = common.ErrNoChangeAddress
+	ErrInsufficientFunds = common.ErrInsufficientFunds
 
 	fxIndexToID = map[uint32]ids.ID{
 		SECP256K1FxIndex: secp256k1fx.ID,
@@ -60,6 +64,8 @@ type Builder interface {
 	//
 	// - [outputs] specifies all the recipients and amounts that should be sent
 	//   from this transaction.
+	//
+	// The fee can be raised above the base tx fee with [common.WithFee].
 	NewBaseTx(
 		outputs []*avax.TransferableOutput,
 		options ...common.Option,
@@ -145,11 +151,27 @@ type Builder interface {
 		options ...common.Option,
 	) (*txs.ImportTx, error)
 
+	// NewImportTxFromUTXOs creates an import transaction that consumes exactly
+	// the UTXOs [utxoIDs] and imports the funds to [to]. An error is returned
+	// if one of the UTXOs isn't available or can't be spent.
+	//
+	// - [chainID] specifies the chain to be importing funds from.
+	// - [utxoIDs] specifies the UTXOs to import.
+	// - [to] specifies where to send the imported funds to.
+	NewImportTxFromUTXOs(
+		chainID ids.ID,
+		utxoIDs []ids.ID,
+		to *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.ImportTx, error)
+
 	// NewExportTx creates an export transaction that attempts to send all the
 	// provided [outputs] to the requested [chainID].
 	//
 	// - [chainID] specifies the chain to be exporting the funds to.
 	// - [outputs] specifies the outputs to send to the [chainID].
+	//
+	// The fee can be raised above the base tx fee with [common.WithFee].
 	NewExportTx(
 		chainID ids.ID,
 		outputs []*avax.TransferableOutput,
@@ -208,8 +230,14 @@ func (b *builder) NewBaseTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.BaseTx, error) {
+	ops := common.NewOptions(options)
+	fee, err := ops.Fee(b.context.BaseTxFee)
+	if err != nil {
+		return nil, err
+	}
+
 	toBurn := map[ids.ID]uint64{
-		b.context.JUNEAssetID: b.context.BaseTxFee,
+		b.context.JUNEAssetID: fee,
 	}
 	for _, out := range outputs {
 		assetID := out.AssetID()
@@ -220,7 +248,9 @@ func (b *builder) NewBaseTx(
 		toBurn[assetID] = amountToBurn
 	}
 
-	ops := common.NewOptions(options)
+	if err := verifyMemo(ops); err != nil {
+		return nil, err
+	}
 	inputs, changeOutputs, err := b.spend(toBurn, ops)
 	if err != nil {
 		return nil, err
@@ -249,6 +279,9 @@ func (b *builder) NewCreateAssetTx(
 		b.context.JUNEAssetID: b.context.CreateAssetTxFee,
 	}
 	ops := common.NewOptions(options)
+	if err := verifyMemo(ops); err != nil {
+		return nil, err
+	}
 	inputs, outputs, err := b.spend(toBurn, ops)
 	if err != nil {
 		return nil, err
@@ -291,6 +324,9 @@ func (b *builder) NewOperationTx(
 		b.context.JUNEAssetID: b.context.BaseTxFee,
 	}
 	ops := common.NewOptions(options)
+	if err := verifyMemo(ops); err != nil {
+		return nil, err
+	}
 	inputs, outputs, err := b.spend(toBurn, ops)
 	if err != nil {
 		return nil, err
@@ -371,6 +407,63 @@ func (b *builder) NewImportTx(
 	if err != nil {
 		return nil, err
 	}
+	return b.newImportTx(chainID, utxos, to, ops)
+}
+
+func (b *builder) NewImportTxFromUTXOs(
+	chainID ids.ID,
+	utxoIDs []ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.ImportTx, error) {
+	ops := common.NewOptions(options)
+	utxos, err := b.backend.UTXOs(ops.Context(), chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	availableUTXOs := make(map[ids.ID]*avax.UTXO, len(utxos))
+	for _, utxo := range utxos {
+		availableUTXOs[utxo.InputID()] = utxo
+	}
+
+	var (
+		addrs           = ops.Addresses(b.addrs)
+		minIssuanceTime = ops.MinIssuanceTime()
+		importedUTXOs   = make([]*avax.UTXO, 0, len(utxoIDs))
+	)
+	for _, utxoID := range utxoIDs {
+		utxo, ok := availableUTXOs[utxoID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s from %s", ErrUnknownUTXO, utxoID, chainID)
+		}
+		// Imported UTXOs are consumed once, even if they are requested
+		// multiple times.
+		delete(availableUTXOs, utxoID)
+
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s has output type %T", ErrUnspendableUTXO, utxoID, utxo.Out)
+		}
+		if _, ok := common.MatchOwners(&out.OutputOwners, addrs, minIssuanceTime); !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnspendableUTXO, utxoID)
+		}
+		importedUTXOs = append(importedUTXOs, utxo)
+	}
+	return b.newImportTx(chainID, importedUTXOs, to, ops)
+}
+
+// newImportTx creates an import transaction that imports the spendable UTXOs
+// of [utxos] from [chainID] to [to].
+func (b *builder) newImportTx(
+	chainID ids.ID,
+	utxos []*avax.UTXO,
+	to *secp256k1fx.OutputOwners,
+	ops *common.Options,
+) (*txs.ImportTx, error) {
+	if err := verifyMemo(ops); err != nil {
+		return nil, err
+	}
 
 	var (
 		addrs           = ops.Addresses(b.addrs)
@@ -419,7 +512,7 @@ func (b *builder) NewImportTx(
 	if len(importedAmounts) == 0 {
 		return nil, fmt.Errorf(
 			"%w: no UTXOs available to import",
-			errInsufficientFunds,
+			ErrInsufficientFunds,
 		)
 	}
 
@@ -475,8 +568,14 @@ func (b *builder) NewExportTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.ExportTx, error) {
+	ops := common.NewOptions(options)
+	fee, err := ops.Fee(b.context.BaseTxFee)
+	if err != nil {
+		return nil, err
+	}
+
 	toBurn := map[ids.ID]uint64{
-		b.context.JUNEAssetID: b.context.BaseTxFee,
+		b.context.JUNEAssetID: fee,
 	}
 	for _, out := range outputs {
 		assetID := out.AssetID()
@@ -487,7 +586,9 @@ func (b *builder) NewExportTx(
 		toBurn[assetID] = amountToBurn
 	}
 
-	ops := common.NewOptions(options)
+	if err := verifyMemo(ops); err != nil {
+		return nil, err
+	}
 	inputs, changeOutputs, err := b.spend(toBurn, ops)
 	if err != nil {
 		return nil, err
@@ -566,13 +667,28 @@ func (b *builder) spend(
 
 	addr, ok := addrs.Peek()
 	if !ok {
-		return nil, nil, errNoChangeAddress
+		return nil, nil, ErrNoChangeAddress
 	}
+
+	// The amounts are decreased as UTXOs are consumed, so the requested
+	// amounts are kept to report any shortfall.
+	requestedToBurn := maps.Clone(amountsToBurn)
 	changeOwner := options.ChangeOwner(&secp256k1fx.OutputOwners{
 		Threshold: 1,
 		Addrs:     []ids.ShortID{addr},
 	})
 
+	if options.UTXOStrategy() == common.MinimalChange {
+		utxos = common.PrioritizeUTXOs(utxos, amountsToBurn, func(utxo *avax.UTXO) (uint64, bool) {
+			out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+			if !ok {
+				return 0, false
+			}
+			_, ok = common.MatchOwners(&out.OutputOwners, addrs, minIssuanceTime)
+			return out.Amt, ok
+		})
+	}
+
 	// Iterate over the UTXOs
 	for _, utxo := range utxos {
 		assetID := utxo.AssetID()
@@ -630,12 +746,11 @@ func (b *builder) spend(
 
 	for assetID, amount := range amountsToBurn {
 		if amount != 0 {
-			return nil, nil, fmt.Errorf(
-				"%w: provided UTXOs need %d more units of asset %q",
-				errInsufficientFunds,
-				amount,
-				assetID,
-			)
+			return nil, nil, &common.InsufficientFundsError{
+				AssetID: assetID,
+				Need:    requestedToBurn[assetID],
+				Have:    requestedToBurn[assetID] - amount,
+			}
 		}
 	}
 
@@ -697,7 +812,7 @@ func (b *builder) mintFTs(
 	for assetID := range outputs {
 		return nil, fmt.Errorf(
 			"%w: provided UTXOs not able to mint asset %q",
-			errInsufficientFunds,
+			ErrInsufficientFunds,
 			assetID,
 		)
 	}
@@ -758,7 +873,7 @@ func (b *builder) mintNFTs(
 	}
 	return nil, fmt.Errorf(
 		"%w: provided UTXOs not able to mint NFT %q",
-		errInsufficientFunds,
+		ErrInsufficientFunds,
 		assetID,
 	)
 }
@@ -816,7 +931,7 @@ func (b *builder) mintProperty(
 	}
 	return nil, fmt.Errorf(
 		"%w: provided UTXOs not able to mint property %q",
-		errInsufficientFunds,
+		ErrInsufficientFunds,
 		assetID,
 	)
 }
@@ -869,13 +984,22 @@ func (b *builder) burnProperty(
 	if len(operations) == 0 {
 		return nil, fmt.Errorf(
 			"%w: provided UTXOs not able to burn property %q",
-			errInsufficientFunds,
+			ErrInsufficientFunds,
 			assetID,
 		)
 	}
 	return operations, nil
 }
 
+// verifyMemo returns an error if the memo set in [ops] is larger than the
+// maximum memo size accepted by the network.
+func verifyMemo(ops *common.Options) error {
+	if memoLen := len(ops.Memo()); memoLen > avax.MaxMemoSize {
+		return fmt.Errorf("%w: %d > %d", avax.ErrMemoTooLarge, memoLen, avax.MaxMemoSize)
+	}
+	return nil
+}
+
 func (b *builder) initCtx(tx txs.UnsignedTx) error {
 	ctx, err := NewSnowContext(
 		b.context.NetworkID,
@@ -149,6 +149,20 @@ func (b *builderWithOptions) NewImportTx(
 	)
 }
 
+func (b *builderWithOptions) NewImportTxFromUTXOs(
+	chainID ids.ID,
+	utxoIDs []ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.ImportTx, error) {
+	return b.builder.NewImportTxFromUTXOs(
+		chainID,
+		utxoIDs,
+		to,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
 func (b *builderWithOptions) NewExportTx(
 	chainID ids.ID,
 	outputs []*avax.TransferableOutput,
@@ -93,6 +93,135 @@ func TestBaseTx(t *testing.T) {
 	require.Equal(outputsToMove[0], outs[1])
 }
 
+func TestBaseTxMemo(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey       = testKeys[1]
+		utxos          = makeTestUTXOs(utxosKey)
+		genericBackend = common.NewDeterministicChainUTXOs(
+			require,
+			map[ids.ID][]*avax.UTXO{
+				jvmChainID: utxos,
+			},
+		)
+		backend = NewBackend(testContext, genericBackend)
+
+		// builder
+		utxoAddr = utxosKey.Address()
+		builder  = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		outputsToMove = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: juneAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 7 * units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxoAddr},
+				},
+			},
+		}}
+	)
+
+	memo := []byte("deposit 1234")
+	utx, err := builder.NewBaseTx(
+		outputsToMove,
+		common.WithMemo(memo),
+	)
+	require.NoError(err)
+	require.Equal(memo, []byte(utx.Memo))
+
+	_, err = builder.NewBaseTx(
+		outputsToMove,
+		common.WithMemo(make([]byte, avax.MaxMemoSize+1)),
+	)
+	require.ErrorIs(err, avax.ErrMemoTooLarge)
+}
+
+func TestBaseTxMinimalChange(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey  = testKeys[1]
+		utxosAddr = utxosKey.Address()
+		utxos     = make([]*avax.UTXO, 0, 5)
+	)
+	for i, amount := range []uint64{
+		20 * units.Avax,
+		5 * units.Avax,
+		3 * units.Avax,
+		units.Avax,
+		500 * units.MilliAvax,
+	} {
+		utxos = append(utxos, &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        ids.Empty.Prefix(uint64(i)),
+				OutputIndex: uint32(i),
+			},
+			Asset: avax.Asset{ID: juneAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: amount,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxosAddr},
+				},
+			},
+		})
+	}
+
+	var (
+		genericBackend = common.NewDeterministicChainUTXOs(
+			require,
+			map[ids.ID][]*avax.UTXO{
+				jvmChainID: utxos,
+			},
+		)
+		backend = NewBackend(testContext, genericBackend)
+
+		// builder
+		builder = builder.New(set.Of(utxosAddr), testContext, backend)
+
+		// data to build the transaction
+		outputsToMove = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: juneAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 8 * units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxosAddr},
+				},
+			},
+		}}
+	)
+
+	utx, err := builder.NewBaseTx(
+		outputsToMove,
+		common.WithUTXOStrategy(common.MinimalChange),
+	)
+	require.NoError(err)
+
+	// The 5, 3 and 0.5 JUNE UTXOs are the closest to the 8 JUNE moved plus
+	// the fee.
+	ins := utx.Ins
+	outs := utx.Outs
+	require.Len(ins, 3)
+	require.Len(outs, 2)
+
+	var consumed uint64
+	for _, in := range ins {
+		consumed += in.In.Amount()
+	}
+	require.Equal(8500*units.MilliAvax, consumed)
+
+	change := outs[0].Out.Amount()
+	require.Less(change, units.Avax)
+	require.Equal(consumed-testContext.BaseTxFee-outputsToMove[0].Out.Amount(), change)
+	require.Equal(outputsToMove[0], outs[1])
+}
+
 func TestCreateAssetTx(t *testing.T) {
 	require := require.New(t)
 
@@ -405,6 +534,72 @@ func TestImportTx(t *testing.T) {
 	require.Equal(expectedConsumed, consumed)
 }
 
+func TestImportTxFromUTXOs(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey       = testKeys[1]
+		utxos          = makeTestUTXOs(utxosKey)
+		sourceChainID  = ids.GenerateTestID()
+		genericBackend = common.NewDeterministicChainUTXOs(
+			require,
+			map[ids.ID][]*avax.UTXO{
+				jvmChainID:    utxos,
+				sourceChainID: utxos,
+			},
+		)
+
+		backend = NewBackend(testContext, genericBackend)
+
+		// builder
+		utxoAddr = utxosKey.Address()
+		xBuilder = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		importKey = testKeys[0]
+		importTo  = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				importKey.Address(),
+			},
+		}
+		largeUTXO = utxos[len(utxos)-1]
+	)
+
+	_, err := xBuilder.NewImportTxFromUTXOs(
+		sourceChainID,
+		[]ids.ID{ids.GenerateTestID()},
+		importTo,
+	)
+	require.ErrorIs(err, builder.ErrUnknownUTXO)
+
+	// NFT mint outputs can't be imported
+	_, err = xBuilder.NewImportTxFromUTXOs(
+		sourceChainID,
+		[]ids.ID{utxos[1].InputID()},
+		importTo,
+	)
+	require.ErrorIs(err, builder.ErrUnspendableUTXO)
+
+	utx, err := xBuilder.NewImportTxFromUTXOs(
+		sourceChainID,
+		[]ids.ID{largeUTXO.InputID()},
+		importTo,
+	)
+	require.NoError(err)
+
+	// only the requested UTXO is imported, and it pays the fee
+	require.Empty(utx.Ins)
+	require.Len(utx.ImportedIns, 1)
+	require.Equal(largeUTXO.InputID(), utx.ImportedIns[0].InputID())
+	require.Len(utx.Outs, 1)
+	require.Equal(
+		largeUTXO.Out.(*secp256k1fx.TransferOutput).Amt-testContext.BaseTxFee,
+		utx.Outs[0].Out.Amount(),
+	)
+}
+
 func TestExportTx(t *testing.T) {
 	var (
 		require = require.New(t)
@@ -456,6 +651,65 @@ func TestExportTx(t *testing.T) {
 	require.Equal(utx.ExportedOuts, exportedOutputs)
 }
 
+func TestExportTxCustomFee(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey       = testKeys[1]
+		utxos          = makeTestUTXOs(utxosKey)
+		genericBackend = common.NewDeterministicChainUTXOs(
+			require,
+			map[ids.ID][]*avax.UTXO{
+				jvmChainID: utxos,
+			},
+		)
+		backend = NewBackend(testContext, genericBackend)
+
+		// builder
+		utxoAddr = utxosKey.Address()
+		builder  = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		supernetID      = ids.GenerateTestID()
+		exportedOutputs = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: juneAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 7 * units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxoAddr},
+				},
+			},
+		}}
+	)
+
+	_, err := builder.NewExportTx(
+		supernetID,
+		exportedOutputs,
+		common.WithFee(testContext.BaseTxFee-1),
+	)
+	require.ErrorIs(err, common.ErrFeeTooLow)
+
+	fee := 2 * testContext.BaseTxFee
+	utx, err := builder.NewExportTx(
+		supernetID,
+		exportedOutputs,
+		common.WithFee(fee),
+	)
+	require.NoError(err)
+
+	var consumed uint64
+	for _, in := range utx.Ins {
+		consumed += in.In.Amount()
+	}
+	for _, out := range utx.Outs {
+		consumed -= out.Out.Amount()
+	}
+	require.Equal(fee+exportedOutputs[0].Out.Amount(), consumed)
+	require.Equal(utx.ExportedOuts, exportedOutputs)
+}
+
 func makeTestUTXOs(utxosKey *secp256k1.PrivateKey) []*avax.UTXO {
 	// Note: we avoid ids.GenerateTestNodeID here to make sure that UTXO IDs won't change
 	// run by run. This simplifies checking what utxos are included in the built txs.
@@ -5,11 +5,13 @@ package x
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/Juneo-io/juneogo/ids"
 	"github.com/Juneo-io/juneogo/snow/choices"
 	"github.com/Juneo-io/juneogo/vms/avm"
 	"github.com/Juneo-io/juneogo/vms/avm/txs"
+	"github.com/Juneo-io/juneogo/vms/avm/txs/mempool"
 	"github.com/Juneo-io/juneogo/vms/components/avax"
 	"github.com/Juneo-io/juneogo/vms/components/verify"
 	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
@@ -18,8 +20,14 @@ import (
 	"github.com/Juneo-io/juneogo/wallet/supernet/primary/common"
 )
 
+// maxDenomination is the largest denomination the X-chain accepts for a new
+// asset.
+const maxDenomination = 32
+
 var (
-	ErrNotAccepted = errors.New("not accepted")
+	ErrNotAccepted          = errors.New("not accepted")
+	ErrEmptySymbol          = errors.New("asset symbol is empty")
+	ErrDenominationTooLarge = fmt.Errorf("asset denomination is larger than %d", maxDenomination)
 
 	_ Wallet = (*wallet)(nil)
 )
@@ -35,6 +43,9 @@ type Wallet interface {
 	//
 	// - [outputs] specifies all the recipients and amounts that should be sent
 	//   from this transaction.
+	//
+	// A memo can be attached with [common.WithMemo]. Memos larger than
+	// [avax.MaxMemoSize] are rejected before the tx is built.
 	IssueBaseTx(
 		outputs []*avax.TransferableOutput,
 		options ...common.Option,
@@ -57,6 +68,24 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// IssueCreateMintableAssetTx creates, signs, and issues a new fungible
+	// asset whose supply can be increased later by [owner].
+	//
+	// - [name] specifies a human readable name for this asset.
+	// - [symbol] specifies a human readable abbreviation for this asset.
+	// - [denomination] specifies how many times the asset can be split.
+	// - [initialSupply] specifies the amount of the asset sent to [owner]. If
+	//   0, no tokens are created.
+	// - [owner] specifies who owns the initial supply and can mint more.
+	IssueCreateMintableAssetTx(
+		name string,
+		symbol string,
+		denomination byte,
+		initialSupply uint64,
+		owner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
 	// IssueOperationTx creates, signs, and issues state changes on the UTXO
 	// set. These state changes may be more complex than simple value transfers.
 	//
@@ -120,6 +149,21 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// IssueImportTxFromUTXOs creates, signs, and issues an import transaction
+	// that consumes exactly the UTXOs [utxoIDs] and imports the funds to [to].
+	// An error is returned if one of the UTXOs isn't available or can't be
+	// spent.
+	//
+	// - [chainID] specifies the chain to be importing funds from.
+	// - [utxoIDs] specifies the UTXOs to import.
+	// - [to] specifies where to send the imported funds to.
+	IssueImportTxFromUTXOs(
+		chainID ids.ID,
+		utxoIDs []ids.ID,
+		to *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
 	// IssueExportTx creates, signs, and issues an export transaction that
 	// attempts to send all the provided [outputs] to the requested [chainID].
 	//
@@ -138,6 +182,9 @@ type Wallet interface {
 	) (*txs.Tx, error)
 
 	// IssueTx issues the signed tx.
+	//
+	// A [common.TxTooLargeError] is returned, without contacting the node, if
+	// the tx is larger than the mempool accepts.
 	IssueTx(
 		tx *txs.Tx,
 		options ...common.Option,
@@ -198,6 +245,43 @@ func (w *wallet) IssueCreateAssetTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueCreateMintableAssetTx(
+	name string,
+	symbol string,
+	denomination byte,
+	initialSupply uint64,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	switch {
+	case symbol == "":
+		return nil, ErrEmptySymbol
+	case denomination > maxDenomination:
+		return nil, ErrDenominationTooLarge
+	}
+
+	outs := []verify.State{
+		&secp256k1fx.MintOutput{
+			OutputOwners: *owner,
+		},
+	}
+	if initialSupply > 0 {
+		outs = append(outs, &secp256k1fx.TransferOutput{
+			Amt:          initialSupply,
+			OutputOwners: *owner,
+		})
+	}
+	return w.IssueCreateAssetTx(
+		name,
+		symbol,
+		denomination,
+		map[uint32][]verify.State{
+			builder.SECP256K1FxIndex: outs,
+		},
+		options...,
+	)
+}
+
 func (w *wallet) IssueOperationTx(
 	operations []*txs.Operation,
 	options ...common.Option,
@@ -268,6 +352,19 @@ func (w *wallet) IssueImportTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueImportTxFromUTXOs(
+	chainID ids.ID,
+	utxoIDs []ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewImportTxFromUTXOs(chainID, utxoIDs, to, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
 func (w *wallet) IssueExportTx(
 	chainID ids.ID,
 	outputs []*avax.TransferableOutput,
@@ -298,9 +395,18 @@ func (w *wallet) IssueTx(
 	tx *txs.Tx,
 	options ...common.Option,
 ) error {
+	txBytes := tx.Bytes()
+	if txSize := len(txBytes); txSize > mempool.MaxTxSize {
+		return &common.TxTooLargeError{
+			TxID:    tx.ID(),
+			Size:    txSize,
+			MaxSize: mempool.MaxTxSize,
+		}
+	}
+
 	ops := common.NewOptions(options)
 	ctx := ops.Context()
-	txID, err := w.client.IssueTx(ctx, tx.Bytes())
+	txID, err := w.client.IssueTx(ctx, txBytes)
 	if err != nil {
 		return err
 	}
@@ -313,7 +419,13 @@ func (w *wallet) IssueTx(
 		return w.backend.AcceptTx(ctx, tx)
 	}
 
-	txStatus, err := w.client.ConfirmTx(ctx, txID, ops.PollFrequency())
+	confirmCtx, cancel := ops.ConfirmContext()
+	defer cancel()
+
+	txStatus, err := w.client.ConfirmTx(confirmCtx, txID, ops.PollFrequency())
+	if ops.ConfirmDeadlineExceeded(err) {
+		return fmt.Errorf("%w: last status %s", common.ErrConfirmDeadlineExceeded, txStatus)
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package x
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/rpc"
+	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/utils/units"
+	"github.com/Juneo-io/juneogo/vms/avm"
+	"github.com/Juneo-io/juneogo/vms/avm/txs"
+	"github.com/Juneo-io/juneogo/vms/components/avax"
+	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
+	"github.com/Juneo-io/juneogo/wallet/chain/x/builder"
+	"github.com/Juneo-io/juneogo/wallet/chain/x/signer"
+	"github.com/Juneo-io/juneogo/wallet/supernet/primary/common"
+)
+
+// issuingClient records the txs issued to it.
+type issuingClient struct {
+	avm.Client
+
+	issued []ids.ID
+}
+
+func (c *issuingClient) IssueTx(_ context.Context, txBytes []byte, _ ...rpc.Option) (ids.ID, error) {
+	tx, err := builder.Parser.ParseTx(txBytes)
+	if err != nil {
+		return ids.Empty, err
+	}
+	c.issued = append(c.issued, tx.ID())
+	return tx.ID(), nil
+}
+
+func TestIssueCreateMintableAssetTx(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey       = testKeys[1]
+		genericBackend = common.NewDeterministicChainUTXOs(
+			require,
+			map[ids.ID][]*avax.UTXO{
+				jvmChainID: makeTestUTXOs(utxosKey),
+			},
+		)
+		backend = NewBackend(testContext, genericBackend)
+
+		// wallet
+		utxoAddr = utxosKey.Address()
+		kc       = secp256k1fx.NewKeychain(utxosKey)
+		client   = &issuingClient{}
+		wallet   = NewWallet(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+			client,
+			backend,
+		)
+
+		owner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{testKeys[0].Address()},
+		}
+		initialSupply = 1234 * units.Avax
+	)
+
+	_, err := wallet.IssueCreateMintableAssetTx("Team Rocket", "", 9, initialSupply, owner)
+	require.ErrorIs(err, ErrEmptySymbol)
+
+	_, err = wallet.IssueCreateMintableAssetTx("Team Rocket", "TR", maxDenomination+1, initialSupply, owner)
+	require.ErrorIs(err, ErrDenominationTooLarge)
+	require.Empty(client.issued)
+
+	tx, err := wallet.IssueCreateMintableAssetTx(
+		"Team Rocket",
+		"TR",
+		9,
+		initialSupply,
+		owner,
+		common.WithAssumeDecided(),
+	)
+	require.NoError(err)
+	require.Equal([]ids.ID{tx.ID()}, client.issued)
+
+	utx, ok := tx.Unsigned.(*txs.CreateAssetTx)
+	require.True(ok)
+	require.Len(utx.States, 1)
+
+	state := utx.States[0]
+	require.Equal(uint32(builder.SECP256K1FxIndex), state.FxIndex)
+	require.Len(state.Outs, 2)
+
+	var (
+		numMintOutputs int
+		transferAmount uint64
+	)
+	for _, out := range state.Outs {
+		switch out := out.(type) {
+		case *secp256k1fx.MintOutput:
+			require.Equal(owner.Addrs, out.Addrs)
+			numMintOutputs++
+		case *secp256k1fx.TransferOutput:
+			require.Equal(owner.Addrs, out.Addrs)
+			transferAmount += out.Amt
+		default:
+			require.FailNow("unexpected output type", "%T", out)
+		}
+	}
+	require.Equal(1, numMintOutputs)
+	require.Equal(initialSupply, transferAmount)
+}
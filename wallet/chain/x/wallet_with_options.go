@@ -68,6 +68,24 @@ func (w *walletWithOptions) IssueCreateAssetTx(
 	)
 }
 
+func (w *walletWithOptions) IssueCreateMintableAssetTx(
+	name string,
+	symbol string,
+	denomination byte,
+	initialSupply uint64,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueCreateMintableAssetTx(
+		name,
+		symbol,
+		denomination,
+		initialSupply,
+		owner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *walletWithOptions) IssueOperationTx(
 	operations []*txs.Operation,
 	options ...common.Option,
@@ -136,6 +154,20 @@ func (w *walletWithOptions) IssueImportTx(
 	)
 }
 
+func (w *walletWithOptions) IssueImportTxFromUTXOs(
+	chainID ids.ID,
+	utxoIDs []ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueImportTxFromUTXOs(
+		chainID,
+		utxoIDs,
+		to,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *walletWithOptions) IssueExportTx(
 	chainID ids.ID,
 	outputs []*avax.TransferableOutput,
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Juneo-io/jeth/ethclient"
 	"github.com/Juneo-io/jeth/plugin/evm"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/Juneo-io/juneogo/api/info"
 	"github.com/Juneo-io/juneogo/codec"
@@ -73,6 +74,21 @@ func FetchState(
 ) (
 	*AVAXState,
 	error,
+) {
+	return fetchState(ctx, uri, addrs, nil)
+}
+
+// fetchState fetches the chain contexts from [uri]. If [utxos] is nil, all the
+// UTXOs referencing [addrs] are fetched into a new UTXO set. Otherwise,
+// [utxos] is used as is and no UTXOs are fetched.
+func fetchState(
+	ctx context.Context,
+	uri string,
+	addrs set.Set[ids.ShortID],
+	utxos walletcommon.UTXOs,
+) (
+	*AVAXState,
+	error,
 ) {
 	infoClient := info.NewClient(uri)
 	pClient := platformvm.NewClient(uri)
@@ -94,7 +110,20 @@ func FetchState(
 		return nil, err
 	}
 
-	utxos := walletcommon.NewUTXOs()
+	state := &AVAXState{
+		PClient: pClient,
+		PCTX:    pCTX,
+		XClient: xClient,
+		XCTX:    xCTX,
+		CClient: cClient,
+		CCTX:    cCTX,
+		UTXOs:   utxos,
+	}
+	if utxos != nil {
+		return state, nil
+	}
+
+	state.UTXOs = walletcommon.NewUTXOs()
 	addrList := addrs.List()
 	chains := []struct {
 		id     ids.ID
@@ -117,31 +146,33 @@ func FetchState(
 			codec:  evm.Codec,
 		},
 	}
+	// The UTXOs of every chain are fetched concurrently. The first failure
+	// cancels [egCtx], which aborts the fetches of the other chains.
+	eg, egCtx := errgroup.WithContext(ctx)
 	for _, destinationChain := range chains {
-		for _, sourceChain := range chains {
-			err = AddAllUTXOs(
-				ctx,
-				utxos,
-				destinationChain.client,
-				destinationChain.codec,
-				sourceChain.id,
-				destinationChain.id,
-				addrList,
-			)
-			if err != nil {
-				return nil, err
+		destinationChain := destinationChain
+		eg.Go(func() error {
+			for _, sourceChain := range chains {
+				err := AddAllUTXOs(
+					egCtx,
+					state.UTXOs,
+					destinationChain.client,
+					destinationChain.codec,
+					sourceChain.id,
+					destinationChain.id,
+					addrList,
+				)
+				if err != nil {
+					return err
+				}
 			}
-		}
+			return nil
+		})
 	}
-	return &AVAXState{
-		PClient: pClient,
-		PCTX:    pCTX,
-		XClient: xClient,
-		XCTX:    xCTX,
-		CClient: cClient,
-		CCTX:    cCTX,
-		UTXOs:   utxos,
-	}, nil
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return state, nil
 }
 
 type EthState struct {
@@ -185,10 +216,17 @@ func FetchEthState(
 	}, nil
 }
 
+// UTXOCursor is the position to resume fetching UTXOs from in
+// [AddAllUTXOsPaginated]. The zero value starts from the first UTXO.
+type UTXOCursor struct {
+	Address ids.ShortID
+	UTXOID  ids.ID
+}
+
 // AddAllUTXOs fetches all the UTXOs referenced by [addresses] that were sent
 // from [sourceChainID] to [destinationChainID] from the [client]. It then uses
 // [codec] to parse the returned UTXOs and it adds them into [utxos]. If [ctx]
-// expires, then the returned error will be immediately reported.
+// expires, the request in flight is aborted and [ctx.Err()] is returned.
 func AddAllUTXOs(
 	ctx context.Context,
 	utxos walletcommon.UTXOs,
@@ -198,43 +236,96 @@ func AddAllUTXOs(
 	destinationChainID ids.ID,
 	addrs []ids.ShortID,
 ) error {
-	var (
-		sourceChainIDStr = sourceChainID.String()
-		startAddr        ids.ShortID
-		startUTXO        ids.ID
-	)
+	var cursor UTXOCursor
 	for {
-		utxosBytes, endAddr, endUTXO, err := client.GetAtomicUTXOs(
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		next, done, err := AddAllUTXOsPaginated(
 			ctx,
+			utxos,
+			client,
+			codec,
+			sourceChainID,
+			destinationChainID,
 			addrs,
-			sourceChainIDStr,
+			cursor,
 			fetchLimit,
-			startAddr,
-			startUTXO,
 		)
 		if err != nil {
+			// Aborted requests report a wrapped transport error, which is
+			// replaced by the cause of the cancellation.
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
 			return err
 		}
+		if done {
+			return nil
+		}
+		cursor = next
+	}
+}
 
-		for _, utxoBytes := range utxosBytes {
-			var utxo avax.UTXO
-			_, err := codec.Unmarshal(utxoBytes, &utxo)
-			if err != nil {
-				return err
-			}
+// AddAllUTXOsPaginated fetches a single page of at most [pageSize] UTXOs
+// referenced by [addrs] that were sent from [sourceChainID] to
+// [destinationChainID], starting at [cursor]. The UTXOs are parsed with [codec]
+// and added into [utxos].
+//
+// The returned cursor can be used to fetch the next page. Once there are no more
+// UTXOs to fetch, done is true and the returned cursor can be used later to
+// fetch only the UTXOs that were added since. [pageSize] should not exceed the
+// maximum page size of the node.
+//
+// Pages are ordered by address and then by UTXO ID, so UTXOs added while
+// paginating don't shift the returned cursor. The UTXO at the cursor may be
+// returned again by the next page, which is harmless as [utxos] ignores UTXOs
+// it already contains.
+func AddAllUTXOsPaginated(
+	ctx context.Context,
+	utxos walletcommon.UTXOs,
+	client UTXOClient,
+	codec codec.Manager,
+	sourceChainID ids.ID,
+	destinationChainID ids.ID,
+	addrs []ids.ShortID,
+	cursor UTXOCursor,
+	pageSize uint32,
+) (UTXOCursor, bool, error) {
+	utxosBytes, endAddr, endUTXO, err := client.GetAtomicUTXOs(
+		ctx,
+		addrs,
+		sourceChainID.String(),
+		pageSize,
+		cursor.Address,
+		cursor.UTXOID,
+	)
+	if err != nil {
+		return cursor, false, err
+	}
 
-			if err := utxos.AddUTXO(ctx, sourceChainID, destinationChainID, &utxo); err != nil {
-				return err
-			}
+	for _, utxoBytes := range utxosBytes {
+		var utxo avax.UTXO
+		_, err := codec.Unmarshal(utxoBytes, &utxo)
+		if err != nil {
+			return cursor, false, err
 		}
 
-		if len(utxosBytes) < fetchLimit {
-			break
+		if err := utxos.AddUTXO(ctx, sourceChainID, destinationChainID, &utxo); err != nil {
+			return cursor, false, err
 		}
+	}
+
+	// An empty page doesn't reference any UTXO, so resuming from the position
+	// reported by the node could skip UTXOs added to earlier addresses.
+	if len(utxosBytes) == 0 {
+		return cursor, true, nil
+	}
 
-		// Update the vars to query the next page of UTXOs.
-		startAddr = endAddr
-		startUTXO = endUTXO
+	next := UTXOCursor{
+		Address: endAddr,
+		UTXOID:  endUTXO,
 	}
-	return nil
+	return next, uint32(len(utxosBytes)) < pageSize, nil
 }
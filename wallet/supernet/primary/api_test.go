@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/database/memdb"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/constants"
+	"github.com/Juneo-io/juneogo/utils/rpc"
+	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/vms/components/avax"
+	"github.com/Juneo-io/juneogo/vms/platformvm"
+	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
+	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
+
+	walletcommon "github.com/Juneo-io/juneogo/wallet/supernet/primary/common"
+)
+
+// testUTXOClient serves the UTXOs of [state] with the same pagination as a
+// node.
+type testUTXOClient struct {
+	state avax.UTXOState
+}
+
+func (c *testUTXOClient) GetAtomicUTXOs(
+	_ context.Context,
+	addrs []ids.ShortID,
+	_ string,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	_ ...rpc.Option,
+) ([][]byte, ids.ShortID, ids.ID, error) {
+	utxos, endAddr, endUTXOID, err := avax.GetPaginatedUTXOs(
+		c.state,
+		set.Of(addrs...),
+		startAddress,
+		startUTXOID,
+		int(limit),
+	)
+	if err != nil {
+		return nil, ids.ShortID{}, ids.Empty, err
+	}
+
+	utxosBytes := make([][]byte, len(utxos))
+	for i, utxo := range utxos {
+		utxosBytes[i], err = txs.Codec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return nil, ids.ShortID{}, ids.Empty, err
+		}
+	}
+	return utxosBytes, endAddr, endUTXOID, nil
+}
+
+func newTestUTXO(addr ids.ShortID) *avax.UTXO {
+	return &avax.UTXO{
+		UTXOID: avax.UTXOID{
+			TxID: ids.GenerateTestID(),
+		},
+		Asset: avax.Asset{ID: ids.GenerateTestID()},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr},
+			},
+		},
+	}
+}
+
+func TestAddAllUTXOsPaginated(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	state, err := avax.NewUTXOState(memdb.New(), txs.Codec, false)
+	require.NoError(err)
+	client := &testUTXOClient{state: state}
+
+	addrs := []ids.ShortID{ids.GenerateTestShortID(), ids.GenerateTestShortID()}
+	var expected []*avax.UTXO
+	for _, addr := range addrs {
+		for i := 0; i < 3; i++ {
+			utxo := newTestUTXO(addr)
+			require.NoError(state.PutUTXO(utxo))
+			expected = append(expected, utxo)
+		}
+	}
+
+	var (
+		utxos    = walletcommon.NewUTXOs()
+		cursor   UTXOCursor
+		done     bool
+		numPages int
+	)
+	for !done {
+		cursor, done, err = AddAllUTXOsPaginated(
+			ctx,
+			utxos,
+			client,
+			txs.Codec,
+			constants.PlatformChainID,
+			constants.PlatformChainID,
+			addrs,
+			cursor,
+			2,
+		)
+		require.NoError(err)
+		numPages++
+
+		// UTXOs added while paginating don't invalidate the cursor.
+		if numPages == 1 {
+			require.NoError(state.PutUTXO(newTestUTXO(addrs[0])))
+		}
+	}
+	require.Greater(numPages, 1)
+
+	for _, utxo := range expected {
+		_, err := utxos.GetUTXO(ctx, constants.PlatformChainID, constants.PlatformChainID, utxo.InputID())
+		require.NoError(err)
+	}
+
+	// Resuming once all the UTXOs were fetched keeps the cursor stable.
+	emptyCursor, done, err := AddAllUTXOsPaginated(
+		ctx,
+		walletcommon.NewUTXOs(),
+		client,
+		txs.Codec,
+		constants.PlatformChainID,
+		constants.PlatformChainID,
+		[]ids.ShortID{ids.GenerateTestShortID()},
+		cursor,
+		2,
+	)
+	require.NoError(err)
+	require.True(done)
+	require.Equal(cursor, emptyCursor)
+}
+
+func TestAddAllUTXOsCancelled(t *testing.T) {
+	require := require.New(t)
+
+	// The node doesn't answer until the end of the test, as if it was
+	// overloaded.
+	var (
+		requested = make(chan struct{})
+		unblock   = make(chan struct{})
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		close(requested)
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		errs <- AddAllUTXOs(
+			ctx,
+			walletcommon.NewUTXOs(),
+			platformvm.NewClient(server.URL),
+			txs.Codec,
+			constants.PlatformChainID,
+			constants.PlatformChainID,
+			[]ids.ShortID{ids.GenerateTestShortID()},
+		)
+	}()
+
+	<-requested
+	cancel()
+
+	select {
+	case err := <-errs:
+		require.Equal(context.Canceled, err)
+	case <-time.After(5 * time.Second):
+		require.FailNow("sync wasn't aborted by the cancellation")
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import "github.com/Juneo-io/juneogo/ids"
+
+// BalanceDiff returns, for each asset whose balance changed between the
+// [before] and [after] snapshots, how much the balance moved. A negative delta
+// means that the balance decreased.
+//
+// Deltas are expected to fit in an int64, which holds for any asset whose
+// supply doesn't exceed the maximum int64 value (2^63 - 1).
+func BalanceDiff(before, after map[ids.ID]uint64) map[ids.ID]int64 {
+	diff := make(map[ids.ID]int64)
+	for assetID, afterBalance := range after {
+		beforeBalance := before[assetID]
+		switch {
+		case afterBalance > beforeBalance:
+			diff[assetID] = int64(afterBalance - beforeBalance)
+		case afterBalance < beforeBalance:
+			diff[assetID] = -int64(beforeBalance - afterBalance)
+		}
+	}
+	for assetID, beforeBalance := range before {
+		if _, ok := after[assetID]; !ok && beforeBalance > 0 {
+			diff[assetID] = -int64(beforeBalance)
+		}
+	}
+	return diff
+}
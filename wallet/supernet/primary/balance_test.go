@@ -0,0 +1,43 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/units"
+)
+
+func TestBalanceDiff(t *testing.T) {
+	var (
+		juneAssetID  = ids.GenerateTestID()
+		assetID      = ids.GenerateTestID()
+		spentAssetID = ids.GenerateTestID()
+		newAssetID   = ids.GenerateTestID()
+		sameAssetID  = ids.GenerateTestID()
+	)
+
+	before := map[ids.ID]uint64{
+		juneAssetID:  10 * units.Avax,
+		assetID:      100,
+		spentAssetID: 5,
+		sameAssetID:  7,
+	}
+	after := map[ids.ID]uint64{
+		juneAssetID: 10*units.Avax - units.MilliAvax,
+		assetID:     150,
+		newAssetID:  3,
+		sameAssetID: 7,
+	}
+
+	require.Equal(t, map[ids.ID]int64{
+		juneAssetID:  -int64(units.MilliAvax),
+		assetID:      50,
+		spentAssetID: -5,
+		newAssetID:   3,
+	}, BalanceDiff(before, after))
+}
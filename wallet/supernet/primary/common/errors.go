@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Juneo-io/juneogo/ids"
+)
+
+var (
+	_ error = (*InsufficientFundsError)(nil)
+	_ error = (*TxTooLargeError)(nil)
+
+	// ErrNoChangeAddress is returned when the wallet has no address that can
+	// receive the change of a transaction.
+	ErrNoChangeAddress = errors.New("no possible change address")
+	// ErrInsufficientFunds is returned when the UTXOs of the wallet can't fund
+	// a transaction.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	// ErrTxTooLarge is returned when a signed transaction is larger than the
+	// network accepts.
+	ErrTxTooLarge = errors.New("tx too large")
+)
+
+// InsufficientFundsError reports how much of an asset was missing to fund a
+// transaction. It wraps [ErrInsufficientFunds].
+type InsufficientFundsError struct {
+	AssetID ids.ID
+	// Need is the amount of [AssetID] required by the transaction
+	Need uint64
+	// Have is the amount of [AssetID] the wallet was able to spend
+	Have uint64
+}
+
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf(
+		"%s: provided UTXOs need %d more units of asset %q",
+		ErrInsufficientFunds,
+		e.Need-e.Have,
+		e.AssetID,
+	)
+}
+
+func (*InsufficientFundsError) Unwrap() error {
+	return ErrInsufficientFunds
+}
+
+// TxTooLargeError reports the size of a transaction that exceeds the maximum
+// size accepted by the network. It wraps [ErrTxTooLarge].
+type TxTooLargeError struct {
+	TxID    ids.ID
+	Size    int
+	MaxSize int
+}
+
+func (e *TxTooLargeError) Error() string {
+	return fmt.Sprintf(
+		"%s: %s is %d bytes but the limit is %d bytes",
+		ErrTxTooLarge,
+		e.TxID,
+		e.Size,
+		e.MaxSize,
+	)
+}
+
+func (*TxTooLargeError) Unwrap() error {
+	return ErrTxTooLarge
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/Juneo-io/juneogo/ids"
+)
+
+var _ prometheus.Collector = (*utxosCollector)(nil)
+
+type utxosCollector struct {
+	utxos    UTXOs
+	chainIDs []ids.ID
+	desc     *prometheus.Desc
+}
+
+// NewUTXOsCollector returns a collector that reports the number of UTXOs in
+// [utxos] that can be spent on each of [chainIDs], including the UTXOs exported
+// to it from the other chains of [chainIDs].
+//
+// The UTXOs are counted when the metrics are gathered, so the reported values
+// reflect every UTXO added or removed by syncing, issuing transactions, or
+// pruning.
+func NewUTXOsCollector(utxos UTXOs, chainIDs []ids.ID) prometheus.Collector {
+	return &utxosCollector{
+		utxos:    utxos,
+		chainIDs: chainIDs,
+		desc: prometheus.NewDesc(
+			"wallet_utxos",
+			"number of UTXOs held by the wallet",
+			[]string{"chain"},
+			nil,
+		),
+	}
+}
+
+func (c *utxosCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *utxosCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+	for _, destinationChainID := range c.chainIDs {
+		numUTXOs, err := c.countUTXOs(ctx, destinationChainID)
+		if err != nil {
+			ch <- prometheus.NewInvalidMetric(c.desc, err)
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(numUTXOs),
+			destinationChainID.String(),
+		)
+	}
+}
+
+func (c *utxosCollector) countUTXOs(ctx context.Context, destinationChainID ids.ID) (int, error) {
+	var numUTXOs int
+	for _, sourceChainID := range c.chainIDs {
+		utxos, err := c.utxos.UTXOs(ctx, sourceChainID, destinationChainID)
+		if err != nil {
+			return 0, err
+		}
+		numUTXOs += len(utxos)
+	}
+	return numUTXOs, nil
+}
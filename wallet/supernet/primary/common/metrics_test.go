@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/constants"
+	"github.com/Juneo-io/juneogo/vms/components/avax"
+)
+
+func TestUTXOsCollector(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx      = context.Background()
+		xChainID = ids.GenerateTestID()
+		utxos    = NewUTXOs()
+		registry = prometheus.NewRegistry()
+	)
+	require.NoError(registry.Register(NewUTXOsCollector(
+		utxos,
+		[]ids.ID{constants.PlatformChainID, xChainID},
+	)))
+
+	newUTXO := func() *avax.UTXO {
+		return &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+		}
+	}
+
+	// Fund the wallet with many small UTXOs on the P-chain and an export
+	// from the X-chain.
+	fundingUTXOs := make([]*avax.UTXO, 5)
+	for i := range fundingUTXOs {
+		fundingUTXOs[i] = newUTXO()
+		require.NoError(utxos.AddUTXO(ctx, constants.PlatformChainID, constants.PlatformChainID, fundingUTXOs[i]))
+	}
+	require.NoError(utxos.AddUTXO(ctx, xChainID, constants.PlatformChainID, newUTXO()))
+	require.Equal(
+		map[string]float64{
+			constants.PlatformChainID.String(): 6,
+			xChainID.String():                  0,
+		},
+		gatherUTXOCounts(t, registry),
+	)
+
+	// Consolidate the funding UTXOs into a single one.
+	for _, utxo := range fundingUTXOs {
+		require.NoError(utxos.RemoveUTXO(ctx, constants.PlatformChainID, constants.PlatformChainID, utxo.InputID()))
+	}
+	require.NoError(utxos.AddUTXO(ctx, constants.PlatformChainID, constants.PlatformChainID, newUTXO()))
+	require.Equal(
+		map[string]float64{
+			constants.PlatformChainID.String(): 2,
+			xChainID.String():                  0,
+		},
+		gatherUTXOCounts(t, registry),
+	)
+}
+
+// gatherUTXOCounts returns the number of UTXOs reported for each chain.
+func gatherUTXOCounts(t *testing.T, gatherer prometheus.Gatherer) map[string]float64 {
+	require := require.New(t)
+
+	families, err := gatherer.Gather()
+	require.NoError(err)
+	require.Len(families, 1)
+	require.Equal("wallet_utxos", families[0].GetName())
+
+	counts := make(map[string]float64)
+	for _, metric := range families[0].GetMetric() {
+		require.Len(metric.GetLabel(), 1)
+		counts[metric.GetLabel()[0].GetValue()] = metric.GetGauge().GetValue()
+	}
+	return counts
+}
@@ -5,22 +5,50 @@ package common
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
 	"time"
 
 	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/crypto/keychain"
 	"github.com/Juneo-io/juneogo/utils/set"
 	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
-const defaultPollFrequency = 100 * time.Millisecond
+const (
+	defaultPollFrequency      = 100 * time.Millisecond
+	defaultReservationTimeout = 10 * time.Minute
+)
+
+var (
+	// ErrConfirmDeadlineExceeded is returned when an issued transaction wasn't
+	// decided before the deadline provided with [WithConfirmDeadline].
+	ErrConfirmDeadlineExceeded = errors.New("confirmation deadline exceeded")
+	// ErrFeeTooLow is returned when the fee provided with [WithFee] is below
+	// the minimum fee of the transaction.
+	ErrFeeTooLow = errors.New("fee too low")
+)
 
 // Signature of the function that will be called after a transaction
 // has been issued with the ID of the issued transaction.
 type PostIssuanceFunc func(ids.ID)
 
+// UTXOStrategy determines which UTXOs are consumed to fund a transaction.
+type UTXOStrategy uint8
+
+const (
+	// DefaultUTXOStrategy consumes the UTXOs in the order they are provided by
+	// the backend until the transaction is funded.
+	DefaultUTXOStrategy UTXOStrategy = iota
+	// MinimalChange consumes the UTXOs whose sum is the closest to the amount
+	// needed to fund the transaction, which minimizes the change returned and
+	// avoids creating dust.
+	MinimalChange
+)
+
 type Option func(*Options)
 
 type Options struct {
@@ -34,20 +62,43 @@ type Options struct {
 
 	baseFee *big.Int
 
+	feeSet bool
+	fee    uint64
+
 	minIssuanceTimeSet bool
 	minIssuanceTime    uint64
 
 	allowStakeableLocked bool
 
+	feeFromImportedFunds bool
+
 	changeOwner *secp256k1fx.OutputOwners
 
+	utxoStrategy UTXOStrategy
+
+	supernetAuthKeys keychain.Keychain
+
 	memo []byte
 
 	assumeDecided bool
 
+	checkValidator     bool
+	checkDelegation    bool
+	checkDelegationFee bool
+	checkSupernet      bool
+
 	pollFrequencySet bool
 	pollFrequency    time.Duration
 
+	confirmDeadlineSet bool
+	confirmDeadline    time.Time
+
+	confirmTimeoutSet bool
+	confirmTimeout    time.Duration
+
+	reservationTimeoutSet bool
+	reservationTimeout    time.Duration
+
 	postIssuanceFunc PostIssuanceFunc
 }
 
@@ -99,6 +150,18 @@ func (o *Options) BaseFee(defaultBaseFee *big.Int) *big.Int {
 	return defaultBaseFee
 }
 
+// Fee returns the fee provided with [WithFee], or [minFee] if none was
+// provided. [ErrFeeTooLow] is returned if the provided fee is below [minFee].
+func (o *Options) Fee(minFee uint64) (uint64, error) {
+	if !o.feeSet {
+		return minFee, nil
+	}
+	if o.fee < minFee {
+		return 0, fmt.Errorf("%w: %d is below the minimum fee of %d", ErrFeeTooLow, o.fee, minFee)
+	}
+	return o.fee, nil
+}
+
 func (o *Options) MinIssuanceTime() uint64 {
 	if o.minIssuanceTimeSet {
 		return o.minIssuanceTime
@@ -110,6 +173,10 @@ func (o *Options) AllowStakeableLocked() bool {
 	return o.allowStakeableLocked
 }
 
+func (o *Options) FeeFromImportedFunds() bool {
+	return o.feeFromImportedFunds
+}
+
 func (o *Options) ChangeOwner(defaultOwner *secp256k1fx.OutputOwners) *secp256k1fx.OutputOwners {
 	if o.changeOwner != nil {
 		return o.changeOwner
@@ -117,6 +184,14 @@ func (o *Options) ChangeOwner(defaultOwner *secp256k1fx.OutputOwners) *secp256k1
 	return defaultOwner
 }
 
+func (o *Options) UTXOStrategy() UTXOStrategy {
+	return o.utxoStrategy
+}
+
+func (o *Options) SupernetAuthKeys() keychain.Keychain {
+	return o.supernetAuthKeys
+}
+
 func (o *Options) Memo() []byte {
 	return o.memo
 }
@@ -125,6 +200,22 @@ func (o *Options) AssumeDecided() bool {
 	return o.assumeDecided
 }
 
+func (o *Options) CheckValidator() bool {
+	return o.checkValidator
+}
+
+func (o *Options) CheckDelegation() bool {
+	return o.checkDelegation
+}
+
+func (o *Options) CheckDelegationFee() bool {
+	return o.checkDelegationFee
+}
+
+func (o *Options) CheckSupernet() bool {
+	return o.checkSupernet
+}
+
 func (o *Options) PollFrequency() time.Duration {
 	if o.pollFrequencySet {
 		return o.pollFrequency
@@ -132,6 +223,39 @@ func (o *Options) PollFrequency() time.Duration {
 	return defaultPollFrequency
 }
 
+// ConfirmContext returns the context to use while waiting for an issued
+// transaction to be decided. If a confirmation deadline or timeout was
+// provided, the returned context is cancelled once it is reached.
+func (o *Options) ConfirmContext() (context.Context, context.CancelFunc) {
+	ctx := o.Context()
+	if o.confirmTimeoutSet {
+		deadline := time.Now().Add(o.confirmTimeout)
+		if !o.confirmDeadlineSet || deadline.Before(o.confirmDeadline) {
+			return context.WithDeadline(ctx, deadline)
+		}
+	}
+	if o.confirmDeadlineSet {
+		return context.WithDeadline(ctx, o.confirmDeadline)
+	}
+	return context.WithCancel(ctx)
+}
+
+// ConfirmDeadlineExceeded returns true if [err], returned while waiting for a
+// transaction to be decided, was caused by the confirmation deadline rather
+// than by the context provided with [WithContext].
+func (o *Options) ConfirmDeadlineExceeded(err error) bool {
+	return (o.confirmDeadlineSet || o.confirmTimeoutSet) &&
+		errors.Is(err, context.DeadlineExceeded) &&
+		o.Context().Err() == nil
+}
+
+func (o *Options) ReservationTimeout() time.Duration {
+	if o.reservationTimeoutSet {
+		return o.reservationTimeout
+	}
+	return defaultReservationTimeout
+}
+
 func (o *Options) PostIssuanceFunc() PostIssuanceFunc {
 	return o.postIssuanceFunc
 }
@@ -162,6 +286,16 @@ func WithBaseFee(baseFee *big.Int) Option {
 	}
 }
 
+// WithFee makes the transaction burn [fee] JUNE rather than the minimum fee,
+// and selects enough UTXOs to fund it. It must not be below the minimum fee of
+// the transaction.
+func WithFee(fee uint64) Option {
+	return func(o *Options) {
+		o.feeSet = true
+		o.fee = fee
+	}
+}
+
 func WithMinIssuanceTime(minIssuanceTime uint64) Option {
 	return func(o *Options) {
 		o.minIssuanceTimeSet = true
@@ -175,12 +309,38 @@ func WithStakeableLocked() Option {
 	}
 }
 
+// WithFeeFromImportedFunds requires the fee of an import tx to be paid
+// entirely out of the imported JUNE, allowing a wallet without any funds on the
+// destination chain to import.
+func WithFeeFromImportedFunds() Option {
+	return func(o *Options) {
+		o.feeFromImportedFunds = true
+	}
+}
+
 func WithChangeOwner(changeOwner *secp256k1fx.OutputOwners) Option {
 	return func(o *Options) {
 		o.changeOwner = changeOwner
 	}
 }
 
+// WithUTXOStrategy selects the UTXOs consumed to fund a transaction according
+// to [strategy].
+func WithUTXOStrategy(strategy UTXOStrategy) Option {
+	return func(o *Options) {
+		o.utxoStrategy = strategy
+	}
+}
+
+// WithSupernetAuthKeys authorizes supernet operations with the keys of [kc]
+// rather than with the addresses of the wallet. This allows the control keys of
+// a multisig supernet to be provided only when they are needed.
+func WithSupernetAuthKeys(kc keychain.Keychain) Option {
+	return func(o *Options) {
+		o.supernetAuthKeys = kc
+	}
+}
+
 func WithMemo(memo []byte) Option {
 	return func(o *Options) {
 		o.memo = memo
@@ -193,6 +353,45 @@ func WithAssumeDecided() Option {
 	}
 }
 
+// WithValidatorCheck makes the wallet query the node before building a tx that
+// removes a validator, and fail if the validator isn't current or pending. It
+// requires access to a node, so it shouldn't be used to build txs offline.
+func WithValidatorCheck() Option {
+	return func(o *Options) {
+		o.checkValidator = true
+	}
+}
+
+// WithDelegationCheck makes the wallet query the node before building a tx that
+// adds a delegator, and fail if the delegator doesn't stake the staking asset
+// of the supernet or exceeds the weight that can still be delegated to the
+// validator. It requires access to a node, so it shouldn't be used to build
+// txs offline.
+func WithDelegationCheck() Option {
+	return func(o *Options) {
+		o.checkDelegation = true
+	}
+}
+
+// WithDelegationFeeCheck makes the wallet query the node before building a tx
+// that adds a validator, and fail if its delegation fee is outside of the
+// bounds allowed by the supernet. It requires access to a node, so it
+// shouldn't be used to build txs offline.
+func WithDelegationFeeCheck() Option {
+	return func(o *Options) {
+		o.checkDelegationFee = true
+	}
+}
+
+// WithSupernetCheck makes the wallet query the node before building a tx that
+// transforms a supernet, and fail if the supernet was already transformed. It
+// requires access to a node, so it shouldn't be used to build txs offline.
+func WithSupernetCheck() Option {
+	return func(o *Options) {
+		o.checkSupernet = true
+	}
+}
+
 func WithPollFrequency(pollFrequency time.Duration) Option {
 	return func(o *Options) {
 		o.pollFrequencySet = true
@@ -200,6 +399,38 @@ func WithPollFrequency(pollFrequency time.Duration) Option {
 	}
 }
 
+// WithConfirmDeadline stops waiting for an issued transaction to be decided
+// once [deadline] is reached. The wallet then returns
+// [ErrConfirmDeadlineExceeded] along with the last status it observed.
+func WithConfirmDeadline(deadline time.Time) Option {
+	return func(o *Options) {
+		o.confirmDeadlineSet = true
+		o.confirmDeadline = deadline
+	}
+}
+
+// WithAwaitAcceptance makes the wallet wait for at most [timeout] for an issued
+// transaction to be decided, even if [WithAssumeDecided] was provided before
+// it. If the transaction isn't decided in time, [ErrConfirmDeadlineExceeded] is
+// returned along with the last status observed. Otherwise, an error is
+// returned if the transaction was rejected.
+func WithAwaitAcceptance(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.assumeDecided = false
+		o.confirmTimeoutSet = true
+		o.confirmTimeout = timeout
+	}
+}
+
+// WithReservationTimeout sets how long the inputs of a prepared transaction are
+// reserved for before they can be spent by other transactions again.
+func WithReservationTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.reservationTimeoutSet = true
+		o.reservationTimeout = timeout
+	}
+}
+
 func WithPostIssuanceFunc(f PostIssuanceFunc) Option {
 	return func(o *Options) {
 		o.postIssuanceFunc = f
@@ -4,11 +4,18 @@
 package common
 
 import (
+	"slices"
+
 	"github.com/Juneo-io/juneogo/ids"
 	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/vms/components/avax"
 	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
 )
 
+// maxSelectionTries bounds the number of subsets explored by
+// [SelectMinimalChange].
+const maxSelectionTries = 100_000
+
 // MatchOwners attempts to match a list of addresses up to the provided
 // threshold.
 func MatchOwners(
@@ -29,3 +36,137 @@ func MatchOwners(
 	}
 	return sigs, uint32(len(sigs)) == owners.Threshold
 }
+
+// SelectMinimalChange returns the indices of a subset of [amounts] whose sum is
+// at least [target] and exceeds it by as little as could be found. False is
+// returned if the sum of all the [amounts] is less than [target].
+//
+// Finding the optimal subset is a subset-sum problem, so the search is a
+// depth-first branch and bound that gives up after [maxSelectionTries] and
+// returns the best subset found so far.
+func SelectMinimalChange(amounts []uint64, target uint64) ([]int, bool) {
+	// Exploring the largest amounts first quickly finds a valid subset, which
+	// then bounds the rest of the search.
+	order := make([]int, len(amounts))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int {
+		switch {
+		case amounts[i] > amounts[j]:
+			return -1
+		case amounts[i] < amounts[j]:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	// remaining[i] is the sum of the amounts from order[i:], capped to
+	// [target] to avoid overflows.
+	remaining := make([]uint64, len(order)+1)
+	for i := len(order) - 1; i >= 0; i-- {
+		if amount := amounts[order[i]]; amount >= target-remaining[i+1] {
+			remaining[i] = target
+		} else {
+			remaining[i] = remaining[i+1] + amount
+		}
+	}
+	if remaining[0] < target {
+		return nil, false
+	}
+	if target == 0 {
+		return nil, true
+	}
+
+	var (
+		best       []int
+		bestChange uint64
+		found      bool
+		tries      int
+		selected   []int
+	)
+	var search func(i int, needed uint64)
+	search = func(i int, needed uint64) {
+		tries++
+		// Stop if the remaining amounts can't cover what is still needed, if
+		// the best subset can't be improved, or if the search is taking too
+		// long.
+		if i == len(order) || remaining[i] < needed || (found && bestChange == 0) || tries >= maxSelectionTries {
+			return
+		}
+
+		selected = append(selected, order[i])
+		if amount := amounts[order[i]]; amount >= needed {
+			if change := amount - needed; !found || change < bestChange {
+				best = slices.Clone(selected)
+				bestChange = change
+				found = true
+			}
+		} else {
+			search(i+1, needed-amount)
+		}
+		selected = selected[:len(selected)-1]
+
+		search(i+1, needed)
+	}
+	search(0, target)
+
+	if !found {
+		return nil, false
+	}
+	slices.Sort(best)
+	return best, true
+}
+
+// PrioritizeUTXOs reorders [utxos] so that, for every asset in
+// [amountsToSpend], a subset of the spendable UTXOs covering the amount with
+// minimal change is iterated over first. [spendableAmount] returns the amount
+// of a UTXO, or false if the UTXO can't be spent.
+func PrioritizeUTXOs(
+	utxos []*avax.UTXO,
+	amountsToSpend map[ids.ID]uint64,
+	spendableAmount func(*avax.UTXO) (uint64, bool),
+) []*avax.UTXO {
+	var (
+		candidates       = make(map[ids.ID][]int)
+		candidateAmounts = make(map[ids.ID][]uint64)
+	)
+	for i, utxo := range utxos {
+		assetID := utxo.AssetID()
+		if amountsToSpend[assetID] == 0 {
+			continue
+		}
+		amount, ok := spendableAmount(utxo)
+		if !ok {
+			continue
+		}
+		candidates[assetID] = append(candidates[assetID], i)
+		candidateAmounts[assetID] = append(candidateAmounts[assetID], amount)
+	}
+
+	prioritized := set.NewSet[int](len(utxos))
+	for assetID, indices := range candidates {
+		selection, ok := SelectMinimalChange(candidateAmounts[assetID], amountsToSpend[assetID])
+		if !ok {
+			// There aren't enough funds, which will be reported while spending.
+			continue
+		}
+		for _, i := range selection {
+			prioritized.Add(indices[i])
+		}
+	}
+
+	ordered := make([]*avax.UTXO, 0, len(utxos))
+	for i, utxo := range utxos {
+		if prioritized.Contains(i) {
+			ordered = append(ordered, utxo)
+		}
+	}
+	for i, utxo := range utxos {
+		if !prioritized.Contains(i) {
+			ordered = append(ordered, utxo)
+		}
+	}
+	return ordered
+}
@@ -0,0 +1,206 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/vms/components/avax"
+	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
+)
+
+func TestSelectMinimalChange(t *testing.T) {
+	tests := []struct {
+		name             string
+		amounts          []uint64
+		target           uint64
+		expectedIndices  []int
+		expectedSelected bool
+	}{
+		{
+			name:             "zero target",
+			amounts:          []uint64{1, 2},
+			target:           0,
+			expectedIndices:  nil,
+			expectedSelected: true,
+		},
+		{
+			name:             "no amounts",
+			amounts:          nil,
+			target:           1,
+			expectedIndices:  nil,
+			expectedSelected: false,
+		},
+		{
+			name:             "insufficient amounts",
+			amounts:          []uint64{1, 2},
+			target:           4,
+			expectedIndices:  nil,
+			expectedSelected: false,
+		},
+		{
+			name:             "exact match",
+			amounts:          []uint64{5, 3, 20, 1},
+			target:           8,
+			expectedIndices:  []int{0, 1},
+			expectedSelected: true,
+		},
+		{
+			name:             "exact match over the largest amounts",
+			amounts:          []uint64{10, 6, 5},
+			target:           11,
+			expectedIndices:  []int{1, 2},
+			expectedSelected: true,
+		},
+		{
+			name:             "minimal change",
+			amounts:          []uint64{10, 7, 6},
+			target:           12,
+			expectedIndices:  []int{1, 2},
+			expectedSelected: true,
+		},
+		{
+			name:             "all amounts needed",
+			amounts:          []uint64{1, 2, 3},
+			target:           6,
+			expectedIndices:  []int{0, 1, 2},
+			expectedSelected: true,
+		},
+		{
+			name:             "ties select the first amount",
+			amounts:          []uint64{3, 3, 3},
+			target:           3,
+			expectedIndices:  []int{0},
+			expectedSelected: true,
+		},
+		{
+			name:             "ties select the largest amount first",
+			amounts:          []uint64{2, 2, 4},
+			target:           4,
+			expectedIndices:  []int{2},
+			expectedSelected: true,
+		},
+		{
+			name:             "amounts summing above max uint64",
+			amounts:          []uint64{math.MaxUint64, math.MaxUint64},
+			target:           math.MaxUint64,
+			expectedIndices:  []int{0},
+			expectedSelected: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			indices, selected := SelectMinimalChange(test.amounts, test.target)
+			require.Equal(test.expectedSelected, selected)
+			require.Equal(test.expectedIndices, indices)
+		})
+	}
+}
+
+func TestPrioritizeUTXOs(t *testing.T) {
+	var (
+		assetA = ids.GenerateTestID()
+		assetB = ids.GenerateTestID()
+
+		newUTXO = func(assetID ids.ID, amount uint64, locktime uint64) *avax.UTXO {
+			return &avax.UTXO{
+				UTXOID: avax.UTXOID{
+					TxID: ids.GenerateTestID(),
+				},
+				Asset: avax.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: amount,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Locktime: locktime,
+					},
+				},
+			}
+		}
+
+		// Locked UTXOs can't be spent.
+		spendableAmount = func(utxo *avax.UTXO) (uint64, bool) {
+			out := utxo.Out.(*secp256k1fx.TransferOutput)
+			return out.Amt, out.Locktime == 0
+		}
+
+		a10      = newUTXO(assetA, 10, 0)
+		a6       = newUTXO(assetA, 6, 0)
+		a5       = newUTXO(assetA, 5, 0)
+		a3       = newUTXO(assetA, 3, 0)
+		a3Tie    = newUTXO(assetA, 3, 0)
+		a5Locked = newUTXO(assetA, 5, 1)
+		b4       = newUTXO(assetB, 4, 0)
+		b2       = newUTXO(assetB, 2, 0)
+	)
+
+	tests := []struct {
+		name           string
+		utxos          []*avax.UTXO
+		amountsToSpend map[ids.ID]uint64
+		expected       []*avax.UTXO
+	}{
+		{
+			name:           "no UTXOs",
+			utxos:          nil,
+			amountsToSpend: map[ids.ID]uint64{assetA: 1},
+			expected:       []*avax.UTXO{},
+		},
+		{
+			name:           "nothing to spend",
+			utxos:          []*avax.UTXO{a10, a6, a5},
+			amountsToSpend: nil,
+			expected:       []*avax.UTXO{a10, a6, a5},
+		},
+		{
+			name:           "exact match first",
+			utxos:          []*avax.UTXO{a10, a6, a5},
+			amountsToSpend: map[ids.ID]uint64{assetA: 11},
+			expected:       []*avax.UTXO{a6, a5, a10},
+		},
+		{
+			name:           "no solution keeps the order",
+			utxos:          []*avax.UTXO{a3, a5, a6},
+			amountsToSpend: map[ids.ID]uint64{assetA: 100},
+			expected:       []*avax.UTXO{a3, a5, a6},
+		},
+		{
+			name:           "ties keep the first UTXO",
+			utxos:          []*avax.UTXO{a5, a3, a3Tie},
+			amountsToSpend: map[ids.ID]uint64{assetA: 3},
+			expected:       []*avax.UTXO{a3, a5, a3Tie},
+		},
+		{
+			name:           "unspendable UTXOs are skipped",
+			utxos:          []*avax.UTXO{a5Locked, a10, a5},
+			amountsToSpend: map[ids.ID]uint64{assetA: 5},
+			expected:       []*avax.UTXO{a5, a5Locked, a10},
+		},
+		{
+			name:           "multiple assets",
+			utxos:          []*avax.UTXO{b4, a10, b2, a6, a5},
+			amountsToSpend: map[ids.ID]uint64{assetA: 11, assetB: 2},
+			expected:       []*avax.UTXO{b2, a6, a5, b4, a10},
+		},
+		{
+			name:           "assets not spent keep their order",
+			utxos:          []*avax.UTXO{b4, a10, b2, a6, a5},
+			amountsToSpend: map[ids.ID]uint64{assetA: 11},
+			expected:       []*avax.UTXO{a6, a5, b4, a10, b2},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			utxos := PrioritizeUTXOs(test.utxos, test.amountsToSpend, spendableAmount)
+			require.Equal(test.expected, utxos)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/snow/choices"
+	"github.com/Juneo-io/juneogo/utils/rpc"
+	"github.com/Juneo-io/juneogo/vms/avm"
+	"github.com/Juneo-io/juneogo/vms/platformvm"
+	"github.com/Juneo-io/juneogo/vms/platformvm/status"
+)
+
+const platformChainAlias = "P"
+
+var (
+	_ TxStatusClient = (*platformTxStatusClient)(nil)
+	_ TxStatusClient = avm.Client(nil)
+
+	ErrNoURIs = errors.New("no URIs provided")
+)
+
+// TxStatusClient reports the status of a transaction as seen by a single node.
+type TxStatusClient interface {
+	GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (choices.Status, error)
+}
+
+// NewTxStatusClient returns a TxStatusClient that queries [chain] on the node
+// served at [uri]. [chain] is either "P" or the alias of an AVM chain.
+func NewTxStatusClient(uri string, chain string) TxStatusClient {
+	if chain == platformChainAlias {
+		return &platformTxStatusClient{
+			client: platformvm.NewClient(uri),
+		}
+	}
+	return avm.NewClient(uri, chain)
+}
+
+// AwaitSupermajorityConfirmation polls every node in [uris] each [interval]
+// until strictly more than two-thirds of them report [txID] as accepted on
+// [chain].
+//
+// The last status reported by each node is returned, keyed by URI. Nodes that
+// fail to respond are reported as Unknown. If [ctx] is done before a
+// supermajority is reached, the statuses observed so far are returned along
+// with the context's error. [ErrNoURIs] is returned if [uris] is empty.
+func AwaitSupermajorityConfirmation(
+	ctx context.Context,
+	uris []string,
+	chain string,
+	txID ids.ID,
+	interval time.Duration,
+) (map[string]choices.Status, error) {
+	clients := make(map[string]TxStatusClient, len(uris))
+	for _, uri := range uris {
+		clients[uri] = NewTxStatusClient(uri, chain)
+	}
+	return awaitSupermajorityConfirmation(ctx, clients, txID, interval)
+}
+
+func awaitSupermajorityConfirmation(
+	ctx context.Context,
+	clients map[string]TxStatusClient,
+	txID ids.ID,
+	interval time.Duration,
+) (map[string]choices.Status, error) {
+	if len(clients) == 0 {
+		return nil, ErrNoURIs
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	statuses := make(map[string]choices.Status, len(clients))
+	for {
+		numAccepted := 0
+		for uri, client := range clients {
+			// Acceptance is final, so there is no need to query the node
+			// again.
+			if statuses[uri] == choices.Accepted {
+				numAccepted++
+				continue
+			}
+
+			txStatus, err := client.GetTxStatus(ctx, txID)
+			if err != nil {
+				txStatus = choices.Unknown
+			}
+			statuses[uri] = txStatus
+			if txStatus == choices.Accepted {
+				numAccepted++
+			}
+		}
+
+		if 3*numAccepted > 2*len(clients) {
+			return statuses, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return statuses, ctx.Err()
+		}
+	}
+}
+
+type platformTxStatusClient struct {
+	client platformvm.Client
+}
+
+func (c *platformTxStatusClient) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (choices.Status, error) {
+	res, err := c.client.GetTxStatus(ctx, txID, options...)
+	if err != nil {
+		return choices.Unknown, err
+	}
+
+	switch res.Status {
+	case status.Committed:
+		return choices.Accepted, nil
+	case status.Aborted, status.Dropped:
+		return choices.Rejected, nil
+	case status.Processing:
+		return choices.Processing, nil
+	default:
+		return choices.Unknown, nil
+	}
+}
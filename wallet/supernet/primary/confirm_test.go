@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/snow/choices"
+	"github.com/Juneo-io/juneogo/utils/rpc"
+)
+
+type testTxStatusClient choices.Status
+
+func (c testTxStatusClient) GetTxStatus(context.Context, ids.ID, ...rpc.Option) (choices.Status, error) {
+	return choices.Status(c), nil
+}
+
+func TestAwaitSupermajorityConfirmation(t *testing.T) {
+	tests := []struct {
+		name        string
+		statuses    map[string]choices.Status
+		expectedErr error
+	}{
+		{
+			name: "supermajority accepted",
+			statuses: map[string]choices.Status{
+				"node-1": choices.Accepted,
+				"node-2": choices.Accepted,
+				"node-3": choices.Accepted,
+				"node-4": choices.Accepted,
+				"node-5": choices.Processing,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "only a majority accepted",
+			statuses: map[string]choices.Status{
+				"node-1": choices.Accepted,
+				"node-2": choices.Accepted,
+				"node-3": choices.Accepted,
+				"node-4": choices.Processing,
+				"node-5": choices.Unknown,
+			},
+			expectedErr: context.DeadlineExceeded,
+		},
+		{
+			name:        "no nodes",
+			statuses:    nil,
+			expectedErr: ErrNoURIs,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			clients := make(map[string]TxStatusClient, len(test.statuses))
+			for uri, status := range test.statuses {
+				clients[uri] = testTxStatusClient(status)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			statuses, err := awaitSupermajorityConfirmation(
+				ctx,
+				clients,
+				ids.GenerateTestID(),
+				time.Millisecond,
+			)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.statuses, statuses)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Juneo-io/juneogo/api/info"
+	"github.com/Juneo-io/juneogo/genesis"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/units"
+	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
+	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
+	"github.com/Juneo-io/juneogo/wallet/supernet/primary"
+	"github.com/Juneo-io/juneogo/wallet/supernet/primary/common"
+)
+
+func main() {
+	key := genesis.EWOQKey
+	uri := primary.LocalAPIURI
+	kc := secp256k1fx.NewKeychain(key)
+	supernetIDStr := "29uVeLPJB1eQJkzRemU8g8wZDw5uJRqpab5U2mX9euieVwiEbL"
+	startTime := time.Now().Add(time.Minute)
+	duration := 2 * 7 * 24 * time.Hour // 2 weeks
+	weight := units.Avax
+	rewardAddr := key.Address()
+
+	supernetID, err := ids.FromString(supernetIDStr)
+	if err != nil {
+		log.Fatalf("failed to parse supernet ID: %s\n", err)
+	}
+
+	ctx := context.Background()
+	infoClient := info.NewClient(uri)
+
+	nodeInfoStartTime := time.Now()
+	nodeID, _, err := infoClient.GetNodeID(ctx)
+	if err != nil {
+		log.Fatalf("failed to fetch node IDs: %s\n", err)
+	}
+	log.Printf("fetched node ID %s in %s\n", nodeID, time.Since(nodeInfoStartTime))
+
+	// MakeWallet fetches the available UTXOs owned by [kc] on the network that
+	// [uri] is hosting.
+	walletSyncStartTime := time.Now()
+	wallet, err := primary.MakeWallet(ctx, &primary.WalletConfig{
+		URI:          uri,
+		AVAXKeychain: kc,
+		EthKeychain:  kc,
+	})
+	if err != nil {
+		log.Fatalf("failed to initialize wallet: %s\n", err)
+	}
+	log.Printf("synced wallet in %s\n", time.Since(walletSyncStartTime))
+
+	// Get the P-chain wallet
+	pWallet := wallet.P()
+
+	// The staking asset of the transformed supernet is fetched from the node
+	// as no asset is provided. The delegation is rejected before being issued
+	// if the validator can't accept [weight] more stake.
+	addDelegatorStartTime := time.Now()
+	addDelegatorTx, err := pWallet.IssueAddPermissionlessDelegatorTx(
+		&txs.SupernetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(startTime.Add(duration).Unix()),
+				Wght:   weight,
+			},
+			Supernet: supernetID,
+		},
+		ids.Empty,
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{rewardAddr},
+		},
+		common.WithDelegationCheck(),
+	)
+	if err != nil {
+		log.Fatalf("failed to issue add permissionless delegator transaction: %s\n", err)
+	}
+	log.Printf("added new supernet delegator to %s with %s in %s\n", nodeID, addDelegatorTx.ID(), time.Since(addDelegatorStartTime))
+}
@@ -58,14 +58,31 @@ func main() {
 	pContext := pBuilder.Context()
 	juneAssetID := pContext.JUNEAssetID
 
+	vdr := &txs.SupernetValidator{Validator: txs.Validator{
+		NodeID: nodeID,
+		Start:  uint64(startTime.Unix()),
+		End:    uint64(startTime.Add(duration).Unix()),
+		Wght:   weight,
+	}}
+
+	// Make sure the wallet holds enough to pay for both the stake and the fee
+	minBalance, err := pWallet.MinBalanceForStake(vdr, juneAssetID)
+	if err != nil {
+		log.Fatalf("failed to compute minimum balance: %s\n", err)
+	}
+	balances, err := pBuilder.GetBalance()
+	if err != nil {
+		log.Fatalf("failed to fetch balance: %s\n", err)
+	}
+	for assetID, required := range minBalance {
+		if balance := balances[assetID]; balance < required {
+			log.Fatalf("insufficient balance of %s: %d available but %d required\n", assetID, balance, required)
+		}
+	}
+
 	addValidatorStartTime := time.Now()
 	addValidatorTx, err := pWallet.IssueAddPermissionlessValidatorTx(
-		&txs.SupernetValidator{Validator: txs.Validator{
-			NodeID: nodeID,
-			Start:  uint64(startTime.Unix()),
-			End:    uint64(startTime.Add(duration).Unix()),
-			Wght:   weight,
-		}},
+		vdr,
 		nodePOP,
 		juneAssetID,
 		&secp256k1fx.OutputOwners{
@@ -15,6 +15,7 @@ import (
 	"github.com/Juneo-io/juneogo/vms/example/xsvm"
 	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
 	"github.com/Juneo-io/juneogo/wallet/supernet/primary"
+	"github.com/Juneo-io/juneogo/wallet/supernet/primary/common"
 
 	xsgenesis "github.com/Juneo-io/juneogo/vms/example/xsvm/genesis"
 )
@@ -66,17 +67,23 @@ func main() {
 	// Get the P-chain wallet
 	pWallet := wallet.P()
 
+	// The chain only starts on nodes tracking [supernetID], so stop waiting
+	// for it after a minute.
+	createChainCtx, cancel := context.WithTimeout(ctx, time.Minute)
+
 	createChainStartTime := time.Now()
-	createChainTx, err := pWallet.IssueCreateChainTx(
+	chainID, err := pWallet.IssueCreateChainAndAwait(
 		supernetID,
 		genesisBytes,
 		vmID,
 		nil,
 		name,
 		chainAssetID,
+		common.WithContext(createChainCtx),
 	)
+	cancel()
 	if err != nil {
-		log.Fatalf("failed to issue create chain transaction: %s\n", err)
+		log.Fatalf("failed to create chain: %s\n", err)
 	}
-	log.Printf("created new chain %s in %s\n", createChainTx.ID(), time.Since(createChainStartTime))
+	log.Printf("created and started new chain %s in %s\n", chainID, time.Since(createChainStartTime))
 }
@@ -12,8 +12,6 @@ import (
 	"github.com/Juneo-io/juneogo/ids"
 	"github.com/Juneo-io/juneogo/utils/formatting/address"
 	"github.com/Juneo-io/juneogo/utils/units"
-	"github.com/Juneo-io/juneogo/vms/components/avax"
-	"github.com/Juneo-io/juneogo/vms/platformvm/stakeable"
 	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
 	"github.com/Juneo-io/juneogo/wallet/supernet/primary"
 )
@@ -53,25 +51,17 @@ func main() {
 	juneAssetID := pContext.JUNEAssetID
 
 	issueTxStartTime := time.Now()
-	tx, err := pWallet.IssueBaseTx([]*avax.TransferableOutput{
-		{
-			Asset: avax.Asset{
-				ID: juneAssetID,
-			},
-			Out: &stakeable.LockOut{
-				Locktime: locktime,
-				TransferableOut: &secp256k1fx.TransferOutput{
-					Amt: amount,
-					OutputOwners: secp256k1fx.OutputOwners{
-						Threshold: 1,
-						Addrs: []ids.ShortID{
-							destAddr,
-						},
-					},
-				},
+	tx, err := pWallet.IssueLockedTransferTx(
+		juneAssetID,
+		amount,
+		locktime,
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				destAddr,
 			},
 		},
-	})
+	)
 	if err != nil {
 		log.Fatalf("failed to issue transaction: %s\n", err)
 	}
@@ -13,12 +13,16 @@ import (
 	"github.com/Juneo-io/juneogo/utils/set"
 	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
 	"github.com/Juneo-io/juneogo/wallet/supernet/primary"
+	"github.com/Juneo-io/juneogo/wallet/supernet/primary/common"
 )
 
 func main() {
 	key := genesis.EWOQKey
 	uri := primary.LocalAPIURI
 	kc := secp256k1fx.NewKeychain(key)
+	// The control keys of the supernet. If the supernet is owned by a multisig,
+	// enough keys to reach its threshold must be provided.
+	supernetAuthKC := secp256k1fx.NewKeychain(key)
 	supernetIDStr := "29uVeLPJB1eQJkzRemU8g8wZDw5uJRqpab5U2mX9euieVwiEbL"
 	nodeIDStr := "NodeID-7Xhw2mDxuDS44j42TCB6U5579esbSt3Lg"
 
@@ -55,6 +59,9 @@ func main() {
 	removeValidatorTx, err := pWallet.IssueRemoveSupernetValidatorTx(
 		nodeID,
 		supernetID,
+		common.WithSupernetAuthKeys(supernetAuthKC),
+		// Fail early instead of paying the fee of a tx that can't be accepted.
+		common.WithValidatorCheck(),
 	)
 	if err != nil {
 		log.Fatalf("failed to issue remove supernet validator transaction: %s\n", err)
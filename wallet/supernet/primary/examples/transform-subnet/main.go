@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Juneo-io/juneogo/genesis"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/utils/units"
+	"github.com/Juneo-io/juneogo/vms/platformvm/reward"
+	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
+	"github.com/Juneo-io/juneogo/wallet/supernet/primary"
+	"github.com/Juneo-io/juneogo/wallet/supernet/primary/common"
+)
+
+func main() {
+	key := genesis.EWOQKey
+	uri := primary.LocalAPIURI
+	kc := secp256k1fx.NewKeychain(key)
+	supernetIDStr := "29uVeLPJB1eQJkzRemU8g8wZDw5uJRqpab5U2mX9euieVwiEbL"
+	assetIDStr := "2Z4UuGRmmgBkRNd6ULRLnAbRh2s3rXrz5PqMNc9hJwqKWJRBWq"
+	initialRewardPoolSupply := 100 * units.MegaAvax
+	rewardStartTime := time.Now()
+
+	supernetID, err := ids.FromString(supernetIDStr)
+	if err != nil {
+		log.Fatalf("failed to parse supernet ID: %s\n", err)
+	}
+
+	assetID, err := ids.FromString(assetIDStr)
+	if err != nil {
+		log.Fatalf("failed to parse asset ID: %s\n", err)
+	}
+
+	ctx := context.Background()
+
+	// MakeWallet fetches the available UTXOs owned by [kc] on the network that
+	// [uri] is hosting and registers [supernetID].
+	walletSyncStartTime := time.Now()
+	wallet, err := primary.MakeWallet(ctx, &primary.WalletConfig{
+		URI:              uri,
+		AVAXKeychain:     kc,
+		EthKeychain:      kc,
+		PChainTxsToFetch: set.Of(supernetID),
+	})
+	if err != nil {
+		log.Fatalf("failed to initialize wallet: %s\n", err)
+	}
+	log.Printf("synced wallet in %s\n", time.Since(walletSyncStartTime))
+
+	// Get the P-chain wallet
+	pWallet := wallet.P()
+	log.Printf("transforming supernet %s costs %d nJUNE\n", supernetID, pWallet.Builder().Context().TransformSupernetTxFee)
+
+	transformSupernetStartTime := time.Now()
+	transformSupernetTx, err := pWallet.IssueTransformSupernetTx(
+		supernetID,
+		assetID,
+		initialRewardPoolSupply,
+		1_0000, // start reward share
+		uint64(rewardStartTime.Unix()),
+		8000, // diminishing reward share
+		uint64(rewardStartTime.Add(365*24*time.Hour).Unix()),
+		6000, // target reward share
+		uint64(rewardStartTime.Add(2*365*24*time.Hour).Unix()),
+		units.KiloAvax,                // min validator stake
+		10*units.MegaAvax,             // max validator stake
+		2*7*24*time.Hour,              // min stake duration
+		365*24*time.Hour,              // max stake duration
+		2_0000,                        // stake period reward share
+		2_0000,                        // min delegation fee
+		reward.PercentDenominator,     // max delegation fee
+		25*units.Avax,                 // min delegator stake
+		5,                             // max validator weight factor
+		.80*reward.PercentDenominator, // uptime requirement
+		common.WithSupernetCheck(),
+	)
+	if err != nil {
+		log.Fatalf("failed to issue transform supernet transaction: %s\n", err)
+	}
+	log.Printf("transformed supernet %s with %s in %s\n", supernetID, transformSupernetTx.ID(), time.Since(transformSupernetStartTime))
+}
@@ -0,0 +1,49 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/math"
+)
+
+var errNegativeNumKeys = errors.New("negative number of keys")
+
+// PlanFunding returns the minimum amount of each asset a wallet must hold to
+// fund [numKeys] keys with [perKey] each in a single transaction paying a fee
+// of [feePerTx] in [feeAssetID].
+//
+// The fee asset is JUNE, whose ID depends on the network, so it must be
+// provided for the fee to be added to the right total. An error is returned if
+// [numKeys] is negative or if a total overflows a uint64, rather than
+// returning a plan that can't be funded.
+func PlanFunding(
+	feeAssetID ids.ID,
+	numKeys int,
+	perKey map[ids.ID]uint64,
+	feePerTx uint64,
+) (map[ids.ID]uint64, error) {
+	if numKeys < 0 {
+		return nil, fmt.Errorf("%w: %d", errNegativeNumKeys, numKeys)
+	}
+
+	totalNeeded := make(map[ids.ID]uint64, len(perKey)+1)
+	for assetID, amount := range perKey {
+		total, err := math.Mul64(amount, uint64(numKeys))
+		if err != nil {
+			return nil, err
+		}
+		totalNeeded[assetID] = total
+	}
+
+	totalFee, err := math.Add64(totalNeeded[feeAssetID], feePerTx)
+	if err != nil {
+		return nil, err
+	}
+	totalNeeded[feeAssetID] = totalFee
+	return totalNeeded, nil
+}
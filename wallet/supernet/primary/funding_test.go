@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/units"
+)
+
+func TestPlanFunding(t *testing.T) {
+	var (
+		feeAssetID   = ids.GenerateTestID()
+		otherAssetID = ids.GenerateTestID()
+	)
+
+	tests := []struct {
+		name        string
+		numKeys     int
+		perKey      map[ids.ID]uint64
+		expected    map[ids.ID]uint64
+		expectedErr error
+	}{
+		{
+			name:    "fee asset only",
+			numKeys: 5,
+			perKey: map[ids.ID]uint64{
+				feeAssetID: 10 * units.Avax,
+			},
+			expected: map[ids.ID]uint64{
+				feeAssetID: 5*10*units.Avax + units.MilliAvax,
+			},
+		},
+		{
+			name:    "multiple assets",
+			numKeys: 3,
+			perKey: map[ids.ID]uint64{
+				feeAssetID:   units.Avax,
+				otherAssetID: 7,
+			},
+			expected: map[ids.ID]uint64{
+				feeAssetID:   3*units.Avax + units.MilliAvax,
+				otherAssetID: 3 * 7,
+			},
+		},
+		{
+			name:    "no fee asset allocation",
+			numKeys: 2,
+			perKey: map[ids.ID]uint64{
+				otherAssetID: 7,
+			},
+			expected: map[ids.ID]uint64{
+				feeAssetID:   units.MilliAvax,
+				otherAssetID: 2 * 7,
+			},
+		},
+		{
+			name:    "negative number of keys",
+			numKeys: -1,
+			perKey: map[ids.ID]uint64{
+				feeAssetID: units.Avax,
+			},
+			expectedErr: errNegativeNumKeys,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			totalNeeded, err := PlanFunding(feeAssetID, test.numKeys, test.perKey, units.MilliAvax)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, totalNeeded)
+		})
+	}
+}
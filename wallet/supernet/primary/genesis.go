@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Juneo-io/juneogo/api/info"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/hashing"
+	"github.com/Juneo-io/juneogo/utils/rpc"
+)
+
+var (
+	_ GenesisClient = info.Client(nil)
+
+	ErrInconsistentGenesis = errors.New("inconsistent genesis")
+)
+
+// GenesisClient reports the genesis a single node was started with.
+type GenesisClient interface {
+	GetGenesisBytes(ctx context.Context, options ...rpc.Option) ([]byte, error)
+}
+
+// VerifyConsistentGenesis fetches the genesis of every node in [uris] and
+// returns an error if they aren't all identical.
+//
+// The genesis of the first node is used as the reference. If any other node
+// reports a different genesis, [ErrInconsistentGenesis] is returned along with
+// the URI of the diverging node.
+func VerifyConsistentGenesis(ctx context.Context, uris []string) error {
+	clients := make([]GenesisClient, len(uris))
+	for i, uri := range uris {
+		clients[i] = info.NewClient(uri)
+	}
+	return verifyConsistentGenesis(ctx, uris, clients)
+}
+
+// verifyConsistentGenesis expects [clients][i] to query the node served at
+// [uris][i].
+func verifyConsistentGenesis(
+	ctx context.Context,
+	uris []string,
+	clients []GenesisClient,
+) error {
+	var (
+		expectedURI string
+		expectedID  ids.ID
+	)
+	for i, client := range clients {
+		genesisBytes, err := client.GetGenesisBytes(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch genesis of %s: %w", uris[i], err)
+		}
+
+		genesisID := ids.ID(hashing.ComputeHash256Array(genesisBytes))
+		if i == 0 {
+			expectedURI = uris[i]
+			expectedID = genesisID
+			continue
+		}
+		if genesisID != expectedID {
+			return fmt.Errorf("%w: %s has genesis %s but %s has genesis %s",
+				ErrInconsistentGenesis,
+				uris[i],
+				genesisID,
+				expectedURI,
+				expectedID,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/utils/rpc"
+)
+
+type testGenesisClient []byte
+
+func (c testGenesisClient) GetGenesisBytes(context.Context, ...rpc.Option) ([]byte, error) {
+	return c, nil
+}
+
+func TestVerifyConsistentGenesis(t *testing.T) {
+	tests := []struct {
+		name        string
+		genesis     [][]byte
+		expectedErr error
+	}{
+		{
+			name: "matching genesis",
+			genesis: [][]byte{
+				{0, 1, 2},
+				{0, 1, 2},
+				{0, 1, 2},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "mismatched genesis",
+			genesis: [][]byte{
+				{0, 1, 2},
+				{0, 1, 2},
+				{3, 4, 5},
+			},
+			expectedErr: ErrInconsistentGenesis,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			uris := []string{"node-1", "node-2", "node-3"}
+			clients := make([]GenesisClient, len(test.genesis))
+			for i, genesisBytes := range test.genesis {
+				clients[i] = testGenesisClient(genesisBytes)
+			}
+
+			err := verifyConsistentGenesis(context.Background(), uris, clients)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				require.ErrorContains(err, "node-3")
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/snow/choices"
+	"github.com/Juneo-io/juneogo/utils/rpc"
+	"github.com/Juneo-io/juneogo/vms/avm"
+	"github.com/Juneo-io/juneogo/vms/platformvm"
+	"github.com/Juneo-io/juneogo/vms/platformvm/status"
+	"github.com/Juneo-io/juneogo/wallet/chain/x"
+
+	pbuilder "github.com/Juneo-io/juneogo/wallet/chain/p/builder"
+	xbuilder "github.com/Juneo-io/juneogo/wallet/chain/x/builder"
+)
+
+var (
+	_ platformvm.Client = (*multiNodePClient)(nil)
+	_ avm.Client        = (*multiNodeXClient)(nil)
+
+	ErrUnknownIssuancePolicy = errors.New("unknown issuance policy")
+	ErrInconsistentContext   = errors.New("inconsistent chain context")
+)
+
+// IssuancePolicy determines how transactions are issued when a wallet is
+// configured with multiple issuance URIs.
+type IssuancePolicy uint8
+
+const (
+	// IssueFirstSuccess issues transactions to the nodes in order until one of
+	// them accepts it.
+	IssueFirstSuccess IssuancePolicy = iota
+	// IssueAll issues transactions to every node. Issuance succeeds if any of
+	// the nodes accepted the transaction.
+	IssueAll
+	// IssueRoundRobin issues every transaction to the node following the one
+	// the previous transaction was issued to, falling back to the next nodes
+	// if it fails.
+	IssueRoundRobin
+)
+
+func (p IssuancePolicy) Valid() bool {
+	return p <= IssueRoundRobin
+}
+
+// issuer issues transactions to [clients] according to [policy].
+type issuer[T any] struct {
+	clients []T
+	policy  IssuancePolicy
+	next    atomic.Uint64
+}
+
+func newIssuer[T any](clients []T, policy IssuancePolicy) *issuer[T] {
+	return &issuer[T]{
+		clients: clients,
+		policy:  policy,
+	}
+}
+
+// issue calls [issueTx] with the clients selected by the policy and returns the
+// ID reported by the first successful call. If every call fails, all the
+// errors are returned.
+func (i *issuer[T]) issue(issueTx func(T) (ids.ID, error)) (ids.ID, error) {
+	var (
+		numClients = len(i.clients)
+		start      int
+	)
+	if i.policy == IssueRoundRobin {
+		start = int((i.next.Add(1) - 1) % uint64(numClients))
+	}
+
+	var (
+		txID   ids.ID
+		issued bool
+		errs   []error
+	)
+	for offset := 0; offset < numClients; offset++ {
+		id, err := issueTx(i.clients[(start+offset)%numClients])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !issued {
+			txID = id
+			issued = true
+		}
+		if i.policy != IssueAll {
+			break
+		}
+	}
+	if !issued {
+		return ids.Empty, errors.Join(errs...)
+	}
+	return txID, nil
+}
+
+// awaitResult is the outcome of awaiting a tx through one of the clients.
+type awaitResult[R any] struct {
+	res R
+	err error
+}
+
+// awaitAny calls [awaitTx] with every client concurrently and returns the
+// first result for which [decided] returns true, canceling the other calls. If
+// no client reports the tx as decided, the first result returned without error,
+// or else the first error, is returned once every call is done.
+func awaitAny[T, R any](
+	ctx context.Context,
+	clients []T,
+	awaitTx func(context.Context, T) (R, error),
+	decided func(R) bool,
+) (R, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan awaitResult[R], len(clients))
+	for _, client := range clients {
+		go func(client T) {
+			res, err := awaitTx(ctx, client)
+			results <- awaitResult[R]{
+				res: res,
+				err: err,
+			}
+		}(client)
+	}
+
+	var (
+		result   awaitResult[R]
+		returned bool
+	)
+	for range clients {
+		r := <-results
+		if r.err == nil && decided(r.res) {
+			return r.res, nil
+		}
+		if !returned || (result.err != nil && r.err == nil) {
+			result = r
+			returned = true
+		}
+	}
+	return result.res, result.err
+}
+
+// multiNodePClient issues P-chain transactions to multiple nodes and awaits
+// their decision through all of them. All the other requests are sent to the
+// embedded client.
+type multiNodePClient struct {
+	platformvm.Client
+
+	issuer *issuer[platformvm.Client]
+}
+
+func (c *multiNodePClient) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (ids.ID, error) {
+	return c.issuer.issue(func(client platformvm.Client) (ids.ID, error) {
+		return client.IssueTx(ctx, txBytes, options...)
+	})
+}
+
+// AwaitTxDecided polls every issuance node and returns as soon as one of them
+// reports the tx as committed or aborted. A tx dropped by one node may still be
+// committed through another, so the other nodes keep being polled.
+func (c *multiNodePClient) AwaitTxDecided(
+	ctx context.Context,
+	txID ids.ID,
+	freq time.Duration,
+	options ...rpc.Option,
+) (*platformvm.AwaitTxDecidedResponse, error) {
+	return awaitAny(
+		ctx,
+		c.issuer.clients,
+		func(ctx context.Context, client platformvm.Client) (*platformvm.AwaitTxDecidedResponse, error) {
+			return client.AwaitTxDecided(ctx, txID, freq, options...)
+		},
+		func(res *platformvm.AwaitTxDecidedResponse) bool {
+			return res.Status == status.Committed || res.Status == status.Aborted
+		},
+	)
+}
+
+// multiNodeXClient issues X-chain transactions to multiple nodes and confirms
+// them through all of them. All the other requests are sent to the embedded
+// client.
+type multiNodeXClient struct {
+	avm.Client
+
+	issuer *issuer[avm.Client]
+}
+
+func (c *multiNodeXClient) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (ids.ID, error) {
+	return c.issuer.issue(func(client avm.Client) (ids.ID, error) {
+		return client.IssueTx(ctx, txBytes, options...)
+	})
+}
+
+// ConfirmTx polls every issuance node and returns as soon as one of them
+// reports the tx as decided.
+func (c *multiNodeXClient) ConfirmTx(
+	ctx context.Context,
+	txID ids.ID,
+	freq time.Duration,
+	options ...rpc.Option,
+) (choices.Status, error) {
+	return awaitAny(
+		ctx,
+		c.issuer.clients,
+		func(ctx context.Context, client avm.Client) (choices.Status, error) {
+			return client.ConfirmTx(ctx, txID, freq, options...)
+		},
+		choices.Status.Decided,
+	)
+}
+
+// newIssuanceClients returns the P-chain and X-chain clients of a wallet that
+// issues transactions to [config.IssuanceURIs]. The chain contexts of every
+// issuance node must match [pCTX] and [xCTX].
+func newIssuanceClients(
+	ctx context.Context,
+	config *WalletConfig,
+	pClient platformvm.Client,
+	pCTX *pbuilder.Context,
+	xClient avm.Client,
+	xCTX *xbuilder.Context,
+) (platformvm.Client, avm.Client, error) {
+	if len(config.IssuanceURIs) == 0 {
+		return pClient, xClient, nil
+	}
+
+	var (
+		pClients = make([]platformvm.Client, len(config.IssuanceURIs))
+		xClients = make([]avm.Client, len(config.IssuanceURIs))
+	)
+	for i, uri := range config.IssuanceURIs {
+		uriPCTX, err := pbuilder.NewContextFromURI(ctx, uri)
+		if err != nil {
+			return nil, nil, err
+		}
+		if *uriPCTX != *pCTX {
+			return nil, nil, fmt.Errorf("%w: P-chain context of %q differs from %q", ErrInconsistentContext, uri, config.URI)
+		}
+
+		uriXCTX, err := x.NewContextFromURI(ctx, uri)
+		if err != nil {
+			return nil, nil, err
+		}
+		if *uriXCTX != *xCTX {
+			return nil, nil, fmt.Errorf("%w: X-chain context of %q differs from %q", ErrInconsistentContext, uri, config.URI)
+		}
+
+		pClients[i] = platformvm.NewClient(uri)
+		xClients[i] = avm.NewClient(uri, "X")
+	}
+
+	return &multiNodePClient{
+		Client: pClient,
+		issuer: newIssuer(pClients, config.IssuancePolicy),
+	}, &multiNodeXClient{
+		Client: xClient,
+		issuer: newIssuer(xClients, config.IssuancePolicy),
+	}, nil
+}
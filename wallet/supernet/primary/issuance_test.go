@@ -0,0 +1,209 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/vms/platformvm/status"
+)
+
+var errNodeUnavailable = errors.New("node unavailable")
+
+// testIssuanceNode records the txs issued to it, or fails if [err] is set.
+type testIssuanceNode struct {
+	err    error
+	issued int
+}
+
+func issueToTestNode(txID ids.ID) func(*testIssuanceNode) (ids.ID, error) {
+	return func(node *testIssuanceNode) (ids.ID, error) {
+		if node.err != nil {
+			return ids.Empty, node.err
+		}
+		node.issued++
+		return txID, nil
+	}
+}
+
+func TestIssuer(t *testing.T) {
+	txID := ids.GenerateTestID()
+	tests := []struct {
+		name           string
+		policy         IssuancePolicy
+		nodeErrs       []error
+		numTxs         int
+		expectedIssued []int
+		expectedErr    error
+	}{
+		{
+			name:           "first success",
+			policy:         IssueFirstSuccess,
+			nodeErrs:       []error{nil, nil, nil},
+			numTxs:         2,
+			expectedIssued: []int{2, 0, 0},
+		},
+		{
+			name:           "first success skips failing nodes",
+			policy:         IssueFirstSuccess,
+			nodeErrs:       []error{errNodeUnavailable, nil, nil},
+			numTxs:         2,
+			expectedIssued: []int{0, 2, 0},
+		},
+		{
+			name:           "all",
+			policy:         IssueAll,
+			nodeErrs:       []error{nil, errNodeUnavailable, nil},
+			numTxs:         2,
+			expectedIssued: []int{2, 0, 2},
+		},
+		{
+			name:           "round robin",
+			policy:         IssueRoundRobin,
+			nodeErrs:       []error{nil, nil, nil},
+			numTxs:         4,
+			expectedIssued: []int{2, 1, 1},
+		},
+		{
+			name:           "round robin falls back to the next node",
+			policy:         IssueRoundRobin,
+			nodeErrs:       []error{nil, errNodeUnavailable, nil},
+			numTxs:         3,
+			expectedIssued: []int{1, 0, 2},
+		},
+		{
+			name:           "every node fails",
+			policy:         IssueAll,
+			nodeErrs:       []error{errNodeUnavailable, errNodeUnavailable},
+			numTxs:         1,
+			expectedIssued: []int{0, 0},
+			expectedErr:    errNodeUnavailable,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			nodes := make([]*testIssuanceNode, len(test.nodeErrs))
+			for i, err := range test.nodeErrs {
+				nodes[i] = &testIssuanceNode{err: err}
+			}
+
+			issuer := newIssuer(nodes, test.policy)
+			for i := 0; i < test.numTxs; i++ {
+				issuedTxID, err := issuer.issue(issueToTestNode(txID))
+				require.ErrorIs(err, test.expectedErr)
+				if test.expectedErr == nil {
+					require.Equal(txID, issuedTxID)
+				}
+			}
+
+			issued := make([]int, len(nodes))
+			for i, node := range nodes {
+				issued[i] = node.issued
+			}
+			require.Equal(test.expectedIssued, issued)
+		})
+	}
+}
+
+// testAwaitNode reports [status] for the awaited tx, or never answers if
+// [down] is set.
+type testAwaitNode struct {
+	status status.Status
+	down   bool
+}
+
+func awaitTestNode(ctx context.Context, node testAwaitNode) (status.Status, error) {
+	if node.down {
+		<-ctx.Done()
+		return status.Unknown, ctx.Err()
+	}
+	return node.status, nil
+}
+
+func TestAwaitAny(t *testing.T) {
+	tests := []struct {
+		name           string
+		nodes          []testAwaitNode
+		expectedStatus status.Status
+		expectedErr    error
+	}{
+		{
+			name: "committed while other nodes are down",
+			nodes: []testAwaitNode{
+				{down: true},
+				{status: status.Committed},
+				{down: true},
+			},
+			expectedStatus: status.Committed,
+		},
+		{
+			name: "committed after being dropped by another node",
+			nodes: []testAwaitNode{
+				{status: status.Dropped},
+				{status: status.Committed},
+			},
+			expectedStatus: status.Committed,
+		},
+		{
+			name: "aborted",
+			nodes: []testAwaitNode{
+				{down: true},
+				{status: status.Aborted},
+			},
+			expectedStatus: status.Aborted,
+		},
+		{
+			name: "dropped by every node",
+			nodes: []testAwaitNode{
+				{status: status.Dropped},
+				{status: status.Dropped},
+			},
+			expectedStatus: status.Dropped,
+		},
+		{
+			name: "dropped while other nodes are down",
+			nodes: []testAwaitNode{
+				{down: true},
+				{status: status.Dropped},
+			},
+			expectedStatus: status.Dropped,
+		},
+		{
+			name: "every node is down",
+			nodes: []testAwaitNode{
+				{down: true},
+				{down: true},
+			},
+			expectedStatus: status.Unknown,
+			expectedErr:    context.DeadlineExceeded,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			txStatus, err := awaitAny(
+				ctx,
+				test.nodes,
+				awaitTestNode,
+				func(txStatus status.Status) bool {
+					return txStatus == status.Committed || txStatus == status.Aborted
+				},
+			)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedStatus, txStatus)
+		})
+	}
+}
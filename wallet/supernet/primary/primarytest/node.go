@@ -0,0 +1,261 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primarytest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/chains"
+	"github.com/Juneo-io/juneogo/chains/atomic"
+	"github.com/Juneo-io/juneogo/database/memdb"
+	"github.com/Juneo-io/juneogo/database/prefixdb"
+	"github.com/Juneo-io/juneogo/genesis"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/snow"
+	"github.com/Juneo-io/juneogo/snow/engine/common"
+	"github.com/Juneo-io/juneogo/snow/snowtest"
+	"github.com/Juneo-io/juneogo/snow/uptime"
+	"github.com/Juneo-io/juneogo/snow/validators"
+	"github.com/Juneo-io/juneogo/utils/constants"
+	"github.com/Juneo-io/juneogo/utils/formatting"
+	"github.com/Juneo-io/juneogo/utils/formatting/address"
+	"github.com/Juneo-io/juneogo/utils/json"
+	"github.com/Juneo-io/juneogo/utils/units"
+	"github.com/Juneo-io/juneogo/vms/platformvm"
+	"github.com/Juneo-io/juneogo/vms/platformvm/api"
+	"github.com/Juneo-io/juneogo/vms/platformvm/config"
+	"github.com/Juneo-io/juneogo/vms/platformvm/reward"
+
+	pbuilder "github.com/Juneo-io/juneogo/wallet/chain/p/builder"
+)
+
+const (
+	nodeTxFee          = units.MilliAvax
+	nodeBalance        = 100 * units.KiloAvax
+	nodeStake          = 2 * units.KiloAvax
+	nodeStakeDuration  = 365 * 24 * time.Hour
+	nodeMinStakeAmount = units.Avax
+)
+
+// NodeID is the ID of the nodes created with [NewNode]. They validate the
+// primary network from genesis.
+var NodeID = ids.GenerateTestNodeID()
+
+// NewNode starts an in-process node serving the P-chain API and returns
+// the URI it can be reached at.
+//
+// The P-chain genesis funds [genesis.EWOQKey] and every transaction issued to
+// the node is immediately built into a block and accepted. The context required
+// to build transactions for the node is returned by [NodeContext].
+//
+// The node is shut down once [tb] completes.
+func NewNode(tb testing.TB) string {
+	require := require.New(tb)
+
+	vm := &platformvm.VM{Config: config.Config{
+		Chains:                        chains.TestManager,
+		UptimeLockedCalculator:        uptime.NewLockedCalculator(),
+		SybilProtectionEnabled:        true,
+		Validators:                    validators.NewManager(),
+		TxFee:                         nodeTxFee,
+		CreateSupernetTxFee:           nodeTxFee,
+		TransformSupernetTxFee:        nodeTxFee,
+		CreateBlockchainTxFee:         nodeTxFee,
+		AddPrimaryNetworkValidatorFee: nodeTxFee,
+		AddPrimaryNetworkDelegatorFee: nodeTxFee,
+		AddSupernetValidatorFee:       nodeTxFee,
+		AddSupernetDelegatorFee:       nodeTxFee,
+		MinValidatorStake:             nodeMinStakeAmount,
+		MaxValidatorStake:             nodeBalance,
+		MinDelegatorStake:             nodeMinStakeAmount,
+		MinStakeDuration:              24 * time.Hour,
+		MaxStakeDuration:              nodeStakeDuration,
+		RewardConfig: reward.Config{
+			MinStakePeriod:         24 * time.Hour,
+			MaxStakePeriod:         nodeStakeDuration,
+			StakePeriodRewardShare: 2_0000,
+			StartRewardShare:       12_0000,
+			DiminishingRewardShare: 8_0000,
+			TargetRewardShare:      6_0000,
+		},
+		// All the network upgrades are activated at genesis.
+		ApricotPhase3Time: time.Time{},
+		ApricotPhase5Time: time.Time{},
+		BanffTime:         time.Time{},
+		CortinaTime:       time.Time{},
+		DurangoTime:       time.Time{},
+		EUpgradeTime:      time.Time{},
+	}}
+
+	db := memdb.New()
+	chainDB := prefixdb.New([]byte{0}, db)
+	atomicDB := prefixdb.New([]byte{1}, db)
+
+	ctx := snowtest.Context(tb, snowtest.PChainID)
+	ctx.NodeID = NodeID
+	ctx.SharedMemory = atomic.NewMemory(atomicDB).NewSharedMemory(ctx.ChainID)
+
+	appSender := &common.SenderTest{}
+	appSender.SendAppGossipF = func(context.Context, common.SendConfig, []byte) error {
+		return nil
+	}
+
+	toEngine := make(chan common.Message, 1)
+
+	ctx.Lock.Lock()
+	require.NoError(vm.Initialize(
+		context.Background(),
+		ctx,
+		chainDB,
+		newNodeGenesis(tb),
+		nil,
+		nil,
+		toEngine,
+		nil,
+		appSender,
+	))
+	require.NoError(vm.SetState(context.Background(), snow.NormalOp))
+
+	handlers, err := vm.CreateHandlers(context.Background())
+	require.NoError(err)
+	ctx.Lock.Unlock()
+
+	mux := http.NewServeMux()
+	for _, chainAlias := range []string{pbuilder.Alias, constants.PlatformChainID.String()} {
+		mux.Handle("/ext/"+chainAlias, handlers[""])
+		mux.Handle("/ext/bc/"+chainAlias, handlers[""])
+	}
+	server := httptest.NewServer(mux)
+
+	// Act as the consensus engine of a single node network: every block that
+	// can be built is immediately accepted.
+	var (
+		closed = make(chan struct{})
+		wg     sync.WaitGroup
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for {
+			select {
+			case <-toEngine:
+			case <-closed:
+				return
+			}
+
+			ctx.Lock.Lock()
+			if err := acceptNextBlock(vm); err != nil {
+				tb.Errorf("failed to accept block: %s", err)
+			}
+			ctx.Lock.Unlock()
+		}
+	}()
+
+	tb.Cleanup(func() {
+		server.Close()
+		close(closed)
+		wg.Wait()
+
+		ctx.Lock.Lock()
+		defer ctx.Lock.Unlock()
+
+		require.NoError(vm.Shutdown(context.Background()))
+	})
+
+	return server.URL
+}
+
+// NodeContext returns the context of the P-chain served by the nodes
+// created with [NewNode].
+func NodeContext() *pbuilder.Context {
+	return &pbuilder.Context{
+		NetworkID:                     constants.UnitTestID,
+		JUNEAssetID:                   snowtest.JUNEAssetID,
+		BaseTxFee:                     nodeTxFee,
+		CreateSupernetTxFee:           nodeTxFee,
+		TransformSupernetTxFee:        nodeTxFee,
+		CreateBlockchainTxFee:         nodeTxFee,
+		AddPrimaryNetworkValidatorFee: nodeTxFee,
+		AddPrimaryNetworkDelegatorFee: nodeTxFee,
+		AddSupernetValidatorFee:       nodeTxFee,
+		AddSupernetDelegatorFee:       nodeTxFee,
+	}
+}
+
+// newNodeGenesis returns a P-chain genesis funding [genesis.EWOQKey] and
+// containing [NodeID] as the only validator, which is required for the block
+// builder to schedule blocks.
+func newNodeGenesis(tb testing.TB) []byte {
+	require := require.New(tb)
+
+	ewoqAddr, err := address.FormatBech32(
+		constants.UnitTestHRP,
+		genesis.EWOQKey.PublicKey().Address().Bytes(),
+	)
+	require.NoError(err)
+
+	genesisTime := time.Now().Add(-time.Minute).Truncate(time.Second)
+	buildGenesisArgs := api.BuildGenesisArgs{
+		Encoding:    formatting.Hex,
+		NetworkID:   json.Uint32(constants.UnitTestID),
+		AvaxAssetID: snowtest.JUNEAssetID,
+		UTXOs: []api.UTXO{{
+			Amount:  json.Uint64(nodeBalance),
+			Address: ewoqAddr,
+		}},
+		Validators: []api.GenesisPermissionlessValidator{{
+			GenesisValidator: api.GenesisValidator{
+				StartTime: json.Uint64(genesisTime.Unix()),
+				EndTime:   json.Uint64(genesisTime.Add(nodeStakeDuration).Unix()),
+				NodeID:    NodeID,
+			},
+			RewardOwner: &api.Owner{
+				Threshold: 1,
+				Addresses: []string{ewoqAddr},
+			},
+			Staked: []api.UTXO{{
+				Amount:  json.Uint64(nodeStake),
+				Address: ewoqAddr,
+			}},
+			DelegationFee: reward.PercentDenominator,
+		}},
+		Time:          json.Uint64(genesisTime.Unix()),
+		InitialSupply: json.Uint64(360 * units.MegaAvax),
+	}
+
+	var buildGenesisResponse api.BuildGenesisReply
+	platformvmSS := api.StaticService{}
+	require.NoError(platformvmSS.BuildGenesis(nil, &buildGenesisArgs, &buildGenesisResponse))
+
+	genesisBytes, err := formatting.Decode(buildGenesisResponse.Encoding, buildGenesisResponse.Bytes)
+	require.NoError(err)
+	return genesisBytes
+}
+
+// acceptNextBlock builds, verifies and accepts a block on top of the preferred
+// block of [vm].
+//
+// Invariant: The context lock of [vm] is held.
+func acceptNextBlock(vm *platformvm.VM) error {
+	ctx := context.Background()
+	blk, err := vm.BuildBlock(ctx)
+	if err != nil {
+		return err
+	}
+	if err := blk.Verify(ctx); err != nil {
+		return err
+	}
+	if err := blk.Accept(ctx); err != nil {
+		return err
+	}
+	return vm.SetPreference(ctx, blk.ID())
+}
@@ -0,0 +1,174 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/genesis"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/snow/choices"
+	"github.com/Juneo-io/juneogo/utils/constants"
+	"github.com/Juneo-io/juneogo/utils/units"
+	"github.com/Juneo-io/juneogo/vms/components/avax"
+	"github.com/Juneo-io/juneogo/vms/platformvm"
+	"github.com/Juneo-io/juneogo/vms/platformvm/status"
+	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
+	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
+	"github.com/Juneo-io/juneogo/wallet/chain/p"
+	"github.com/Juneo-io/juneogo/wallet/supernet/primary/common"
+	"github.com/Juneo-io/juneogo/wallet/supernet/primary/primarytest"
+
+	pbuilder "github.com/Juneo-io/juneogo/wallet/chain/p/builder"
+	psigner "github.com/Juneo-io/juneogo/wallet/chain/p/signer"
+)
+
+func TestTestNodeIssueBaseTx(t *testing.T) {
+	require := require.New(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	uri := primarytest.NewNode(t)
+	pCTX := primarytest.NodeContext()
+	kc := secp256k1fx.NewKeychain(genesis.EWOQKey)
+	pClient := platformvm.NewClient(uri)
+
+	utxos := common.NewUTXOs()
+	require.NoError(AddAllUTXOs(
+		ctx,
+		utxos,
+		pClient,
+		txs.Codec,
+		constants.PlatformChainID,
+		constants.PlatformChainID,
+		kc.Addresses().List(),
+	))
+
+	pUTXOs := common.NewChainUTXOs(constants.PlatformChainID, utxos)
+	pBackend := p.NewBackend(pCTX, pUTXOs, nil)
+	pBuilder := pbuilder.New(kc.Addresses(), pCTX, pBackend)
+	pSigner := psigner.New(kc, pBackend)
+	pWallet := p.NewWallet(pBuilder, pSigner, pClient, pBackend)
+
+	tx, err := pWallet.IssueBaseTx(
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: pCTX.JUNEAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+				},
+			},
+		}},
+		common.WithContext(ctx),
+	)
+	require.NoError(err)
+
+	statuses, err := AwaitSupermajorityConfirmation(
+		ctx,
+		[]string{uri},
+		pbuilder.Alias,
+		tx.ID(),
+		10*time.Millisecond,
+	)
+	require.NoError(err)
+	require.Equal(map[string]choices.Status{uri: choices.Accepted}, statuses)
+}
+
+func TestTestNodeIssueCreateChainAndAwait(t *testing.T) {
+	require := require.New(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	uri := primarytest.NewNode(t)
+	pCTX := primarytest.NodeContext()
+	kc := secp256k1fx.NewKeychain(genesis.EWOQKey)
+	pClient := platformvm.NewClient(uri)
+
+	utxos := common.NewUTXOs()
+	require.NoError(AddAllUTXOs(
+		ctx,
+		utxos,
+		pClient,
+		txs.Codec,
+		constants.PlatformChainID,
+		constants.PlatformChainID,
+		kc.Addresses().List(),
+	))
+
+	pUTXOs := common.NewChainUTXOs(constants.PlatformChainID, utxos)
+	pBackend := p.NewBackend(pCTX, pUTXOs, nil)
+	pBuilder := pbuilder.New(kc.Addresses(), pCTX, pBackend)
+	pSigner := psigner.New(kc, pBackend)
+	pWallet := p.NewWallet(pBuilder, pSigner, pClient, pBackend)
+
+	supernetTx, err := pWallet.IssueCreateSupernetTx(
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{genesis.EWOQKey.Address()},
+		},
+		common.WithContext(ctx),
+	)
+	require.NoError(err)
+
+	// The node doesn't validate the supernet yet, so the chain never reports
+	// as validating.
+	notValidatedCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel()
+	_, err = pWallet.IssueCreateChainAndAwait(
+		supernetTx.ID(),
+		nil,
+		ids.GenerateTestID(),
+		nil,
+		"test",
+		pCTX.JUNEAssetID,
+		common.WithContext(notValidatedCtx),
+		common.WithPollFrequency(10*time.Millisecond),
+	)
+	require.ErrorIs(err, p.ErrChainNotValidated)
+
+	startTime := time.Now()
+	_, err = pWallet.IssueAddSupernetValidatorTx(
+		&txs.SupernetValidator{
+			Validator: txs.Validator{
+				NodeID: primarytest.NodeID,
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(startTime.Add(48 * time.Hour).Unix()),
+				Wght:   1,
+			},
+			Supernet: supernetTx.ID(),
+		},
+		common.WithContext(ctx),
+	)
+	require.NoError(err)
+
+	chainID, err := pWallet.IssueCreateChainAndAwait(
+		supernetTx.ID(),
+		nil,
+		ids.GenerateTestID(),
+		nil,
+		"test",
+		pCTX.JUNEAssetID,
+		common.WithContext(ctx),
+		common.WithPollFrequency(10*time.Millisecond),
+	)
+	require.NoError(err)
+
+	chainTxBytes, err := pClient.GetTx(ctx, chainID)
+	require.NoError(err)
+	chainTx, err := txs.Parse(txs.Codec, chainTxBytes)
+	require.NoError(err)
+	require.IsType(&txs.CreateChainTx{}, chainTx.Unsigned)
+
+	chainStatus, err := pClient.GetBlockchainStatus(ctx, chainID.String())
+	require.NoError(err)
+	require.Equal(status.Validating, chainStatus)
+}
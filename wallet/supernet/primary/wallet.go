@@ -5,12 +5,21 @@ package primary
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/Juneo-io/juneogo/ids"
 	"github.com/Juneo-io/juneogo/utils/constants"
 	"github.com/Juneo-io/juneogo/utils/crypto/keychain"
+	"github.com/Juneo-io/juneogo/utils/math"
 	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/vms/platformvm"
 	"github.com/Juneo-io/juneogo/vms/platformvm/txs"
+	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
 	"github.com/Juneo-io/juneogo/wallet/chain/c"
 	"github.com/Juneo-io/juneogo/wallet/chain/p"
 	"github.com/Juneo-io/juneogo/wallet/chain/x"
@@ -22,19 +31,40 @@ import (
 	xsigner "github.com/Juneo-io/juneogo/wallet/chain/x/signer"
 )
 
-var _ Wallet = (*wallet)(nil)
+var (
+	_ Wallet = (*wallet)(nil)
+
+	ErrInvalidURI       = errors.New("invalid URI")
+	ErrNoKeychain       = errors.New("no keychain provided")
+	ErrEmptyTxID        = errors.New("empty tx ID")
+	ErrSameChain        = errors.New("source and destination chains are the same")
+	ErrUnsupportedChain = errors.New("unsupported chain")
+)
 
 // Wallet provides chain wallets for the primary network.
 type Wallet interface {
 	P() p.Wallet
 	X() x.Wallet
 	C() c.Wallet
+
+	// EstimateRoundTripCost returns the total fee paid to move funds from
+	// [chainA] to [chainB] and back, which requires an export and an import
+	// in each direction.
+	//
+	// Only the P-chain and the X-chain are supported, as the fees of atomic
+	// txs on the C-chain depend on the gas price.
+	EstimateRoundTripCost(chainA, chainB ids.ID) (uint64, error)
+
+	// Registry returns the metrics of the wallet, such as the number of UTXOs
+	// it holds on each chain.
+	Registry() prometheus.Gatherer
 }
 
 type wallet struct {
-	p p.Wallet
-	x x.Wallet
-	c c.Wallet
+	p        p.Wallet
+	x        x.Wallet
+	c        c.Wallet
+	registry prometheus.Gatherer
 }
 
 func (w *wallet) P() p.Wallet {
@@ -49,36 +79,131 @@ func (w *wallet) C() c.Wallet {
 	return w.c
 }
 
+func (w *wallet) Registry() prometheus.Gatherer {
+	return w.registry
+}
+
+func (w *wallet) EstimateRoundTripCost(chainA, chainB ids.ID) (uint64, error) {
+	if chainA == chainB {
+		return 0, fmt.Errorf("%w: %s", ErrSameChain, chainA)
+	}
+
+	feeA, err := w.crossChainTxFee(chainA)
+	if err != nil {
+		return 0, err
+	}
+	feeB, err := w.crossChainTxFee(chainB)
+	if err != nil {
+		return 0, err
+	}
+
+	// Both chains issue an export and an import.
+	feePerDirection, err := math.Add64(feeA, feeB)
+	if err != nil {
+		return 0, err
+	}
+	return math.Mul64(feePerDirection, 2)
+}
+
+// crossChainTxFee returns the fee paid by an export or an import on [chainID].
+func (w *wallet) crossChainTxFee(chainID ids.ID) (uint64, error) {
+	switch chainID {
+	case constants.PlatformChainID:
+		return w.p.Builder().Context().BaseTxFee, nil
+	case w.x.Builder().Context().BlockchainID:
+		return w.x.Builder().Context().BaseTxFee, nil
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrUnsupportedChain, chainID)
+	}
+}
+
 // Creates a new default wallet
 func NewWallet(p p.Wallet, x x.Wallet, c c.Wallet) Wallet {
+	return newWallet(p, x, c, prometheus.NewRegistry())
+}
+
+func newWallet(p p.Wallet, x x.Wallet, c c.Wallet, registry prometheus.Gatherer) Wallet {
 	return &wallet{
-		p: p,
-		x: x,
-		c: c,
+		p:        p,
+		x:        x,
+		c:        c,
+		registry: registry,
 	}
 }
 
 // Creates a Wallet with the given set of options
 func NewWalletWithOptions(w Wallet, options ...common.Option) Wallet {
-	return NewWallet(
+	return newWallet(
 		p.NewWalletWithOptions(w.P(), options...),
 		x.NewWalletWithOptions(w.X(), options...),
 		c.NewWalletWithOptions(w.C(), options...),
+		w.Registry(),
 	)
 }
 
 type WalletConfig struct {
 	// Base URI to use for all node requests.
 	URI string // required
-	// Keys to use for signing all transactions.
-	AVAXKeychain keychain.Keychain // required
-	EthKeychain  c.EthKeychain     // required
+	// Keys to use for signing all transactions. At least one of the keychains
+	// is required.
+	AVAXKeychain keychain.Keychain
+	EthKeychain  c.EthKeychain
 	// Set of P-chain transactions that the wallet should know about to be able
 	// to generate transactions.
 	PChainTxs map[ids.ID]*txs.Tx // optional
 	// Set of P-chain transactions that the wallet should fetch to be able to
 	// generate transactions.
 	PChainTxsToFetch set.Set[ids.ID] // optional
+	// UTXOs to seed the wallet with instead of fetching them from the node.
+	// The set is used directly by the wallet, so the UTXOs consumed and
+	// produced by issued transactions, including change outputs, are applied
+	// to it.
+	UTXOs common.UTXOs // optional
+	// URIs of the nodes that P-chain and X-chain transactions are issued to,
+	// following [IssuancePolicy], and whose decision is awaited through all of
+	// them. All the other requests are sent to [URI]. If empty, transactions
+	// are issued to [URI].
+	IssuanceURIs []string // optional
+	// Policy used to issue transactions to [IssuanceURIs].
+	IssuancePolicy IssuancePolicy // optional
+}
+
+// Validate returns an error if [config] can't be used to create a wallet.
+//
+// At least one of [config.AVAXKeychain] and [config.EthKeychain] must be
+// provided. [config] isn't modified.
+func (config *WalletConfig) Validate() error {
+	if err := validateURI(config.URI); err != nil {
+		return err
+	}
+	for _, uri := range config.IssuanceURIs {
+		if err := validateURI(uri); err != nil {
+			return fmt.Errorf("%w in issuance URIs", err)
+		}
+	}
+	if !config.IssuancePolicy.Valid() {
+		return fmt.Errorf("%w: %d", ErrUnknownIssuancePolicy, config.IssuancePolicy)
+	}
+
+	if config.AVAXKeychain == nil && config.EthKeychain == nil {
+		return ErrNoKeychain
+	}
+
+	if config.PChainTxsToFetch.Contains(ids.Empty) {
+		return fmt.Errorf("%w in P-chain txs to fetch", ErrEmptyTxID)
+	}
+	return nil
+}
+
+func validateURI(uriStr string) error {
+	uri, err := url.Parse(uriStr)
+	if err != nil {
+		return fmt.Errorf("%w %q: %w", ErrInvalidURI, uriStr, err)
+	}
+	if (uri.Scheme != "http" && uri.Scheme != "https") || uri.Host == "" {
+		return fmt.Errorf("%w %q: expected an http(s) URI with a host", ErrInvalidURI, uriStr)
+	}
+	return nil
 }
 
 // MakeWallet returns a wallet that supports issuing transactions to the chains
@@ -90,57 +215,143 @@ type WalletConfig struct {
 // may become out of sync. The wallet will also fetch all requested P-chain
 // transactions.
 //
+// If [config.UTXOs] is provided, the UTXOs aren't fetched and the provided set
+// is used and updated by the wallet instead.
+//
+// If [config.IssuanceURIs] is provided, P-chain and X-chain transactions are
+// issued to these nodes according to [config.IssuancePolicy] and are confirmed
+// as soon as any of them reports them as decided. An error is returned if the
+// chain contexts of these nodes don't match the one of [config.URI].
+//
+// The number of UTXOs held by the wallet on each chain is reported by
+// [Wallet.Registry].
+//
+// If only one of [config.AVAXKeychain] and [config.EthKeychain] is provided,
+// an empty keychain is used in place of the other one.
+//
 // The wallet manages all state locally, and performs all tx signing locally.
 func MakeWallet(ctx context.Context, config *WalletConfig) (Wallet, error) {
-	avaxAddrs := config.AVAXKeychain.Addresses()
-	avaxState, err := FetchState(ctx, config.URI, avaxAddrs)
-	if err != nil {
-		return nil, err
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid wallet config: %w", err)
 	}
 
-	ethAddrs := config.EthKeychain.EthAddresses()
-	ethState, err := FetchEthState(ctx, config.URI, ethAddrs)
-	if err != nil {
-		return nil, err
+	var (
+		avaxKeychain = config.AVAXKeychain
+		ethKeychain  = config.EthKeychain
+	)
+	if avaxKeychain == nil {
+		avaxKeychain = secp256k1fx.NewKeychain()
 	}
+	if ethKeychain == nil {
+		ethKeychain = secp256k1fx.NewKeychain()
+	}
+
+	var (
+		avaxAddrs = avaxKeychain.Addresses()
+		ethAddrs  = ethKeychain.EthAddresses()
+		pChainTxs = config.PChainTxs
 
-	pChainTxs := config.PChainTxs
+		avaxState *AVAXState
+		ethState  *EthState
+	)
 	if pChainTxs == nil {
 		pChainTxs = make(map[ids.ID]*txs.Tx)
 	}
 
-	for txID := range config.PChainTxsToFetch {
-		txBytes, err := avaxState.PClient.GetTx(ctx, txID)
-		if err != nil {
-			return nil, err
+	// The chains are synced concurrently. The first failure cancels [egCtx],
+	// which aborts the requests that are still in flight.
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		var err error
+		avaxState, err = fetchState(egCtx, config.URI, avaxAddrs, config.UTXOs)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		ethState, err = FetchEthState(egCtx, config.URI, ethAddrs)
+		return err
+	})
+	eg.Go(func() error {
+		return fetchPChainTxs(
+			egCtx,
+			platformvm.NewClient(config.URI),
+			config.PChainTxsToFetch,
+			pChainTxs,
+		)
+	})
+	if err := eg.Wait(); err != nil {
+		// If the sync was cancelled by the caller, the aborted requests report
+		// wrapped transport errors.
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
 		}
-		tx, err := txs.Parse(txs.Codec, txBytes)
-		if err != nil {
-			return nil, err
-		}
-		pChainTxs[txID] = tx
+		return nil, err
+	}
+
+	pClient, xClient, err := newIssuanceClients(
+		ctx,
+		config,
+		avaxState.PClient,
+		avaxState.PCTX,
+		avaxState.XClient,
+		avaxState.XCTX,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	pUTXOs := common.NewChainUTXOs(constants.PlatformChainID, avaxState.UTXOs)
 	pBackend := p.NewBackend(avaxState.PCTX, pUTXOs, pChainTxs)
 	pBuilder := pbuilder.New(avaxAddrs, avaxState.PCTX, pBackend)
-	pSigner := psigner.New(config.AVAXKeychain, pBackend)
+	pSigner := psigner.New(avaxKeychain, pBackend)
 
 	jvmChainID := avaxState.XCTX.BlockchainID
 	xUTXOs := common.NewChainUTXOs(jvmChainID, avaxState.UTXOs)
 	xBackend := x.NewBackend(avaxState.XCTX, xUTXOs)
 	xBuilder := xbuilder.New(avaxAddrs, avaxState.XCTX, xBackend)
-	xSigner := xsigner.New(config.AVAXKeychain, xBackend)
+	xSigner := xsigner.New(avaxKeychain, xBackend)
 
 	juneChainID := avaxState.CCTX.BlockchainID()
 	cUTXOs := common.NewChainUTXOs(juneChainID, avaxState.UTXOs)
 	cBackend := c.NewBackend(avaxState.CCTX, cUTXOs, ethState.Accounts)
 	cBuilder := c.NewBuilder(avaxAddrs, ethAddrs, cBackend)
-	cSigner := c.NewSigner(config.AVAXKeychain, config.EthKeychain, cBackend)
+	cSigner := c.NewSigner(avaxKeychain, ethKeychain, cBackend)
+
+	registry := prometheus.NewRegistry()
+	err = registry.Register(common.NewUTXOsCollector(
+		avaxState.UTXOs,
+		[]ids.ID{constants.PlatformChainID, jvmChainID, juneChainID},
+	))
+	if err != nil {
+		return nil, err
+	}
 
-	return NewWallet(
-		p.NewWallet(pBuilder, pSigner, avaxState.PClient, pBackend),
-		x.NewWallet(xBuilder, xSigner, avaxState.XClient, xBackend),
+	return newWallet(
+		p.NewWallet(pBuilder, pSigner, pClient, pBackend),
+		x.NewWallet(xBuilder, xSigner, xClient, xBackend),
 		c.NewWallet(cBuilder, cSigner, avaxState.CClient, ethState.Client, cBackend),
+		registry,
 	), nil
 }
+
+// fetchPChainTxs fetches the P-chain txs [txIDs] from [client] into
+// [pChainTxs].
+func fetchPChainTxs(
+	ctx context.Context,
+	client platformvm.Client,
+	txIDs set.Set[ids.ID],
+	pChainTxs map[ids.ID]*txs.Tx,
+) error {
+	for txID := range txIDs {
+		txBytes, err := client.GetTx(ctx, txID)
+		if err != nil {
+			return err
+		}
+		tx, err := txs.Parse(txs.Codec, txBytes)
+		if err != nil {
+			return err
+		}
+		pChainTxs[txID] = tx
+	}
+	return nil
+}
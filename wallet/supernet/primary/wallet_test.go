@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Juneo-io/juneogo/genesis"
+	"github.com/Juneo-io/juneogo/ids"
+	"github.com/Juneo-io/juneogo/utils/constants"
+	"github.com/Juneo-io/juneogo/utils/set"
+	"github.com/Juneo-io/juneogo/vms/secp256k1fx"
+	"github.com/Juneo-io/juneogo/wallet/chain/p"
+	"github.com/Juneo-io/juneogo/wallet/chain/x"
+
+	pbuilder "github.com/Juneo-io/juneogo/wallet/chain/p/builder"
+	xbuilder "github.com/Juneo-io/juneogo/wallet/chain/x/builder"
+)
+
+func TestWalletConfigValidate(t *testing.T) {
+	kc := secp256k1fx.NewKeychain(genesis.EWOQKey)
+
+	tests := []struct {
+		name        string
+		config      *WalletConfig
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			config: &WalletConfig{
+				URI:              LocalAPIURI,
+				AVAXKeychain:     kc,
+				EthKeychain:      kc,
+				PChainTxsToFetch: set.Of(ids.GenerateTestID()),
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "only avax keychain",
+			config: &WalletConfig{
+				URI:          LocalAPIURI,
+				AVAXKeychain: kc,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "missing URI",
+			config: &WalletConfig{
+				AVAXKeychain: kc,
+				EthKeychain:  kc,
+			},
+			expectedErr: ErrInvalidURI,
+		},
+		{
+			name: "URI without scheme",
+			config: &WalletConfig{
+				URI:          "localhost:9650",
+				AVAXKeychain: kc,
+				EthKeychain:  kc,
+			},
+			expectedErr: ErrInvalidURI,
+		},
+		{
+			name: "issuance URIs",
+			config: &WalletConfig{
+				URI:            LocalAPIURI,
+				AVAXKeychain:   kc,
+				IssuanceURIs:   []string{LocalAPIURI, "http://127.0.0.1:9652"},
+				IssuancePolicy: IssueRoundRobin,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "invalid issuance URI",
+			config: &WalletConfig{
+				URI:          LocalAPIURI,
+				AVAXKeychain: kc,
+				IssuanceURIs: []string{LocalAPIURI, "localhost:9652"},
+			},
+			expectedErr: ErrInvalidURI,
+		},
+		{
+			name: "unknown issuance policy",
+			config: &WalletConfig{
+				URI:            LocalAPIURI,
+				AVAXKeychain:   kc,
+				IssuanceURIs:   []string{LocalAPIURI},
+				IssuancePolicy: IssueRoundRobin + 1,
+			},
+			expectedErr: ErrUnknownIssuancePolicy,
+		},
+		{
+			name: "no keychain",
+			config: &WalletConfig{
+				URI: LocalAPIURI,
+			},
+			expectedErr: ErrNoKeychain,
+		},
+		{
+			name: "empty tx ID to fetch",
+			config: &WalletConfig{
+				URI:              LocalAPIURI,
+				AVAXKeychain:     kc,
+				EthKeychain:      kc,
+				PChainTxsToFetch: set.Of(ids.Empty),
+			},
+			expectedErr: ErrEmptyTxID,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			config := *test.config
+			err := test.config.Validate()
+			require.ErrorIs(err, test.expectedErr)
+
+			// Validate must not fill in the missing keychain.
+			require.Equal(&config, test.config)
+		})
+	}
+}
+
+func TestWalletEstimateRoundTripCost(t *testing.T) {
+	const (
+		pFee = 1_000_000
+		xFee = 2_000
+	)
+	var (
+		xChainID = ids.GenerateTestID()
+		pWallet  = p.NewWallet(
+			pbuilder.New(nil, &pbuilder.Context{BaseTxFee: pFee}, nil),
+			nil,
+			nil,
+			nil,
+		)
+		xWallet = x.NewWallet(
+			xbuilder.New(nil, &xbuilder.Context{BlockchainID: xChainID, BaseTxFee: xFee}, nil),
+			nil,
+			nil,
+			nil,
+		)
+		wallet = NewWallet(pWallet, xWallet, nil)
+	)
+
+	tests := []struct {
+		name         string
+		chainA       ids.ID
+		chainB       ids.ID
+		expectedCost uint64
+		expectedErr  error
+	}{
+		{
+			name:   "P to X",
+			chainA: constants.PlatformChainID,
+			chainB: xChainID,
+			// P-chain export + X-chain import + X-chain export + P-chain import
+			expectedCost: pFee + xFee + xFee + pFee,
+		},
+		{
+			name:         "X to P",
+			chainA:       xChainID,
+			chainB:       constants.PlatformChainID,
+			expectedCost: xFee + pFee + pFee + xFee,
+		},
+		{
+			name:        "same chain",
+			chainA:      xChainID,
+			chainB:      xChainID,
+			expectedErr: ErrSameChain,
+		},
+		{
+			name:        "unsupported chain",
+			chainA:      constants.PlatformChainID,
+			chainB:      ids.GenerateTestID(),
+			expectedErr: ErrUnsupportedChain,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			cost, err := wallet.EstimateRoundTripCost(test.chainA, test.chainB)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedCost, cost)
+		})
+	}
+}